@@ -0,0 +1,200 @@
+package main
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/brooksbp/go.netflow/pkg/asn"
+	"github.com/brooksbp/go.netflow/pkg/flow"
+	"github.com/brooksbp/go.netflow/pkg/geoip"
+	"github.com/brooksbp/go.netflow/pkg/iface"
+	"github.com/brooksbp/go.netflow/pkg/rdns"
+	"github.com/brooksbp/go.netflow/pkg/sites"
+)
+
+// Enrich attaches the enabled enrichments to a record that passed the
+// ingest filter. Routing information and site labels are filled in
+// earlier, by EnrichRoutes and EnrichSites, so that the filter can match
+// on them.
+func Enrich(r *flow.Record) {
+	EnrichHostnames(r)
+	EnrichGeo(r)
+	EnrichInterfaces(r)
+}
+
+// resolver looks up the host names of record addresses. It is nil when
+// -rdns is off.
+var resolver *rdns.Resolver
+
+// EnrichHostnames attaches the reverse DNS names of the record's
+// addresses that are cached, and starts lookups for the others so that
+// later records from the same hosts are named.
+func EnrichHostnames(r *flow.Record) {
+	if resolver == nil {
+		return
+	}
+	for _, a := range []struct {
+		key  string
+		addr netip.Addr
+	}{
+		{"src_host", r.SrcAddr},
+		{"dst_host", r.DstAddr},
+		{"next_hop_host", r.NextHop},
+	} {
+		if !a.addr.IsValid() || a.addr.IsUnspecified() {
+			continue
+		}
+		if names, ok := resolver.Lookup(a.addr); ok {
+			r.Enrich(a.key, strings.Join(names, " "))
+		}
+	}
+}
+
+// geoDB locates record addresses. It is nil unless -geoip-db is set.
+var geoDB *geoip.DB
+
+// EnrichGeo attaches the country, city and coordinates of the record's
+// source and destination addresses, as src_country, src_city, src_lat,
+// src_lon and the same for dst.
+func EnrichGeo(r *flow.Record) {
+	if geoDB == nil {
+		return
+	}
+	for _, a := range []struct {
+		prefix string
+		addr   netip.Addr
+	}{
+		{"src_", r.SrcAddr},
+		{"dst_", r.DstAddr},
+	} {
+		loc, ok := geoDB.Lookup(a.addr)
+		if !ok {
+			continue
+		}
+		if loc.Country != "" {
+			r.Enrich(a.prefix+"country", loc.Country)
+		}
+		if loc.City != "" {
+			r.Enrich(a.prefix+"city", loc.City)
+		}
+		if loc.HasCoordinates {
+			r.Enrich(a.prefix+"lat", strconv.FormatFloat(loc.Latitude, 'f', -1, 64))
+			r.Enrich(a.prefix+"lon", strconv.FormatFloat(loc.Longitude, 'f', -1, 64))
+		}
+	}
+}
+
+// routes maps record addresses to their prefix and origin AS. It is nil
+// unless -asn-routes or -asn-names is set.
+var routes *asn.DB
+
+// EnrichRoutes fills in the source and destination AS and mask of r from
+// the routing table where the exporter sent 0, attaches the prefixes as
+// src_prefix and dst_prefix, and the names of the ASes as src_as_name and
+// dst_as_name.
+func EnrichRoutes(r *flow.Record) {
+	if routes == nil {
+		return
+	}
+	for _, a := range []struct {
+		prefix    string
+		addr      netip.Addr
+		as        *uint32
+		mask      *uint8
+		asType    uint16
+		maskTypes [2]uint16
+	}{
+		{"src_", r.SrcAddr, &r.SrcAS, &r.SrcMask, 16, [2]uint16{9, 29}},  // SRC_AS, SRC_MASK, IPV6_SRC_MASK
+		{"dst_", r.DstAddr, &r.DstAS, &r.DstMask, 17, [2]uint16{13, 30}}, // DST_AS, DST_MASK, IPV6_DST_MASK
+	} {
+		if !a.addr.IsValid() {
+			continue
+		}
+		if route, ok := routes.Lookup(a.addr); ok {
+			if *a.as == 0 {
+				*a.as = route.ASN
+				r.SetField(a.asType, binary.BigEndian.AppendUint32(nil, route.ASN))
+			}
+			if *a.mask == 0 {
+				*a.mask = uint8(route.Prefix.Bits())
+				ty := a.maskTypes[1]
+				if a.addr.Unmap().Is4() {
+					ty = a.maskTypes[0]
+				}
+				r.SetField(ty, []byte{*a.mask})
+			}
+		}
+		if *a.mask != 0 {
+			if p, err := a.addr.Unmap().Prefix(int(*a.mask)); err == nil {
+				r.Enrich(a.prefix+"prefix", p.String())
+			}
+		}
+		if name := routes.Name(*a.as); name != "" {
+			r.Enrich(a.prefix+"as_name", name)
+		}
+	}
+}
+
+// siteFile labels record addresses with their local networks. It is nil
+// unless -sites is set.
+var siteFile *sites.File
+
+// EnrichSites attaches the labels of the networks containing the record's
+// source and destination addresses, e.g. src_site and dst_role, and the
+// direction of the flow.
+func EnrichSites(r *flow.Record) {
+	if siteFile == nil {
+		return
+	}
+	m := siteFile.Map()
+	for _, a := range []struct {
+		prefix string
+		addr   netip.Addr
+	}{
+		{"src_", r.SrcAddr},
+		{"dst_", r.DstAddr},
+	} {
+		if _, labels, ok := m.Lookup(a.addr); ok {
+			for k, v := range labels {
+				r.Enrich(a.prefix+k, v)
+			}
+		}
+	}
+	r.Enrich("direction", string(m.Direction(r.SrcAddr, r.DstAddr)))
+}
+
+// interfaces names the interfaces exporters report by ifIndex, from
+// -interfaces and from options data.
+var interfaces = iface.New()
+
+// EnrichInterfaces attaches the name, description, speed and role of the
+// record's input and output interfaces, as in_if_name, in_if_desc,
+// in_if_speed, in_if_role and the same for out_if.
+func EnrichInterfaces(r *flow.Record) {
+	for _, a := range []struct {
+		prefix string
+		index  uint32
+	}{
+		{"in_if_", r.InputIf},
+		{"out_if_", r.OutputIf},
+	} {
+		i, ok := interfaces.Lookup(r.Exporter, a.index)
+		if !ok {
+			continue
+		}
+		if i.Name != "" {
+			r.Enrich(a.prefix+"name", i.Name)
+		}
+		if i.Description != "" {
+			r.Enrich(a.prefix+"desc", i.Description)
+		}
+		if i.Speed != 0 {
+			r.Enrich(a.prefix+"speed", strconv.FormatUint(i.Speed, 10))
+		}
+		if i.Role != "" {
+			r.Enrich(a.prefix+"role", i.Role)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Export protocols a listener can be configured for.
+const (
+	ProtoNFv5  = "nfv5"
+	ProtoNFv9  = "nfv9"
+	ProtoIPFIX = "ipfix"
+	ProtoSFlow = "sflow"
+)
+
+var knownProtocols = map[string]bool{
+	ProtoNFv5:  true,
+	ProtoNFv9:  true,
+	ProtoIPFIX: true,
+	ProtoSFlow: true,
+}
+
+// Listener receives export packets on a single UDP socket.
+type Listener struct {
+	// Name labels every record received on this listener. Defaults to Addr.
+	Name string
+	// Protocol is the export protocol expected on this socket.
+	Protocol string
+	// Network is "udp", "udp4" or "udp6".
+	Network string
+	// Addr is the host:port to listen on.
+	Addr string
+	// Allow is the exporter allowlist. An empty list accepts every source.
+	Allow []*net.IPNet
+
+	conn *net.UDPConn
+
+	mu sync.Mutex
+	// rejected counts the packets dropped by the allowlist by source, for
+	// up to maxRejectedSources sources and the rest under otherSource.
+	rejected map[string]uint64
+}
+
+// maxRejectedSources bounds the rejected sources counted and logged per
+// listener.
+const maxRejectedSources = 1000
+
+// otherSource counts the rejected packets of sources beyond
+// maxRejectedSources.
+const otherSource = "other"
+
+// Packet is a datagram received by a Listener.
+type Packet struct {
+	Listener *Listener
+	Source   *net.UDPAddr
+	Received time.Time
+	Data     []byte
+}
+
+// ParseListener parses a listener specification of the form
+//
+//	[name=NAME,][proto=PROTO,][net=NETWORK,]addr=HOST:PORT[,allow=CIDR]...
+//
+// A bare HOST:PORT is accepted as a NetFlow v9 listener on that address.
+func ParseListener(s string) (*Listener, error) {
+	l := &Listener{
+		Protocol: ProtoNFv9,
+		Network:  "udp",
+	}
+	if !strings.Contains(s, "=") {
+		l.Addr = s
+		l.Name = s
+		return l, nil
+	}
+	for _, kv := range strings.Split(s, ",") {
+		i := strings.Index(kv, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("listener %q: expected key=value, got %q", s, kv)
+		}
+		key, value := kv[:i], kv[i+1:]
+		switch key {
+		case "name":
+			l.Name = value
+		case "proto":
+			if !knownProtocols[value] {
+				return nil, fmt.Errorf("listener %q: unknown protocol %q", s, value)
+			}
+			l.Protocol = value
+		case "net":
+			if value != "udp" && value != "udp4" && value != "udp6" {
+				return nil, fmt.Errorf("listener %q: unknown network %q", s, value)
+			}
+			l.Network = value
+		case "addr":
+			l.Addr = value
+		case "allow":
+			_, ipnet, err := net.ParseCIDR(value)
+			if err != nil {
+				ip := net.ParseIP(value)
+				if ip == nil {
+					return nil, fmt.Errorf("listener %q: bad allow entry %q", s, value)
+				}
+				bits := 8 * net.IPv6len
+				if ip.To4() != nil {
+					ip = ip.To4()
+					bits = 8 * net.IPv4len
+				}
+				ipnet = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+			}
+			l.Allow = append(l.Allow, ipnet)
+		default:
+			return nil, fmt.Errorf("listener %q: unknown key %q", s, key)
+		}
+	}
+	if l.Addr == "" {
+		return nil, fmt.Errorf("listener %q: missing addr", s)
+	}
+	if l.Name == "" {
+		l.Name = l.Addr
+	}
+	return l, nil
+}
+
+// Allowed reports whether packets from ip are accepted by this listener.
+func (l *Listener) Allowed(ip net.IP) bool {
+	if len(l.Allow) == 0 {
+		return true
+	}
+	for _, ipnet := range l.Allow {
+		if ipnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Open binds the listener's socket.
+func (l *Listener) Open() error {
+	addr, err := net.ResolveUDPAddr(l.Network, l.Addr)
+	if err != nil {
+		return err
+	}
+	conn, err := net.ListenUDP(l.Network, addr)
+	if err != nil {
+		return err
+	}
+	l.conn = conn
+	return nil
+}
+
+// Serve reads packets until the socket is closed, sending every packet
+// from an allowed source to out. Packets from other sources are counted
+// and dropped.
+func (l *Listener) Serve(out chan<- Packet) error {
+	var buf [65535]byte
+	for {
+		n, src, err := l.conn.ReadFromUDP(buf[0:])
+		if err != nil {
+			return err
+		}
+		if !l.Allowed(src.IP) {
+			l.reject(src.IP)
+			continue
+		}
+		data := make([]byte, n)
+		copy(data, buf[:n])
+		out <- Packet{
+			Listener: l,
+			Source:   src,
+			Received: time.Now(),
+			Data:     data,
+		}
+	}
+}
+
+func (l *Listener) reject(ip net.IP) {
+	key := ip.String()
+
+	l.mu.Lock()
+	if l.rejected == nil {
+		l.rejected = make(map[string]uint64)
+	}
+	if _, ok := l.rejected[key]; !ok {
+		if len(l.rejected) < maxRejectedSources {
+			fmt.Fprintln(os.Stderr, "Rejected packet from", key, "on listener", l.Name)
+		} else {
+			if l.rejected[otherSource] == 0 {
+				fmt.Fprintln(os.Stderr, "Rejected packets from over", maxRejectedSources, "sources on listener", l.Name+"; counting the rest as", otherSource)
+			}
+			key = otherSource
+		}
+	}
+	l.rejected[key]++
+	l.mu.Unlock()
+
+	metricRejected.WithLabelValues(l.Name).Inc()
+	metricRejectedSources.WithLabelValues(l.Name, key).Inc()
+}
+
+// Close closes the listener's socket, causing Serve to return.
+func (l *Listener) Close() error {
+	if l.conn == nil {
+		return nil
+	}
+	return l.conn.Close()
+}
+
+// listenFlags collects repeated -listen flags.
+type listenFlags []*Listener
+
+func (lf *listenFlags) String() string {
+	var names []string
+	for _, l := range *lf {
+		names = append(names, l.Name)
+	}
+	return strings.Join(names, " ")
+}
+
+func (lf *listenFlags) Set(s string) error {
+	l, err := ParseListener(s)
+	if err != nil {
+		return err
+	}
+	*lf = append(*lf, l)
+	return nil
+}
@@ -4,151 +4,358 @@ import (
 	"bytes"
 	"flag"
 	"fmt"
-	"net"
+	"net/netip"
 	"os"
+	"os/signal"
 	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
+	"github.com/brooksbp/go.netflow/pkg/asn"
+	"github.com/brooksbp/go.netflow/pkg/filter"
+	"github.com/brooksbp/go.netflow/pkg/flow"
+	"github.com/brooksbp/go.netflow/pkg/geoip"
+	"github.com/brooksbp/go.netflow/pkg/iface"
 	"github.com/brooksbp/go.netflow/pkg/net2"
 	"github.com/brooksbp/go.netflow/pkg/nfv9"
+	"github.com/brooksbp/go.netflow/pkg/rdns"
+	"github.com/brooksbp/go.netflow/pkg/rotate"
+	"github.com/brooksbp/go.netflow/pkg/sites"
 )
 
 var (
-	flagListen = flag.String("listen", ":9999", "host:port to listen on.")
+	flagListen     listenFlags
+	flagFormat     = flag.String("format", "text", "Output format: text, json, csv, tsv, archive, parquet or none.")
+	flagColumns    = flag.String("columns", "", "Comma-separated field names and enrichment keys to write in csv and tsv output.")
+	flagRawUnknown = flag.Bool("raw-unknown", false, "Include fields missing from the field map in json output.")
+	flagFilter     = flag.String("filter", "", "Only output records matching this filter expression, e.g. \"proto tcp and dst port 443\".")
+
+	flagOutputFilters = outputFilters{}
+
+	flagOutputDir          = flag.String("output-dir", "", "Write records to rotating files in this directory instead of stdout.")
+	flagRotateInterval     = flag.Duration("rotate-interval", 5*time.Minute, "Time bucket covered by each output file.")
+	flagRotateSize         = flag.Int64("rotate-size", 0, "Rotate output files early after this many uncompressed bytes. 0 disables.")
+	flagCompression        = flag.String("compression", "", "Output file compression: gzip or zstd.")
+	flagPartition          = flag.String("partition", "", "Time layout of the subdirectory of -output-dir each file is written to, e.g. dt=2006-01-02/hour=15.")
+	flagRetainAge          = flag.Duration("retain-age", 0, "Remove output files older than this. 0 keeps them forever.")
+	flagRetainSize         = flag.Int64("retain-size", 0, "Remove the oldest output files while their total size exceeds this many bytes. 0 disables.")
+	flagArchiveCompression = flag.String("archive-compression", "zstd", "Block compression for archive output: none, gzip or zstd.")
+	flagParquetRowGroup    = flag.Int64("parquet-row-group", 0, "Target row group size in bytes for parquet output. 0 uses the default of 128 MiB.")
+
+	flagKafkaBrokers     = flag.String("kafka-brokers", "", "Comma-separated Kafka brokers to publish records to.")
+	flagKafkaTopic       = flag.String("kafka-topic", "flows", "Kafka topic to publish records to.")
+	flagKafkaFormat      = flag.String("kafka-format", "json", "Kafka message serialization: json, protobuf or avro.")
+	flagKafkaKey         = flag.String("kafka-key", "exporter", "Kafka message key: exporter, flow (src/dst pair) or none.")
+	flagKafkaCompression = flag.String("kafka-compression", "", "Kafka compression: gzip, snappy, lz4 or zstd.")
+	flagKafkaBatch       = flag.Int("kafka-batch", 1000, "Maximum number of messages per Kafka request.")
+	flagKafkaBuffer      = flag.Int("kafka-buffer", 100000, "Maximum number of queued Kafka messages; records are dropped when full.")
+	flagKafkaRetries     = flag.Int("kafka-retries", 5, "Number of times a failed Kafka request is retried.")
+
+	flagAggregate         = flag.String("aggregate", "", "Comma-separated key fields to aggregate file, stream and Kafka output by, e.g. src/24,dst/24,proto,dst_port.")
+	flagAggregateWindow   = flag.Duration("aggregate-window", 5*time.Minute, "Length of aggregation windows.")
+	flagAggregateStep     = flag.Duration("aggregate-step", 0, "Start aggregation windows this far apart for sliding windows. 0 gives tumbling windows.")
+	flagAggregateDelay    = flag.Duration("aggregate-delay", 0, "Keep aggregation windows open this long after they end for late records.")
+	flagAggregateFlowTime = flag.Bool("aggregate-flow-time", false, "Place records in aggregation windows by flow end time instead of receive time.")
+	flagBiflow            = flag.Bool("biflow", false, "Stitch the two directions of conversations into one record before file, stream and Kafka output.")
+	flagBiflowTimeout     = flag.Duration("biflow-timeout", 30*time.Second, "How long a record waits for its reverse direction before it is written on its own.")
+	flagSamplingRate      = flag.String("sampling-rate", "", "Sampling rate for aggregation of records without a sampling field, as RATE, EXPORTER=RATE or a comma-separated list of both.")
+
+	flagGRPCListen           = flag.String("grpc-listen", "", "host:port to serve the gRPC FlowService on.")
+	flagFlowMetrics          = flag.String("flow-metrics", "", "Comma-separated dimensions of traffic metrics: listener, exporter, input_if, output_if, protocol, app, src_as, dst_as, direction, src_site, dst_site.")
+	flagFlowMetricsMaxSeries = flag.Int("flow-metrics-max-series", 10000, "Maximum number of traffic metric series; further series are counted as \"other\".")
+	flagFlowMetricsTopK      = flag.Int("flow-metrics-top", 0, "Export only the top N traffic metric series by bytes. 0 exports all.")
+	flagTop                  = flag.String("top", "", "Comma-separated dimensions to answer top-N queries for on /api/top: src, dst, conversation, dst_port, src_as, dst_as, exporter, or \"default\".")
+	flagTopCapacity          = flag.Int("top-capacity", 1000, "Number of keys tracked per top-N dimension and minute.")
+	flagTopHistory           = flag.Duration("top-history", 15*time.Minute, "How far back top-N queries can reach.")
+	flagRDNS                 = flag.Bool("rdns", true, "Enrich records with the reverse DNS names of their addresses, looked up in the background.")
+	flagRDNSServer           = flag.String("rdns-server", "", "host:port of the DNS server for reverse lookups. Defaults to the system resolver.")
+	flagRDNSWorkers          = flag.Int("rdns-workers", 8, "Number of concurrent reverse DNS lookups.")
+	flagRDNSTimeout          = flag.Duration("rdns-timeout", 2*time.Second, "Timeout of each reverse DNS lookup.")
+	flagRDNSTTL              = flag.Duration("rdns-ttl", 5*time.Minute, "How long reverse DNS names are cached.")
+	flagRDNSNegativeTTL      = flag.Duration("rdns-negative-ttl", time.Minute, "How long failed reverse DNS lookups are cached.")
+	flagRDNSCacheSize        = flag.Int("rdns-cache-size", 100000, "Number of addresses in the reverse DNS cache.")
+	flagGeoIPDB              = flag.String("geoip-db", "", "MaxMind DB file, e.g. GeoLite2-City.mmdb, to locate record addresses with.")
+	flagGeoIPReload          = flag.Duration("geoip-reload", time.Minute, "How often to check -geoip-db for changes.")
+	flagASNRoutes            = flag.String("asn-routes", "", "MRT RIB dump or PREFIX,ASN file to fill in the AS and mask of records that lack them from.")
+	flagASNNames             = flag.String("asn-names", "", "File of ASN and AS name pairs to name record ASes with.")
+	flagASNReload            = flag.Duration("asn-reload", time.Minute, "How often to check -asn-routes and -asn-names for changes.")
+	flagSites                = flag.String("sites", "", "File of local network prefixes and labels to tag record addresses and directions with.")
+	flagSitesReload          = flag.Duration("sites-reload", time.Minute, "How often to check -sites for changes.")
+	flagInterfaces           = flag.String("interfaces", "", "File of exporter, ifindex, name, description, speed and role to name interfaces with, in addition to names learned from options data.")
+	flagInterfacesReload     = flag.Duration("interfaces-reload", time.Minute, "How often to check -interfaces for changes.")
+	flagServices             = flag.String("services", "", "Comma-separated files in /etc/services format, e.g. /etc/services, whose port names override the built-in ones.")
+	flagOUI                  = flag.String("oui", "", "Comma-separated IEEE MAC address registry files, e.g. oui.csv, to name the vendors of MAC addresses with.")
+	flagHTTPListen           = flag.String("http-listen", "", "host:port to serve the HTTP API, including /metrics, on.")
 )
 
-type LookupAddrCacheEntry struct {
-	names []string
-	ts    time.Time
-}
-type LookupAddrCache struct {
-	addrs map[string]*LookupAddrCacheEntry
+func init() {
+	flag.Var(&flagListen, "listen", "Listener to open, either host:port or "+
+		"[name=NAME,][proto=nfv9|nfv5|ipfix|sflow,][net=udp|udp4|udp6,]addr=HOST:PORT[,allow=CIDR]... "+
+		"May be repeated. (default \":9999\")")
+	flag.Var(flagOutputFilters, "output-filter", "Only write records matching a filter expression to one output, as "+
+		"NAME=EXPR where NAME is the output format, aggregate, biflow, kafka, flow-metrics, top or grpc. May be repeated.")
 }
 
-const LookupAddrCacheTime = 5 * time.Minute
-
-func (lac *LookupAddrCache) Get(ip string) (*[]string, bool) {
-	if lac.addrs == nil {
-		lac.addrs = make(map[string]*LookupAddrCacheEntry)
-	}
-	now := time.Now()
-	entry, ok := lac.addrs[ip]
-	if !ok || (ok && (now.After(entry.ts.Add(LookupAddrCacheTime)))) {
-		name, err := net.LookupAddr(ip)
-		if err != nil {
-			return nil, false
+// tick periodically finishes idle output files and flushes stages and
+// streams.
+func tick(outputs multiOutput) {
+	for now := range time.Tick(time.Second) {
+		if err := flush(outputs, now); err != nil {
+			fmt.Fprintln(os.Stderr, "Error: ", err)
 		}
-		lac.addrs[ip] = &LookupAddrCacheEntry{
-			names: name,
-			ts:    now,
-		}
-		return &lac.addrs[ip].names, true
 	}
-	return &entry.names, true
 }
 
-var lookup_addr_cache LookupAddrCache
-
-func PrintDataFlowSet(dfs *nfv9.DataFlowSet, tc *nfv9.TemplateCache) {
-	template, ok := tc.Get(dfs.FlowSetID)
-	if !ok {
-		return
-	}
-	for _, record := range dfs.Records {
-		var i int
-		var protocol string
-		for _, field := range template.Fields {
-			ty := int(field.Type)
-			len := int(field.Length)
-
-			entry := nfv9.FieldMap[ty]
-
-			data := record.Fields[i : i+len]
-			dataStr := entry.String(data)
-
-			i += len
-
-			fmt.Print(entry.Name, ": ")
-			switch entry.Name {
-			case "IPV4_SRC_ADDR":
-				fallthrough
-			case "IPV4_DST_ADDR":
-				fallthrough
-			case "IPV4_NEXT_HOP":
-				if names, ok := lookup_addr_cache.Get(dataStr); ok {
-					fmt.Print(*names)
-					fmt.Print(" (", dataStr, ")")
-				} else {
-					fmt.Print(dataStr)
-				}
-			case "PROTOCOL":
-				protocol = dataStr
-				fmt.Print(dataStr)
-			case "L4_SRC_PORT":
-				fallthrough
-			case "L4_DST_PORT":
-				mapped := false
-				if port, err := strconv.Atoi(dataStr); err == nil {
-					if portMapEntry, ok := net2.TCPUDPPortMap[port]; ok {
-						if proto, ok := portMapEntry[protocol]; ok {
-							fmt.Print(proto)
-							mapped = true
-						}
-					}
-				}
-				if !mapped {
-					fmt.Print(dataStr)
-				}
-			default:
-				fmt.Print(dataStr)
+// flush rotates the files of outputs and flushes their streams and
+// stages, each stage before the outputs it writes to.
+func flush(outputs multiOutput, now time.Time) error {
+	var err error
+	for _, o := range outputs {
+		var e error
+		switch o := o.Output.(type) {
+		case *rotate.Writer:
+			e = o.Rotate(now)
+		case *streamOutput:
+			e = o.Flush()
+		case stage:
+			e = o.Flush(now)
+			if e2 := flush(o.next(), now); e == nil {
+				e = e2
 			}
-			fmt.Print(" ")
 		}
-		fmt.Print("\n\n")
+		if e != nil && err == nil {
+			err = e
+		}
 	}
+	return err
 }
 
 func main() {
 	flag.Parse()
 
-	listenStr := *flagListen
+	for _, path := range strings.Split(*flagServices, ",") {
+		if path == "" {
+			continue
+		}
+		if err := net2.LoadServices(path); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+	for _, path := range strings.Split(*flagOUI, ",") {
+		if path == "" {
+			continue
+		}
+		if err := net2.LoadOUIs(path); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+
+	if len(flagListen) == 0 {
+		flagListen.Set(":9999")
+	}
+
+	packets := make(chan Packet, 1024)
+	RegisterQueueMetrics(packets)
+	for _, l := range flagListen {
+		if err := l.Open(); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		if l.Protocol != ProtoNFv9 {
+			fmt.Println("Warning: no", l.Protocol, "decoder; packets on listener", l.Name, "will be dropped")
+		}
+		go func(l *Listener) {
+			if err := l.Serve(packets); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		}(l)
+	}
 
-	addr, err := net.ResolveUDPAddr("udp", listenStr)
+	ingest, err := filter.Compile(*flagFilter)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
-	conn, err := net.ListenUDP("udp", addr)
+
+	if *flagRDNS {
+		resolver = rdns.New(rdns.Config{
+			Workers:     *flagRDNSWorkers,
+			CacheSize:   *flagRDNSCacheSize,
+			TTL:         *flagRDNSTTL,
+			NegativeTTL: *flagRDNSNegativeTTL,
+			Timeout:     *flagRDNSTimeout,
+			Server:      *flagRDNSServer,
+		})
+		RegisterRDNSMetrics(resolver)
+	}
+
+	if *flagGeoIPDB != "" {
+		geoDB, err = geoip.Open(*flagGeoIPDB)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		go geoDB.Watch(*flagGeoIPReload, nil, func(reloaded bool, err error) {
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Error: ", err)
+			} else {
+				fmt.Fprintln(os.Stderr, "Reloaded", *flagGeoIPDB)
+			}
+		})
+	}
+
+	if *flagASNRoutes != "" || *flagASNNames != "" {
+		routes, err = asn.Open(*flagASNRoutes, *flagASNNames)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		go routes.Watch(*flagASNReload, nil, func(reloaded bool, err error) {
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Error: ", err)
+			} else {
+				fmt.Fprintln(os.Stderr, "Reloaded -asn-routes and -asn-names")
+			}
+		})
+	}
+
+	if *flagSites != "" {
+		siteFile, err = sites.Open(*flagSites)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		go siteFile.Watch(*flagSitesReload, nil, func(reloaded bool, err error) {
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Error: ", err)
+			} else {
+				fmt.Fprintln(os.Stderr, "Reloaded", *flagSites)
+			}
+		})
+	}
+
+	if *flagInterfaces != "" {
+		interfaces, err = iface.Open(*flagInterfaces)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		go interfaces.Watch(*flagInterfacesReload, nil, func(reloaded bool, err error) {
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Error: ", err)
+			} else {
+				fmt.Fprintln(os.Stderr, "Reloaded", *flagInterfaces)
+			}
+		})
+	}
+	RegisterInterfaceMetrics(interfaces)
+
+	output, err := NewOutputs(*flagFormat, os.Stdout)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
+	defer output.Close()
 
-	template_cache := nfv9.NewTemplateCache()
-
-	var buf [4096]byte
-	for {
-		n, _, err := conn.ReadFromUDP(buf[0:])
-		if err != nil {
+	// Finish output files on shutdown.
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signals
+		if err := output.Close(); err != nil {
 			fmt.Println(err)
 			os.Exit(1)
 		}
+		os.Exit(0)
+	}()
+
+	if *flagHTTPListen != "" {
+		go func() {
+			if err := ServeHTTP(*flagHTTPListen); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		}()
+	}
+
+	go tick(output)
+
+	// Templates are scoped to the exporter that sent them.
+	template_caches := make(map[string]*nfv9.TemplateCache)
+	sequences := make(sequenceTracker)
+
+	for p := range packets {
+		exporter := p.Source.IP.String()
+		metricPackets.WithLabelValues(p.Listener.Name, exporter).Inc()
+		metricBytes.WithLabelValues(p.Listener.Name, exporter).Add(float64(len(p.Data)))
+
+		if p.Listener.Protocol != ProtoNFv9 {
+			metricUnsupported.WithLabelValues(p.Listener.Name, p.Listener.Protocol).Inc()
+			continue
+		}
+
+		template_cache, ok := template_caches[exporter]
+		if !ok {
+			template_cache = nfv9.NewTemplateCache()
+			template_caches[exporter] = template_cache
+		}
 
-		framer := nfv9.NewFramer(bytes.NewBuffer(buf[:n]), template_cache)
+		framer := nfv9.NewFramer(bytes.NewBuffer(p.Data), template_cache)
 		frame, err := framer.ReadFrame()
 		if err != nil {
-			fmt.Println("Error: ", err, frame)
+			metricDecodeErrors.WithLabelValues(exporter, decodeErrorType(err)).Inc()
+			fmt.Fprintln(os.Stderr, "Error: ", err, frame)
+		}
+		// The header is left zero when the packet is too short for it,
+		// so only a complete v9 header has a sequence number to observe,
+		// even if reading the flowsets after it failed.
+		if frame.Header.Version == nfv9.Version {
+			sequences.Observe(exporter, &frame.Header)
+		}
+		metricTemplates.WithLabelValues(exporter).Set(float64(template_cache.Len()))
+		if *flagFormat == "text" && *flagOutputDir == "" {
+			fmt.Println(frame.Header.String())
 		}
-		fmt.Println(frame.Header.String())
 		for _, fs := range frame.FlowSets {
 			switch flowset := fs.(type) {
-			case nfv9.TemplateFlowSet:
+			case nfv9.TemplateFlowSet, nfv9.OptionsTemplateFlowSet:
 				break
 			case nfv9.DataFlowSet:
-				PrintDataFlowSet(&flowset, template_cache)
+				template, ok := template_cache.Get(flowset.FlowSetID)
+				if !ok {
+					break
+				}
+				exporterAddr, _ := netip.AddrFromSlice(p.Source.IP)
+				if template.Options {
+					for i := range flowset.Records {
+						interfaces.LearnNFV9(exporterAddr, template, &flowset.Records[i])
+					}
+					break
+				}
+				metricRecords.WithLabelValues(exporter, strconv.Itoa(int(flowset.FlowSetID))).Add(float64(len(flowset.Records)))
+				for i := range flowset.Records {
+					r := flow.FromNFV9(&frame.Header, template, &flowset.Records[i])
+					r.Listener = p.Listener.Name
+					r.Exporter = exporterAddr.Unmap()
+					r.Received = p.Received
+					EnrichRoutes(r)
+					EnrichSites(r)
+					if !ingest.Match(r) {
+						metricFiltered.WithLabelValues(exporter).Inc()
+						continue
+					}
+					Enrich(r)
+					if err := output.Write(r); err != nil {
+						fmt.Fprintln(os.Stderr, "Error: ", err)
+					}
+				}
 				break
 			default:
-				fmt.Println("Unknown flowset")
+				fmt.Fprintln(os.Stderr, "Unknown flowset")
 			}
 		}
 	}
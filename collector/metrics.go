@@ -0,0 +1,268 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/brooksbp/go.netflow/pkg/aggregate"
+	"github.com/brooksbp/go.netflow/pkg/flowkafka"
+	"github.com/brooksbp/go.netflow/pkg/iface"
+	"github.com/brooksbp/go.netflow/pkg/nfv9"
+	"github.com/brooksbp/go.netflow/pkg/rdns"
+)
+
+// Collector health metrics.
+var (
+	metricPackets = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "netflow",
+		Name:      "packets_received_total",
+		Help:      "Export packets received, by listener and exporter.",
+	}, []string{"listener", "exporter"})
+	metricBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "netflow",
+		Name:      "bytes_received_total",
+		Help:      "Bytes of export packets received, by listener and exporter.",
+	}, []string{"listener", "exporter"})
+	metricRejected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "netflow",
+		Name:      "packets_rejected_total",
+		Help:      "Packets dropped by a listener's allowlist, by listener.",
+	}, []string{"listener"})
+	metricRejectedSources = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "netflow",
+		Name:      "rejected_packets_total",
+		Help:      "Packets dropped by a listener's allowlist, by listener and source. Sources beyond the first " + strconv.Itoa(maxRejectedSources) + " of a listener are counted as " + otherSource + ".",
+	}, []string{"listener", "source"})
+	metricUnsupported = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "netflow",
+		Name:      "packets_unsupported_total",
+		Help:      "Packets dropped because their listener's protocol has no decoder.",
+	}, []string{"listener", "protocol"})
+	metricDecodeErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "netflow",
+		Name:      "decode_errors_total",
+		Help:      "Export packets that failed to decode, by exporter and error type.",
+	}, []string{"exporter", "type"})
+	metricTemplates = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "netflow",
+		Name:      "templates_cached",
+		Help:      "Templates cached per exporter.",
+	}, []string{"exporter"})
+	metricRecords = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "netflow",
+		Name:      "records_decoded_total",
+		Help:      "Flow records decoded, by exporter and template.",
+	}, []string{"exporter", "template"})
+	metricFiltered = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "netflow",
+		Name:      "records_filtered_total",
+		Help:      "Flow records dropped by the -filter expression, by exporter.",
+	}, []string{"exporter"})
+	metricSequenceLost = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "netflow",
+		Name:      "sequence_lost_packets_total",
+		Help:      "Export packets missing from the sequence numbers received, by exporter and source ID.",
+	}, []string{"exporter", "source_id"})
+	metricOutputErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "netflow",
+		Name:      "output_errors_total",
+		Help:      "Records that an output failed to write, by output.",
+	}, []string{"output"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		metricPackets,
+		metricBytes,
+		metricRejected,
+		metricRejectedSources,
+		metricUnsupported,
+		metricDecodeErrors,
+		metricTemplates,
+		metricRecords,
+		metricFiltered,
+		metricSequenceLost,
+		metricOutputErrors,
+	)
+}
+
+// RegisterQueueMetrics exports the depth of the packet queue between the
+// listeners and the decoder.
+func RegisterQueueMetrics(packets chan Packet) {
+	prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "netflow",
+		Name:      "packet_queue_depth",
+		Help:      "Packets received but not yet decoded.",
+	}, func() float64 {
+		return float64(len(packets))
+	}))
+	prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "netflow",
+		Name:      "packet_queue_capacity",
+		Help:      "Capacity of the packet queue.",
+	}, func() float64 {
+		return float64(cap(packets))
+	}))
+}
+
+// RegisterKafkaMetrics exports the counters of the Kafka producer.
+func RegisterKafkaMetrics(p *flowkafka.Producer) {
+	for _, m := range []struct {
+		name, help string
+		value      func(s flowkafka.Stats) float64
+	}{
+		{"kafka_messages_sent_total", "Messages delivered to Kafka.",
+			func(s flowkafka.Stats) float64 { return float64(s.Sent) }},
+		{"kafka_messages_dropped_total", "Messages dropped because the Kafka queue was full.",
+			func(s flowkafka.Stats) float64 { return float64(s.Dropped) }},
+		{"kafka_messages_failed_total", "Messages dropped after exhausting retries.",
+			func(s flowkafka.Stats) float64 { return float64(s.Failed) }},
+	} {
+		value := m.value
+		prometheus.MustRegister(prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: "netflow",
+			Name:      m.name,
+			Help:      m.help,
+		}, func() float64 {
+			return value(p.Stats())
+		}))
+	}
+	prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "netflow",
+		Name:      "kafka_queue_depth",
+		Help:      "Messages queued for Kafka.",
+	}, func() float64 {
+		return float64(p.Stats().Queued)
+	}))
+}
+
+// RegisterAggregateMetrics exports the records dropped by aggregation for
+// arriving late.
+func RegisterAggregateMetrics(a *aggregate.Aggregator) {
+	prometheus.MustRegister(prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: "netflow",
+		Name:      "aggregate_late_records_total",
+		Help:      "Records dropped by aggregation because their window had already been emitted, once per window.",
+	}, func() float64 {
+		return float64(a.Late())
+	}))
+}
+
+// decodeErrorType maps a ReadFrame error to a metric label.
+func decodeErrorType(err error) string {
+	switch {
+	case errors.Is(err, nfv9.ErrUnknownTemplate):
+		return "unknown_template"
+	case errors.Is(err, nfv9.ErrTruncated):
+		return "truncated"
+	case errors.Is(err, nfv9.ErrBadVersion):
+		return "bad_version"
+	case errors.Is(err, nfv9.ErrBadTemplate):
+		return "bad_template"
+	}
+	return "other"
+}
+
+// sequenceKey identifies an exporter's sequence number space.
+type sequenceKey struct {
+	exporter string
+	sourceID uint32
+}
+
+// sequenceTracker counts export packets lost between the sequence
+// numbers received from each exporter.
+type sequenceTracker map[sequenceKey]uint32
+
+func (st sequenceTracker) Observe(exporter string, h *nfv9.Header) {
+	key := sequenceKey{exporter, h.SourceID}
+	last, ok := st[key]
+	st[key] = h.SequenceNumber
+	if !ok {
+		return
+	}
+	// Reordered, duplicated or restarted exporters move the sequence
+	// number backwards; only count forward gaps.
+	gap := h.SequenceNumber - last - 1
+	if h.SequenceNumber > last && gap > 0 {
+		metricSequenceLost.WithLabelValues(exporter, strconv.FormatUint(uint64(h.SourceID), 10)).Add(float64(gap))
+	}
+}
+
+// RegisterRDNSMetrics exports the counters of the reverse DNS resolver.
+func RegisterRDNSMetrics(r *rdns.Resolver) {
+	for _, m := range []struct {
+		name, help string
+		value      func(s rdns.Stats) float64
+	}{
+		{"rdns_cache_hits_total", "Reverse DNS lookups answered from the cache.",
+			func(s rdns.Stats) float64 { return float64(s.Hits) }},
+		{"rdns_cache_misses_total", "Reverse DNS lookups that were not cached or had expired.",
+			func(s rdns.Stats) float64 { return float64(s.Misses) }},
+		{"rdns_lookups_dropped_total", "Reverse DNS lookups dropped because the queue was full.",
+			func(s rdns.Stats) float64 { return float64(s.Dropped) }},
+		{"rdns_lookup_failures_total", "Reverse DNS queries that failed or timed out.",
+			func(s rdns.Stats) float64 { return float64(s.Failures) }},
+	} {
+		value := m.value
+		prometheus.MustRegister(prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: "netflow",
+			Name:      m.name,
+			Help:      m.help,
+		}, func() float64 {
+			return value(r.Stats())
+		}))
+	}
+	prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "netflow",
+		Name:      "rdns_cache_size",
+		Help:      "Addresses in the reverse DNS cache.",
+	}, func() float64 {
+		return float64(r.Stats().Cached)
+	}))
+}
+
+// interfaceSpeed exports the speed of each named interface, so that
+// traffic by input_if or output_if can be divided by it for utilization.
+type interfaceSpeed struct {
+	t    *iface.Table
+	desc *prometheus.Desc
+}
+
+// RegisterInterfaceMetrics exports the speeds of the interfaces in t.
+func RegisterInterfaceMetrics(t *iface.Table) {
+	prometheus.MustRegister(&interfaceSpeed{
+		t: t,
+		desc: prometheus.NewDesc("netflow_interface_speed_bits",
+			"Interface speed in bits per second, by exporter (* for every exporter) and ifIndex.",
+			[]string{"exporter", "if_index", "name", "role"}, nil),
+	})
+}
+
+func (s *interfaceSpeed) Describe(ch chan<- *prometheus.Desc) {
+	ch <- s.desc
+}
+
+func (s *interfaceSpeed) Collect(ch chan<- prometheus.Metric) {
+	s.t.Each(func(k iface.Key, i iface.Interface) bool {
+		if i.Speed == 0 {
+			return true
+		}
+		exporter := "*"
+		if k.Exporter.IsValid() {
+			exporter = k.Exporter.String()
+		}
+		ch <- prometheus.MustNewConstMetric(s.desc, prometheus.GaugeValue, float64(i.Speed),
+			exporter, strconv.FormatUint(uint64(k.Index), 10), i.Name, i.Role)
+		return true
+	})
+}
+
+// ServeHTTP serves the collector's HTTP API on addr.
+func ServeHTTP(addr string) error {
+	http.Handle("/metrics", promhttp.Handler())
+	return http.ListenAndServe(addr, nil)
+}
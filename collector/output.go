@@ -0,0 +1,498 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/brooksbp/go.netflow/pkg/aggregate"
+	"github.com/brooksbp/go.netflow/pkg/archive"
+	"github.com/brooksbp/go.netflow/pkg/biflow"
+	"github.com/brooksbp/go.netflow/pkg/filter"
+	"github.com/brooksbp/go.netflow/pkg/flow"
+	"github.com/brooksbp/go.netflow/pkg/flowgrpc"
+	"github.com/brooksbp/go.netflow/pkg/flowkafka"
+	"github.com/brooksbp/go.netflow/pkg/flowmetrics"
+	"github.com/brooksbp/go.netflow/pkg/flowparquet"
+	"github.com/brooksbp/go.netflow/pkg/flowpb"
+	"github.com/brooksbp/go.netflow/pkg/rotate"
+	"github.com/brooksbp/go.netflow/pkg/topn"
+)
+
+// Output receives decoded flow records.
+type Output interface {
+	Write(r *flow.Record) error
+	Close() error
+}
+
+// NewEncoder returns a rotate.Encoder writing records to w in the named
+// format.
+func NewEncoder(format string, w io.Writer) (rotate.Encoder, error) {
+	switch format {
+	case "text":
+		return flow.NewTextEncoder(w), nil
+	case "json":
+		enc := flow.NewJSONEncoder(w)
+		enc.RawUnknown = *flagRawUnknown
+		return enc, nil
+	case "csv", "tsv":
+		comma := ','
+		if format == "tsv" {
+			comma = '\t'
+		}
+		var columns []string
+		if *flagColumns != "" {
+			columns = strings.Split(*flagColumns, ",")
+		}
+		return flow.NewCSVEncoder(w, comma, columns)
+	case "archive":
+		compression, err := archive.ParseCompression(*flagArchiveCompression)
+		if err != nil {
+			return nil, err
+		}
+		aw, err := archive.NewWriter(w, compression)
+		if err != nil {
+			return nil, err
+		}
+		return &archiveEncoder{aw}, nil
+	case "parquet":
+		return flowparquet.NewEncoder(w, *flagParquetRowGroup)
+	}
+	return nil, fmt.Errorf("unknown output format %q", format)
+}
+
+// formatExt maps output formats to file name extensions.
+var formatExt = map[string]string{
+	"text":    ".txt",
+	"json":    ".json",
+	"csv":     ".csv",
+	"tsv":     ".tsv",
+	"archive": ".nfa",
+	"parquet": ".parquet",
+}
+
+// NewOutputs returns the outputs selected by flags: records in the
+// selected format to rotating files when -output-dir is set or to w
+// otherwise (unless the format is "none"), and to Kafka when
+// -kafka-brokers is set. When -aggregate or -biflow is set, those outputs
+// receive aggregated or stitched records; traffic metrics, top-N and gRPC
+// subscribers always see every record.
+func NewOutputs(format string, w io.Writer) (multiOutput, error) {
+	var outputs multiOutput
+	if format != "none" {
+		o, err := NewOutput(format, w)
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, newNamedOutput(format, o))
+	}
+	if *flagKafkaBrokers != "" {
+		o, err := NewKafkaOutput()
+		if err != nil {
+			outputs.Close()
+			return nil, err
+		}
+		outputs = append(outputs, newNamedOutput("kafka", o))
+	}
+	if *flagAggregate != "" {
+		o, err := NewAggregateOutput(outputs)
+		if err != nil {
+			outputs.Close()
+			return nil, err
+		}
+		outputs = multiOutput{newNamedOutput("aggregate", o)}
+	}
+	if *flagBiflow {
+		o, err := NewBiflowOutput(outputs)
+		if err != nil {
+			outputs.Close()
+			return nil, err
+		}
+		outputs = multiOutput{newNamedOutput("biflow", o)}
+	}
+	if *flagFlowMetrics != "" {
+		a, err := flowmetrics.New(flowmetrics.Config{
+			Dimensions: strings.Split(*flagFlowMetrics, ","),
+			MaxSeries:  *flagFlowMetricsMaxSeries,
+			TopK:       *flagFlowMetricsTopK,
+		})
+		if err != nil {
+			outputs.Close()
+			return nil, err
+		}
+		prometheus.MustRegister(a)
+		outputs = append(outputs, newNamedOutput("flow-metrics", a))
+	}
+	if *flagTop != "" {
+		var dims []string
+		if *flagTop != "default" {
+			dims = strings.Split(*flagTop, ",")
+		}
+		t, err := topn.NewTracker(topn.Config{
+			Dimensions: dims,
+			Capacity:   *flagTopCapacity,
+			History:    *flagTopHistory,
+		})
+		if err != nil {
+			outputs.Close()
+			return nil, err
+		}
+		http.Handle("/api/top", t)
+		outputs = append(outputs, newNamedOutput("top", t))
+	}
+	if *flagGRPCListen != "" {
+		o, err := NewGRPCOutput(*flagGRPCListen)
+		if err != nil {
+			outputs.Close()
+			return nil, err
+		}
+		outputs = append(outputs, newNamedOutput("grpc", o))
+	}
+	for name := range flagOutputFilters {
+		if !outputs.has(name) {
+			outputs.Close()
+			return nil, fmt.Errorf("-output-filter: no output named %q", name)
+		}
+	}
+	return outputs, nil
+}
+
+// stage is an Output that processes records before writing them to
+// further outputs.
+type stage interface {
+	Output
+	// Flush writes the records the stage is done with.
+	Flush(now time.Time) error
+	next() multiOutput
+}
+
+// aggregateOutput aggregates records before writing them to its outputs.
+type aggregateOutput struct {
+	*aggregate.Aggregator
+	outputs multiOutput
+}
+
+// NewAggregateOutput returns an Output that aggregates records as
+// configured by the -aggregate flags and writes the aggregates to outputs.
+func NewAggregateOutput(outputs multiOutput) (*aggregateOutput, error) {
+	rate, rates, err := parseSamplingRates(*flagSamplingRate)
+	if err != nil {
+		return nil, err
+	}
+	// Records reach aggregation up to -biflow-timeout late when they
+	// wait for their reverse direction.
+	delay := *flagAggregateDelay
+	if *flagBiflow && delay < *flagBiflowTimeout {
+		delay = *flagBiflowTimeout
+	}
+	a, err := aggregate.New(aggregate.Config{
+		Key:                   *flagAggregate,
+		Window:                *flagAggregateWindow,
+		Step:                  *flagAggregateStep,
+		FlowTime:              *flagAggregateFlowTime,
+		Delay:                 delay,
+		SamplingRate:          rate,
+		ExporterSamplingRates: rates,
+		Emit:                  outputs.Write,
+	})
+	if err != nil {
+		return nil, err
+	}
+	RegisterAggregateMetrics(a)
+	return &aggregateOutput{a, outputs}, nil
+}
+
+// Close emits every open window and closes the outputs.
+func (o *aggregateOutput) Close() error {
+	err := o.Aggregator.Close()
+	if e := o.outputs.Close(); e != nil && err == nil {
+		err = e
+	}
+	return err
+}
+
+func (o *aggregateOutput) next() multiOutput {
+	return o.outputs
+}
+
+// biflowOutput stitches records with their reverse direction before
+// writing them to its outputs.
+type biflowOutput struct {
+	*biflow.Stitcher
+	outputs multiOutput
+}
+
+// NewBiflowOutput returns an Output that stitches records as configured by
+// the -biflow flags and writes the result to outputs.
+func NewBiflowOutput(outputs multiOutput) (*biflowOutput, error) {
+	s, err := biflow.New(biflow.Config{
+		Timeout: *flagBiflowTimeout,
+		Emit:    outputs.Write,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &biflowOutput{s, outputs}, nil
+}
+
+// Close emits every pending record and closes the outputs.
+func (o *biflowOutput) Close() error {
+	err := o.Stitcher.Close()
+	if e := o.outputs.Close(); e != nil && err == nil {
+		err = e
+	}
+	return err
+}
+
+func (o *biflowOutput) next() multiOutput {
+	return o.outputs
+}
+
+// parseSamplingRates parses a -sampling-rate value into the default rate
+// and per-exporter rates.
+func parseSamplingRates(s string) (uint32, map[netip.Addr]uint32, error) {
+	var rate uint32
+	rates := make(map[netip.Addr]uint32)
+	if s == "" {
+		return rate, rates, nil
+	}
+	for _, term := range strings.Split(s, ",") {
+		exporter, value, ok := strings.Cut(term, "=")
+		if !ok {
+			value = exporter
+		}
+		n, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return 0, nil, fmt.Errorf("bad sampling rate %q", term)
+		}
+		if !ok {
+			rate = uint32(n)
+			continue
+		}
+		addr, err := netip.ParseAddr(exporter)
+		if err != nil {
+			return 0, nil, fmt.Errorf("bad sampling rate %q: %v", term, err)
+		}
+		rates[addr] = uint32(n)
+	}
+	return rate, rates, nil
+}
+
+// grpcOutput streams records to FlowService subscribers.
+type grpcOutput struct {
+	*flowgrpc.Server
+	srv *grpc.Server
+}
+
+// NewGRPCOutput serves the FlowService on addr.
+func NewGRPCOutput(addr string) (*grpcOutput, error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	o := &grpcOutput{
+		Server: flowgrpc.NewServer(),
+		srv:    grpc.NewServer(),
+	}
+	flowpb.RegisterFlowServiceServer(o.srv, o.Server)
+	reflection.Register(o.srv)
+	go func() {
+		if err := o.srv.Serve(lis); err != nil {
+			fmt.Fprintln(os.Stderr, "Error: ", err)
+		}
+	}()
+	return o, nil
+}
+
+func (o *grpcOutput) Close() error {
+	o.Server.Close()
+	o.srv.GracefulStop()
+	return nil
+}
+
+// NewKafkaOutput returns a producer publishing records to -kafka-topic.
+func NewKafkaOutput() (*flowkafka.Producer, error) {
+	ser, err := flowkafka.NewSerializer(*flagKafkaFormat)
+	if err != nil {
+		return nil, err
+	}
+	client, err := flowkafka.NewKafkaClient(strings.Split(*flagKafkaBrokers, ","), *flagKafkaTopic, *flagKafkaCompression)
+	if err != nil {
+		return nil, err
+	}
+	p, err := flowkafka.NewProducer(client, ser, flowkafka.Config{
+		Key:        *flagKafkaKey,
+		BatchSize:  *flagKafkaBatch,
+		BufferSize: *flagKafkaBuffer,
+		MaxRetries: *flagKafkaRetries,
+	})
+	if err != nil {
+		return nil, err
+	}
+	RegisterKafkaMetrics(p)
+	return p, nil
+}
+
+// NewOutput returns an Output in the given format: rotating files when
+// -output-dir is set, otherwise w.
+func NewOutput(format string, w io.Writer) (Output, error) {
+	if _, err := NewEncoder(format, io.Discard); err != nil {
+		return nil, err
+	}
+	if *flagCompression != "" {
+		switch format {
+		case "archive":
+			return nil, fmt.Errorf("-compression cannot be used with -format archive, which compresses its blocks; see -archive-compression")
+		case "parquet":
+			return nil, fmt.Errorf("-compression cannot be used with -format parquet, which compresses its pages")
+		}
+	}
+	if *flagOutputDir == "" {
+		enc, _ := NewEncoder(format, w)
+		return &streamOutput{enc: enc}, nil
+	}
+	return rotate.NewWriter(rotate.Config{
+		Dir:          *flagOutputDir,
+		Ext:          formatExt[format],
+		Partition:    *flagPartition,
+		Interval:     *flagRotateInterval,
+		MaxSize:      *flagRotateSize,
+		Compression:  *flagCompression,
+		MaxAge:       *flagRetainAge,
+		MaxTotalSize: *flagRetainSize,
+		NewEncoder: func(w io.Writer) (rotate.Encoder, error) {
+			return NewEncoder(format, w)
+		},
+	})
+}
+
+// namedOutput is an Output labelled for metrics and -output-filter.
+type namedOutput struct {
+	name string
+	Output
+	filter *filter.Filter
+}
+
+func newNamedOutput(name string, o Output) namedOutput {
+	return namedOutput{name, o, flagOutputFilters[name]}
+}
+
+// outputFilters collects repeated -output-filter flags by output name.
+type outputFilters map[string]*filter.Filter
+
+func (of outputFilters) String() string {
+	var terms []string
+	for name, f := range of {
+		terms = append(terms, name+"="+f.String())
+	}
+	return strings.Join(terms, " ")
+}
+
+func (of outputFilters) Set(s string) error {
+	name, expr, ok := strings.Cut(s, "=")
+	if !ok {
+		return fmt.Errorf("expected NAME=EXPR, got %q", s)
+	}
+	f, err := filter.Compile(expr)
+	if err != nil {
+		return err
+	}
+	of[name] = f
+	return nil
+}
+
+// multiOutput writes every record to each of its outputs.
+type multiOutput []namedOutput
+
+// has reports whether m, or a stage in m, has an output called name.
+func (m multiOutput) has(name string) bool {
+	for _, o := range m {
+		if o.name == name {
+			return true
+		}
+		if st, ok := o.Output.(stage); ok && st.next().has(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m multiOutput) Write(r *flow.Record) error {
+	var err error
+	for _, o := range m {
+		if !o.filter.Match(r) {
+			continue
+		}
+		if e := o.Write(r); e != nil {
+			metricOutputErrors.WithLabelValues(o.name).Inc()
+			if err == nil {
+				err = e
+			}
+		}
+	}
+	return err
+}
+
+func (m multiOutput) Close() error {
+	var err error
+	for _, o := range m {
+		if e := o.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// streamOutput encodes records to a single stream. Records arrive from
+// the packet loop, from the flush ticker via aggregation and biflows, and
+// Close from the signal handler, so encoding is serialized.
+type streamOutput struct {
+	mu  sync.Mutex
+	enc rotate.Encoder
+}
+
+func (o *streamOutput) Write(r *flow.Record) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.enc.Encode(r)
+}
+
+// Flush writes the records buffered by an encoder that writes in blocks,
+// so that readers of the stream see them before the block fills.
+func (o *streamOutput) Flush() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if f, ok := o.enc.(interface{ Flush() error }); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+func (o *streamOutput) Close() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if c, ok := o.enc.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// archiveEncoder adapts an archive.Writer to rotate.Encoder.
+type archiveEncoder struct {
+	*archive.Writer
+}
+
+func (e *archiveEncoder) Encode(r *flow.Record) error {
+	return e.Write(r)
+}
@@ -0,0 +1,171 @@
+// Command flowcat dumps and filters flow archives written by the
+// collector's archive output.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/archive"
+	"github.com/brooksbp/go.netflow/pkg/filter"
+	"github.com/brooksbp/go.netflow/pkg/flow"
+	"github.com/brooksbp/go.netflow/pkg/net2"
+)
+
+var (
+	flagFormat   = flag.String("format", "text", "Output format: text, json, csv or tsv.")
+	flagColumns  = flag.String("columns", "", "Comma-separated field names to write in csv and tsv output.")
+	flagStart    = flag.String("start", "", "Only print flows ending at or after this RFC 3339 time.")
+	flagEnd      = flag.String("end", "", "Only print flows starting before this RFC 3339 time.")
+	flagExporter = flag.String("exporter", "", "Only print flows from this exporter address.")
+	flagFilter   = flag.String("filter", "", "Only print flows matching this filter expression, e.g. \"proto tcp and dst port 443\".")
+	flagIndex    = flag.Bool("index", false, "Print the block index instead of records.")
+	flagServices = flag.String("services", "", "Comma-separated files in /etc/services format whose port names override the built-in ones.")
+	flagOUI      = flag.String("oui", "", "Comma-separated IEEE MAC address registry files, e.g. oui.csv, to name the vendors of MAC addresses with.")
+)
+
+type encoder interface {
+	Encode(r *flow.Record) error
+}
+
+func newEncoder(format string, w io.Writer) (encoder, error) {
+	switch format {
+	case "text":
+		return flow.NewTextEncoder(w), nil
+	case "json":
+		enc := flow.NewJSONEncoder(w)
+		enc.RawUnknown = true
+		return enc, nil
+	case "csv", "tsv":
+		comma := ','
+		if format == "tsv" {
+			comma = '\t'
+		}
+		var columns []string
+		if *flagColumns != "" {
+			columns = strings.Split(*flagColumns, ",")
+		}
+		return flow.NewCSVEncoder(w, comma, columns)
+	}
+	return nil, fmt.Errorf("unknown output format %q", format)
+}
+
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: flowcat [flags] archive...")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	for _, path := range strings.Split(*flagServices, ",") {
+		if path == "" {
+			continue
+		}
+		if err := net2.LoadServices(path); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+	for _, path := range strings.Split(*flagOUI, ",") {
+		if path == "" {
+			continue
+		}
+		if err := net2.LoadOUIs(path); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+
+	start, err := parseTime(*flagStart)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	end, err := parseTime(*flagEnd)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	flt, err := filter.Compile(*flagFilter)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	enc, err := newEncoder(*flagFormat, os.Stdout)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	for _, name := range flag.Args() {
+		if err := dump(name, enc, flt, start, end); err != nil {
+			fmt.Println(name+":", err)
+			os.Exit(1)
+		}
+	}
+}
+
+func dump(name string, enc encoder, flt *filter.Filter, start, end time.Time) error {
+	f, err := archive.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if *flagIndex {
+		for _, b := range f.Blocks() {
+			fmt.Println(name, "offset", b.Offset, "count", b.Count,
+				b.MinTime.UTC().Format(time.RFC3339), b.MaxTime.UTC().Format(time.RFC3339))
+		}
+		return nil
+	}
+
+	f.SetRange(start, end)
+	f.SetFilter(flt.Match)
+	for {
+		r, err := f.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !match(r, start, end) {
+			continue
+		}
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+	}
+}
+
+func match(r *flow.Record, start, end time.Time) bool {
+	first, last := r.Start, r.End
+	if first.IsZero() {
+		first = r.Received
+	}
+	if last.IsZero() {
+		last = r.Received
+	}
+	if !start.IsZero() && last.Before(start) {
+		return false
+	}
+	if !end.IsZero() && !first.Before(end) {
+		return false
+	}
+	if *flagExporter != "" && r.Exporter.String() != *flagExporter {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,81 @@
+// Command flowtop prints top-N talkers, conversations, ports or ASNs from
+// a running collector's /api/top endpoint.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/topn"
+)
+
+var (
+	flagAddr   = flag.String("addr", "http://localhost:9100", "Base URL of the collector's HTTP API.")
+	flagBy     = flag.String("by", "src", "Dimension to rank: src, dst, conversation, dst_port, src_as, dst_as or exporter.")
+	flagMetric = flag.String("metric", "bytes", "Metric to rank by: bytes, packets or flows.")
+	flagN      = flag.Int("n", 10, "Number of entries to print.")
+	flagWindow = flag.Duration("window", 5*time.Minute, "How far back to look.")
+	flagWatch  = flag.Duration("watch", 0, "Refresh at this interval instead of printing once.")
+)
+
+func query() (*topn.Result, error) {
+	q := url.Values{}
+	q.Set("by", *flagBy)
+	q.Set("metric", *flagMetric)
+	q.Set("n", strconv.Itoa(*flagN))
+	q.Set("window", flagWindow.String())
+	resp, err := http.Get(*flagAddr + "/api/top?" + q.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("%s: %s", resp.Status, msg)
+	}
+	var res topn.Result
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func printResult(res *topn.Result) {
+	fmt.Printf("Top %s by %s, %s to %s\n", res.Dimension, res.Metric,
+		res.Start.Local().Format(time.TimeOnly), res.End.Local().Format(time.TimeOnly))
+	for i, it := range res.Items {
+		fmt.Printf("%3d  %-45s %15d", i+1, it.Key, it.Value)
+		if it.Error > 0 {
+			fmt.Printf("  ±%d", it.Error)
+		}
+		fmt.Println()
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	for {
+		res, err := query()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		if *flagWatch > 0 {
+			// Clear the terminal between refreshes.
+			fmt.Print("\033[H\033[2J")
+		}
+		printResult(res)
+		if *flagWatch <= 0 {
+			return
+		}
+		time.Sleep(*flagWatch)
+	}
+}
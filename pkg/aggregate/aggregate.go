@@ -0,0 +1,394 @@
+// Package aggregate sums flow records into tumbling or sliding time
+// windows keyed by a subset of their fields, emitting one record per key
+// when a window closes.
+package aggregate
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+	"github.com/brooksbp/go.netflow/pkg/net2"
+)
+
+// key identifies an aggregate within a window. Fields that are not part
+// of the configured key are left zero.
+type key struct {
+	listener string
+	exporter netip.Addr
+	src      netip.Addr
+	dst      netip.Addr
+	nextHop  netip.Addr
+	srcMask  uint8
+	dstMask  uint8
+	srcPort  uint16
+	dstPort  uint16
+	proto    uint8
+	tos      uint8
+	srcAS    uint32
+	dstAS    uint32
+	inputIf  uint32
+	outputIf uint32
+}
+
+// selector copies one key field from a record.
+type selector func(r *flow.Record, k *key)
+
+var selectors = map[string]selector{
+	"listener":  func(r *flow.Record, k *key) { k.listener = r.Listener },
+	"exporter":  func(r *flow.Record, k *key) { k.exporter = r.Exporter },
+	"next_hop":  func(r *flow.Record, k *key) { k.nextHop = r.NextHop },
+	"src_port":  func(r *flow.Record, k *key) { k.srcPort = r.SrcPort },
+	"dst_port":  func(r *flow.Record, k *key) { k.dstPort = r.DstPort },
+	"proto":     func(r *flow.Record, k *key) { k.proto = r.Protocol },
+	"tos":       func(r *flow.Record, k *key) { k.tos = r.TOS },
+	"src_as":    func(r *flow.Record, k *key) { k.srcAS = r.SrcAS },
+	"dst_as":    func(r *flow.Record, k *key) { k.dstAS = r.DstAS },
+	"input_if":  func(r *flow.Record, k *key) { k.inputIf = r.InputIf },
+	"output_if": func(r *flow.Record, k *key) { k.outputIf = r.OutputIf },
+	"service": func(r *flow.Record, k *key) {
+		k.proto = r.Protocol
+		k.dstPort, _ = net2.DefaultClassifier.ServicePort(r.Protocol, r.SrcPort, r.DstPort)
+	},
+}
+
+// addrSelector returns a selector for the source or destination address
+// masked to bits4 for IPv4 and bits6 for IPv6. IPv4-mapped IPv6 addresses
+// are masked, and emitted, as IPv4.
+func addrSelector(dst bool, bits4, bits6 int) selector {
+	mask := func(a netip.Addr) (netip.Addr, uint8) {
+		if !a.IsValid() {
+			return a, 0
+		}
+		a = a.Unmap()
+		bits := bits6
+		if a.Is4() {
+			bits = bits4
+		}
+		p, _ := a.Prefix(bits)
+		return p.Addr(), uint8(bits)
+	}
+	if dst {
+		return func(r *flow.Record, k *key) { k.dst, k.dstMask = mask(r.DstAddr) }
+	}
+	return func(r *flow.Record, k *key) { k.src, k.srcMask = mask(r.SrcAddr) }
+}
+
+// parseKey parses a comma-separated list of key fields, see Config.Key.
+func parseKey(s string) ([]selector, error) {
+	var sels []selector
+	names := make(map[string]bool)
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		names[name] = true
+		if names["service"] && (names["src_port"] || names["dst_port"]) {
+			return nil, fmt.Errorf("aggregate: service cannot be combined with src_port or dst_port")
+		}
+		if sel, ok := selectors[name]; ok {
+			sels = append(sels, sel)
+			continue
+		}
+		parts := strings.Split(name, "/")
+		if parts[0] != "src" && parts[0] != "dst" || len(parts) > 3 {
+			return nil, fmt.Errorf("aggregate: unknown key field %q", name)
+		}
+		bits := []int{32, 128}
+		for i, p := range parts[1:] {
+			n, err := strconv.Atoi(p)
+			if err != nil || n < 0 || n > bits[i] {
+				return nil, fmt.Errorf("aggregate: bad prefix length in %q", name)
+			}
+			bits[i] = n
+		}
+		sels = append(sels, addrSelector(parts[0] == "dst", bits[0], bits[1]))
+	}
+	return sels, nil
+}
+
+// Config describes how records are aggregated.
+type Config struct {
+	// Key is a comma-separated list of the fields records are grouped by:
+	// listener, exporter, src, dst, next_hop, src_port, dst_port, proto,
+	// tos, src_as, dst_as, input_if, output_if and service. src and dst
+	// may be followed by prefix lengths: "src/24" masks IPv4 sources to
+	// /24, and "dst/24/64" also masks IPv6 destinations to /64. service
+	// groups by protocol and the service port chosen by
+	// net2.DefaultClassifier, emitted as proto and dst_port, so flows in
+	// either direction and from any client port share a key.
+	Key string
+	// Window is the length of each window.
+	Window time.Duration
+	// Step is how far consecutive windows start apart. Zero, or a Step
+	// equal to Window, gives tumbling windows; a smaller Step gives
+	// overlapping sliding windows, and every record is counted in each
+	// window that covers it. Window must be a multiple of Step.
+	Step time.Duration
+	// FlowTime places records by the end time of the flow instead of the
+	// time they were received.
+	FlowTime bool
+	// Delay keeps windows open this long after they end so that late
+	// records can still be counted. Later records are dropped.
+	Delay time.Duration
+	// SamplingRate scales bytes and packets of records that carry no
+	// SAMPLING_INTERVAL or FLOW_SAMPLER_RANDOM_INTERVAL field. Zero means
+	// 1. ExporterSamplingRates overrides it per exporter.
+	SamplingRate          uint32
+	ExporterSamplingRates map[netip.Addr]uint32
+	// Emit receives the aggregated records of each closed window.
+	Emit func(r *flow.Record) error
+}
+
+// aggregate holds the sums of one key in one window.
+type aggregate struct {
+	bytes, packets, flows uint64
+}
+
+// window holds the aggregates of one window, keyed by its start.
+type window struct {
+	start time.Time
+	aggs  map[key]*aggregate
+}
+
+// Aggregator sums records into windows. It is safe for concurrent use.
+type Aggregator struct {
+	cfg  Config
+	sels []selector
+
+	mu      sync.Mutex
+	windows map[time.Time]*window
+	// closed is the end of the latest window that has been emitted;
+	// records before it are late.
+	closed time.Time
+	late   uint64
+}
+
+// New returns an Aggregator for cfg.
+func New(cfg Config) (*Aggregator, error) {
+	sels, err := parseKey(cfg.Key)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Window <= 0 {
+		return nil, fmt.Errorf("aggregate: window must be positive")
+	}
+	if cfg.Step <= 0 {
+		cfg.Step = cfg.Window
+	}
+	if cfg.Window%cfg.Step != 0 {
+		return nil, fmt.Errorf("aggregate: window %v is not a multiple of step %v", cfg.Window, cfg.Step)
+	}
+	if cfg.Emit == nil {
+		return nil, fmt.Errorf("aggregate: missing Emit")
+	}
+	return &Aggregator{
+		cfg:     cfg,
+		sels:    sels,
+		windows: make(map[time.Time]*window),
+	}, nil
+}
+
+// samplingRate returns the rate r was sampled at.
+func (a *Aggregator) samplingRate(r *flow.Record) uint64 {
+	for _, ty := range []uint16{34, 50} { // SAMPLING_INTERVAL, FLOW_SAMPLER_RANDOM_INTERVAL
+		if f, ok := r.Field(ty); ok && f.Uint() > 0 {
+			return f.Uint()
+		}
+	}
+	if rate, ok := a.cfg.ExporterSamplingRates[r.Exporter]; ok && rate > 0 {
+		return uint64(rate)
+	}
+	if a.cfg.SamplingRate > 0 {
+		return uint64(a.cfg.SamplingRate)
+	}
+	return 1
+}
+
+// Write adds r to every open window that covers it.
+func (a *Aggregator) Write(r *flow.Record) error {
+	t := r.Received
+	if a.cfg.FlowTime && !r.End.IsZero() {
+		t = r.End
+	}
+	if t.IsZero() {
+		t = time.Now()
+	}
+	var k key
+	for _, sel := range a.sels {
+		sel(r, &k)
+	}
+	rate := a.samplingRate(r)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	first := t.Truncate(a.cfg.Step)
+	for start := first; start.After(t.Add(-a.cfg.Window)); start = start.Add(-a.cfg.Step) {
+		if !start.Add(a.cfg.Window).After(a.closed) {
+			a.late++
+			continue
+		}
+		w, ok := a.windows[start]
+		if !ok {
+			w = &window{start: start, aggs: make(map[key]*aggregate)}
+			a.windows[start] = w
+		}
+		agg, ok := w.aggs[k]
+		if !ok {
+			agg = &aggregate{}
+			w.aggs[k] = agg
+		}
+		agg.bytes += r.Bytes * rate
+		agg.packets += r.Packets * rate
+		agg.flows += r.Flows
+	}
+	return nil
+}
+
+// Late returns the number of times a record arrived for a window that had
+// already been emitted.
+func (a *Aggregator) Late() uint64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.late
+}
+
+// Flush emits every window that ended at least Delay before now. It
+// should be called periodically.
+func (a *Aggregator) Flush(now time.Time) error {
+	return a.flush(func(w *window) bool {
+		return !w.start.Add(a.cfg.Window + a.cfg.Delay).After(now)
+	})
+}
+
+// Close emits every open window, complete or not.
+func (a *Aggregator) Close() error {
+	return a.flush(func(*window) bool { return true })
+}
+
+func (a *Aggregator) flush(done func(w *window) bool) error {
+	a.mu.Lock()
+	var ready []*window
+	for start, w := range a.windows {
+		if done(w) {
+			ready = append(ready, w)
+			delete(a.windows, start)
+			if end := start.Add(a.cfg.Window); end.After(a.closed) {
+				a.closed = end
+			}
+		}
+	}
+	a.mu.Unlock()
+
+	sort.Slice(ready, func(i, j int) bool { return ready[i].start.Before(ready[j].start) })
+	var err error
+	for _, w := range ready {
+		if e := a.emit(w); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// emit sends the aggregates of w, largest first.
+func (a *Aggregator) emit(w *window) error {
+	type entry struct {
+		k key
+		*aggregate
+	}
+	entries := make([]entry, 0, len(w.aggs))
+	for k, agg := range w.aggs {
+		entries = append(entries, entry{k, agg})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].bytes > entries[j].bytes })
+
+	end := w.start.Add(a.cfg.Window)
+	var err error
+	for _, e := range entries {
+		r := &flow.Record{
+			Listener: e.k.listener,
+			Exporter: e.k.exporter,
+			Received: end,
+			Start:    w.start,
+			End:      end,
+			SrcAddr:  e.k.src,
+			DstAddr:  e.k.dst,
+			NextHop:  e.k.nextHop,
+			SrcPort:  e.k.srcPort,
+			DstPort:  e.k.dstPort,
+			Protocol: e.k.proto,
+			TOS:      e.k.tos,
+			SrcMask:  e.k.srcMask,
+			DstMask:  e.k.dstMask,
+			SrcAS:    e.k.srcAS,
+			DstAS:    e.k.dstAS,
+			InputIf:  e.k.inputIf,
+			OutputIf: e.k.outputIf,
+			Bytes:    e.bytes,
+			Packets:  e.packets,
+			Flows:    e.flows,
+		}
+		r.Fields = fields(r)
+		if e := a.cfg.Emit(r); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// fields returns NetFlow v9 fields for the non-zero typed fields of an
+// aggregated record, so that encoders that print template fields show it.
+func fields(r *flow.Record) []flow.Field {
+	var fs []flow.Field
+	num := func(ty uint16, n int, v uint64) {
+		if v == 0 {
+			return
+		}
+		b := make([]byte, 8)
+		binary.BigEndian.PutUint64(b, v)
+		fs = append(fs, flow.Field{Type: ty, Value: b[8-n:]})
+	}
+	addr := func(ty4, ty6 uint16, a netip.Addr) {
+		if !a.IsValid() {
+			return
+		}
+		ty := ty6
+		if a.Is4() {
+			ty = ty4
+		}
+		fs = append(fs, flow.Field{Type: ty, Value: a.AsSlice()})
+	}
+	mask := func(ty4, ty6 uint16, a netip.Addr, bits uint8) {
+		if !a.IsValid() || bits == uint8(a.BitLen()) {
+			return
+		}
+		ty := ty6
+		if a.Is4() {
+			ty = ty4
+		}
+		fs = append(fs, flow.Field{Type: ty, Value: []byte{bits}})
+	}
+
+	addr(8, 27, r.SrcAddr)             // IPV4_SRC_ADDR, IPV6_SRC_ADDR
+	mask(9, 29, r.SrcAddr, r.SrcMask)  // SRC_MASK, IPV6_SRC_MASK
+	addr(12, 28, r.DstAddr)            // IPV4_DST_ADDR, IPV6_DST_ADDR
+	mask(13, 30, r.DstAddr, r.DstMask) // DST_MASK, IPV6_DST_MASK
+	addr(15, 62, r.NextHop)            // IPV4_NEXT_HOP, IPV6_NEXT_HOP
+	num(4, 1, uint64(r.Protocol))
+	num(5, 1, uint64(r.TOS))
+	num(7, 2, uint64(r.SrcPort))
+	num(11, 2, uint64(r.DstPort))
+	num(10, 4, uint64(r.InputIf))
+	num(14, 4, uint64(r.OutputIf))
+	num(16, 4, uint64(r.SrcAS))
+	num(17, 4, uint64(r.DstAS))
+	num(1, 8, r.Bytes)   // IN_BYTES
+	num(2, 8, r.Packets) // IN_PKTS
+	num(3, 8, r.Flows)   // FLOWS
+	return fs
+}
@@ -0,0 +1,214 @@
+package aggregate
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+)
+
+var base = time.Date(2022, 3, 11, 18, 0, 0, 0, time.UTC)
+
+// collect returns an Aggregator for cfg and the records it emits.
+func collect(t *testing.T, cfg Config) (*Aggregator, *[]*flow.Record) {
+	t.Helper()
+	var out []*flow.Record
+	cfg.Emit = func(r *flow.Record) error {
+		out = append(out, r)
+		return nil
+	}
+	a, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a, &out
+}
+
+func record(received time.Duration, src string, bytes uint64) *flow.Record {
+	return &flow.Record{
+		Exporter: netip.MustParseAddr("192.0.2.1"),
+		Received: base.Add(received),
+		SrcAddr:  netip.MustParseAddr(src),
+		DstAddr:  netip.MustParseAddr("198.51.100.1"),
+		Protocol: 6,
+		Bytes:    bytes,
+		Packets:  1,
+		Flows:    1,
+	}
+}
+
+// windowSums returns the bytes emitted by window start, in minutes after
+// base.
+func windowSums(out []*flow.Record) map[int]uint64 {
+	sums := make(map[int]uint64)
+	for _, r := range out {
+		sums[int(r.Start.Sub(base)/time.Minute)] += r.Bytes
+	}
+	return sums
+}
+
+func TestWindows(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      Config
+		received []time.Duration
+		want     map[int]uint64
+	}{
+		{
+			name:     "tumbling",
+			cfg:      Config{Window: 5 * time.Minute},
+			received: []time.Duration{0, 4*time.Minute + 59*time.Second, 5 * time.Minute, 12 * time.Minute},
+			want:     map[int]uint64{0: 2, 5: 1, 10: 1},
+		},
+		{
+			// Each record is counted in the five windows that cover it.
+			name:     "sliding",
+			cfg:      Config{Window: 5 * time.Minute, Step: time.Minute},
+			received: []time.Duration{2 * time.Minute, 3*time.Minute + 30*time.Second},
+			want:     map[int]uint64{-2: 1, -1: 2, 0: 2, 1: 2, 2: 2, 3: 1},
+		},
+	}
+	for _, tt := range tests {
+		tt.cfg.Key = "proto"
+		a, out := collect(t, tt.cfg)
+		for _, d := range tt.received {
+			if err := a.Write(record(d, "10.0.0.1", 1)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := a.Close(); err != nil {
+			t.Fatal(err)
+		}
+		got := windowSums(*out)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: got windows %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for start, bytes := range tt.want {
+			if got[start] != bytes {
+				t.Errorf("%s: window %dm has %d bytes, want %d", tt.name, start, got[start], bytes)
+			}
+		}
+		for _, r := range *out {
+			if !r.End.Equal(r.Start.Add(tt.cfg.Window)) || !r.Received.Equal(r.End) {
+				t.Errorf("%s: window %v-%v received %v", tt.name, r.Start, r.End, r.Received)
+			}
+		}
+	}
+}
+
+func TestFlowTime(t *testing.T) {
+	a, out := collect(t, Config{Key: "proto", Window: 5 * time.Minute, FlowTime: true})
+	r := record(7*time.Minute, "10.0.0.1", 1)
+	r.End = base.Add(3 * time.Minute)
+	a.Write(r)
+	// Records without an end time fall back to the time received.
+	a.Write(record(7*time.Minute, "10.0.0.1", 10))
+	a.Close()
+	got := windowSums(*out)
+	if got[0] != 1 || got[5] != 10 {
+		t.Errorf("got windows %v, want 0m: 1, 5m: 10", got)
+	}
+}
+
+func TestKey(t *testing.T) {
+	a, out := collect(t, Config{Key: "src/24,proto", Window: 5 * time.Minute})
+	a.Write(record(0, "10.0.0.1", 1))
+	a.Write(record(0, "10.0.0.200", 2))
+	a.Write(record(0, "10.0.1.1", 4))
+	a.Close()
+	if len(*out) != 2 {
+		t.Fatalf("got %d aggregates, want 2", len(*out))
+	}
+	// Aggregates are emitted largest first.
+	for i, want := range []struct {
+		src   string
+		bytes uint64
+	}{{"10.0.1.0", 4}, {"10.0.0.0", 3}} {
+		r := (*out)[i]
+		if r.SrcAddr.String() != want.src || r.SrcMask != 24 || r.Bytes != want.bytes || r.DstAddr.IsValid() {
+			t.Errorf("aggregate %d: %v/%d -> %v, %d bytes; want %s/24, %d bytes", i, r.SrcAddr, r.SrcMask, r.DstAddr, r.Bytes, want.src, want.bytes)
+		}
+	}
+	if _, err := New(Config{Key: "service,dst_port", Window: time.Minute, Emit: func(*flow.Record) error { return nil }}); err == nil {
+		t.Error("service with dst_port: got no error")
+	}
+}
+
+func TestSamplingRate(t *testing.T) {
+	interval := func(ty uint16, n uint32) flow.Field {
+		return flow.Field{Type: ty, Value: binary.BigEndian.AppendUint32(nil, n)}
+	}
+	tests := []struct {
+		name     string
+		exporter string
+		fields   []flow.Field
+		want     uint64
+	}{
+		{"default", "192.0.2.9", nil, 10},
+		{"exporter", "192.0.2.1", nil, 100},
+		{"SAMPLING_INTERVAL", "192.0.2.1", []flow.Field{interval(34, 1000)}, 1000},
+		{"FLOW_SAMPLER_RANDOM_INTERVAL", "192.0.2.9", []flow.Field{interval(50, 512)}, 512},
+		{"zero interval", "192.0.2.1", []flow.Field{interval(34, 0)}, 100},
+	}
+	for _, tt := range tests {
+		a, out := collect(t, Config{
+			Key:          "exporter",
+			Window:       5 * time.Minute,
+			SamplingRate: 10,
+			ExporterSamplingRates: map[netip.Addr]uint32{
+				netip.MustParseAddr("192.0.2.1"): 100,
+			},
+		})
+		r := record(0, "10.0.0.1", 3)
+		r.Exporter = netip.MustParseAddr(tt.exporter)
+		r.Packets = 2
+		r.Fields = tt.fields
+		a.Write(r)
+		a.Close()
+		if len(*out) != 1 {
+			t.Fatalf("%s: got %d aggregates, want 1", tt.name, len(*out))
+		}
+		if got := (*out)[0]; got.Bytes != 3*tt.want || got.Packets != 2*tt.want || got.Flows != 1 {
+			t.Errorf("%s: got %d bytes, %d packets, %d flows; want %d, %d, 1", tt.name, got.Bytes, got.Packets, got.Flows, 3*tt.want, 2*tt.want)
+		}
+	}
+
+	a, out := collect(t, Config{Key: "proto", Window: time.Minute})
+	a.Write(record(0, "10.0.0.1", 3))
+	a.Close()
+	if (*out)[0].Bytes != 3 {
+		t.Errorf("no sampling rate: got %d bytes, want 3", (*out)[0].Bytes)
+	}
+}
+
+func TestLate(t *testing.T) {
+	a, out := collect(t, Config{Key: "proto", Window: 5 * time.Minute, Delay: time.Minute})
+	a.Write(record(time.Minute, "10.0.0.1", 1))
+
+	// Within the delay, the 18:00 window is still open.
+	a.Flush(base.Add(5*time.Minute + 30*time.Second))
+	if len(*out) != 0 {
+		t.Fatalf("window emitted before its delay passed")
+	}
+	a.Write(record(2*time.Minute, "10.0.0.1", 2))
+
+	a.Flush(base.Add(6 * time.Minute))
+	if got := windowSums(*out); len(got) != 1 || got[0] != 3 {
+		t.Fatalf("got windows %v, want 0m: 3", got)
+	}
+
+	// The window has been emitted, so this record is dropped, while one
+	// for the next window is counted.
+	a.Write(record(4*time.Minute, "10.0.0.1", 4))
+	a.Write(record(5*time.Minute, "10.0.0.1", 8))
+	if a.Late() != 1 {
+		t.Errorf("Late() = %d, want 1", a.Late())
+	}
+	a.Close()
+	if got := windowSums(*out); len(got) != 2 || got[0] != 3 || got[5] != 8 {
+		t.Errorf("got windows %v, want 0m: 3, 5m: 8", got)
+	}
+}
@@ -0,0 +1,204 @@
+package archive
+
+import (
+	"bytes"
+	"io"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+)
+
+var base = time.Date(2022, 3, 11, 18, 0, 0, 0, time.UTC)
+
+// testRecords returns n records received a minute apart, alternating
+// between IPv4 and IPv6 and carrying template fields and enrichments.
+func testRecords(n int) []*flow.Record {
+	var records []*flow.Record
+	for i := 0; i < n; i++ {
+		r := &flow.Record{
+			Listener:   "edge",
+			Exporter:   netip.MustParseAddr("192.0.2.1"),
+			SourceID:   7,
+			TemplateID: 256,
+			Sequence:   uint32(1000 + i),
+			Received:   base.Add(time.Duration(i) * time.Minute),
+			SrcAddr:    netip.MustParseAddr("10.0.0.1"),
+			DstAddr:    netip.MustParseAddr("198.51.100.1"),
+			NextHop:    netip.MustParseAddr("192.0.2.254"),
+			SrcPort:    uint16(50000 + i),
+			DstPort:    443,
+			Protocol:   6,
+			TCPFlags:   0x1b,
+			TOS:        0xb8,
+			SrcMask:    24,
+			DstMask:    16,
+			SrcAS:      64500,
+			DstAS:      4200000000,
+			InputIf:    3,
+			OutputIf:   7,
+			Bytes:      uint64(1) << (i % 40),
+			Packets:    uint64(i + 1),
+			Flows:      1,
+			Fields: []flow.Field{
+				{Type: 10, Value: []byte{0, 3}},
+				// A field missing from nfv9.FieldMap.
+				{Type: 40000, Value: []byte("opaque")},
+			},
+		}
+		if i%2 == 1 {
+			r.SrcAddr = netip.MustParseAddr("2001:db8::1")
+			r.DstAddr = netip.MustParseAddr("::ffff:198.51.100.1")
+			r.NextHop = netip.Addr{}
+			r.Start = r.Received.Add(-30 * time.Second)
+			r.End = r.Received.Add(-time.Second)
+			r.Enrich("src_site", "hq")
+			r.Enrich("direction", "outbound")
+		}
+		records = append(records, r)
+	}
+	return records
+}
+
+// normalize drops the location of r's times, which are read back in
+// local time.
+func normalize(r *flow.Record) *flow.Record {
+	c := *r
+	c.Received = c.Received.UTC()
+	c.Start = c.Start.UTC()
+	c.End = c.End.UTC()
+	return &c
+}
+
+// writeArchive writes records in blocks of about blockSize bytes and
+// closes the archive if close is set, otherwise only flushes it.
+func writeArchive(t *testing.T, records []*flow.Record, compression uint8, blockSize int, close bool) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, compression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.BlockSize = blockSize
+	for _, r := range records {
+		if err := w.Write(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if close {
+		err = w.Close()
+	} else {
+		err = w.Flush()
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func readAll(t *testing.T, r *Reader) []*flow.Record {
+	t.Helper()
+	var records []*flow.Record
+	for {
+		fr, err := r.Read()
+		if err == io.EOF {
+			return records
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, fr)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	records := testRecords(20)
+	for _, compression := range []uint8{CompressionNone, CompressionGzip, CompressionZstd} {
+		// Without Close there is no index and the blocks are found by
+		// scanning.
+		for _, indexed := range []bool{true, false} {
+			b := writeArchive(t, records, compression, 512, indexed)
+			r, err := NewReader(bytes.NewReader(b))
+			if err != nil {
+				t.Fatalf("compression %d, indexed %v: %v", compression, indexed, err)
+			}
+			blocks := r.Blocks()
+			count := 0
+			for _, info := range blocks {
+				count += info.Count
+			}
+			if len(blocks) < 2 || count != len(records) {
+				t.Errorf("compression %d, indexed %v: got %d records in %d blocks, want %d in several", compression, indexed, count, len(blocks), len(records))
+			}
+			got := readAll(t, r)
+			if len(got) != len(records) {
+				t.Fatalf("compression %d, indexed %v: read %d records, want %d", compression, indexed, len(got), len(records))
+			}
+			for i, want := range records {
+				if g := normalize(got[i]); !reflect.DeepEqual(g, want) {
+					t.Errorf("compression %d, indexed %v: record %d = %+v, want %+v", compression, indexed, i, g, want)
+				}
+			}
+		}
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	records := testRecords(20)
+	b := writeArchive(t, records, CompressionZstd, 512, false)
+	full, err := NewReader(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocks := full.Blocks()
+	last := blocks[len(blocks)-1]
+
+	// A block cut short by a killed collector ends the archive.
+	r, err := NewReader(bytes.NewReader(b[:last.Offset+blockHeaderSize+1]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(readAll(t, r)), len(records)-last.Count; got != want {
+		t.Errorf("read %d records, want %d", got, want)
+	}
+}
+
+func TestRangeAndFilter(t *testing.T) {
+	records := testRecords(20)
+	b := writeArchive(t, records, CompressionNone, 512, true)
+	r, err := NewReader(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start, end := base.Add(10*time.Minute), base.Add(12*time.Minute)
+	r.SetRange(start, end)
+	got := readAll(t, r)
+	// Whole blocks are read, so the records returned cover the range
+	// but may extend beyond it.
+	var inRange int
+	for _, fr := range got {
+		s, e := recordTimes(fr)
+		if e.Before(start) || !s.Before(end) {
+			continue
+		}
+		inRange++
+	}
+	if inRange != 2 || len(got) >= len(records) {
+		t.Errorf("SetRange: read %d records, %d in range; want 2 in range and blocks skipped", len(got), inRange)
+	}
+
+	r.SetRange(time.Time{}, time.Time{})
+	r.SetFilter(func(fr *flow.Record) bool { return fr.SrcAddr.Is6() })
+	got = readAll(t, r)
+	if len(got) != len(records)/2 {
+		t.Errorf("SetFilter: read %d records, want %d", len(got), len(records)/2)
+	}
+	for _, fr := range got {
+		if fr.Enrichments["src_site"] != "hq" {
+			t.Errorf("SetFilter: record %d has enrichments %v", fr.Sequence, fr.Enrichments)
+		}
+	}
+}
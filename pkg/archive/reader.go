@@ -0,0 +1,241 @@
+package archive
+
+import (
+	"encoding/binary"
+	"io"
+	"os"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+)
+
+// Reader reads records from an archive.
+type Reader struct {
+	r           io.ReadSeeker
+	compression uint8
+	index       []BlockInfo
+
+	// next is the index of the next block to read.
+	next int
+	// block holds the undecoded remainder of the current block.
+	block []byte
+	// start and end restrict the blocks that are read, see SetRange.
+	start time.Time
+	end   time.Time
+	// match selects the records returned by Read, see SetFilter.
+	match func(r *flow.Record) bool
+}
+
+// NewReader reads the archive header and index from r. If the archive has
+// no index, the blocks are found by scanning the file.
+func NewReader(r io.ReadSeeker) (*Reader, error) {
+	hdr := make([]byte, fileHeaderSize)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return nil, err
+	}
+	if string(hdr[:4]) != fileMagic || hdr[4] != version {
+		return nil, ErrFormat
+	}
+	ar := &Reader{
+		r:           r,
+		compression: hdr[5],
+	}
+	if err := ar.readIndex(); err != nil {
+		if err := ar.scanIndex(); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := r.Seek(fileHeaderSize, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return ar, nil
+}
+
+// readIndex reads the index written by Writer.Close.
+func (r *Reader) readIndex() error {
+	size, err := r.r.Seek(-trailerSize, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	trailer := make([]byte, trailerSize)
+	if _, err := io.ReadFull(r.r, trailer); err != nil {
+		return err
+	}
+	if string(trailer[8:]) != trailerMagic {
+		return ErrFormat
+	}
+	offset := int64(binary.BigEndian.Uint64(trailer))
+	if offset < fileHeaderSize || offset+8 > size {
+		return ErrFormat
+	}
+	if _, err := r.r.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	b := make([]byte, size-offset)
+	if _, err := io.ReadFull(r.r, b); err != nil {
+		return err
+	}
+	if string(b[:4]) != indexMagic {
+		return ErrFormat
+	}
+	n := int(binary.BigEndian.Uint32(b[4:]))
+	b = b[8:]
+	if len(b) < n*indexEntrySize {
+		return ErrFormat
+	}
+	r.index = make([]BlockInfo, n)
+	for i := range r.index {
+		r.index[i] = BlockInfo{
+			Offset:  int64(binary.BigEndian.Uint64(b)),
+			MinTime: nanosToTime(int64(binary.BigEndian.Uint64(b[8:]))),
+			MaxTime: nanosToTime(int64(binary.BigEndian.Uint64(b[16:]))),
+			Count:   int(binary.BigEndian.Uint32(b[24:])),
+		}
+		b = b[indexEntrySize:]
+	}
+	return nil
+}
+
+// scanIndex rebuilds the index by walking the block headers.
+func (r *Reader) scanIndex() error {
+	r.index = nil
+	size, err := r.r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	offset := int64(fileHeaderSize)
+	for {
+		if _, err := r.r.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+		info, dataLen, _, err := r.readBlockHeader()
+		if err != nil || offset+blockHeaderSize+int64(dataLen) > size {
+			// A missing or partially written block ends the archive.
+			return nil
+		}
+		info.Offset = offset
+		r.index = append(r.index, info)
+		offset += blockHeaderSize + int64(dataLen)
+	}
+}
+
+func (r *Reader) readBlockHeader() (info BlockInfo, dataLen, rawLen int, err error) {
+	hdr := make([]byte, blockHeaderSize)
+	if _, err = io.ReadFull(r.r, hdr); err != nil {
+		return
+	}
+	if string(hdr[:4]) != blockMagic {
+		err = ErrFormat
+		return
+	}
+	rawLen = int(binary.BigEndian.Uint32(hdr[4:]))
+	dataLen = int(binary.BigEndian.Uint32(hdr[8:]))
+	info.Count = int(binary.BigEndian.Uint32(hdr[12:]))
+	info.MinTime = nanosToTime(int64(binary.BigEndian.Uint64(hdr[16:])))
+	info.MaxTime = nanosToTime(int64(binary.BigEndian.Uint64(hdr[24:])))
+	return
+}
+
+// Blocks returns the index of the archive.
+func (r *Reader) Blocks() []BlockInfo {
+	return r.index
+}
+
+// SetRange rewinds the reader and restricts it to blocks whose time
+// range overlaps [start, end). A zero start or end leaves that side
+// unbounded. Records outside the range that share a block with records
+// inside it are still returned by Read.
+func (r *Reader) SetRange(start, end time.Time) {
+	r.block = nil
+	r.next = 0
+	r.start = start
+	r.end = end
+}
+
+func (r *Reader) inRange(info BlockInfo) bool {
+	if !r.start.IsZero() && info.MaxTime.Before(r.start) {
+		return false
+	}
+	if !r.end.IsZero() && !info.MinTime.Before(r.end) {
+		return false
+	}
+	return true
+}
+
+// loadBlock reads and decompresses the next block.
+func (r *Reader) loadBlock() error {
+	for r.next < len(r.index) && !r.inRange(r.index[r.next]) {
+		r.next++
+	}
+	if r.next >= len(r.index) {
+		return io.EOF
+	}
+	info := r.index[r.next]
+	r.next++
+	if _, err := r.r.Seek(info.Offset, io.SeekStart); err != nil {
+		return err
+	}
+	_, dataLen, rawLen, err := r.readBlockHeader()
+	if err != nil {
+		return err
+	}
+	data := make([]byte, dataLen)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return err
+	}
+	r.block, err = decompress(r.compression, data, rawLen)
+	return err
+}
+
+// SetFilter restricts Read to records for which match returns true. A
+// nil match returns every record.
+func (r *Reader) SetFilter(match func(r *flow.Record) bool) {
+	r.match = match
+}
+
+// Read returns the next record, or io.EOF at the end of the archive.
+func (r *Reader) Read() (*flow.Record, error) {
+	for {
+		for len(r.block) == 0 {
+			if err := r.loadBlock(); err != nil {
+				return nil, err
+			}
+		}
+		n, size := binary.Uvarint(r.block)
+		if size <= 0 || uint64(len(r.block)-size) < n {
+			r.block = nil
+			return nil, ErrFormat
+		}
+		rec := r.block[size : size+int(n)]
+		r.block = r.block[size+int(n):]
+		fr, err := decodeRecord(rec)
+		if err != nil || r.match == nil || r.match(fr) {
+			return fr, err
+		}
+	}
+}
+
+// File is an archive opened with Open.
+type File struct {
+	*Reader
+	f *os.File
+}
+
+// Open opens the named archive for reading.
+func Open(name string) (*File, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &File{Reader: r, f: f}, nil
+}
+
+// Close closes the underlying file.
+func (f *File) Close() error {
+	return f.f.Close()
+}
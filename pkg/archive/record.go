@@ -0,0 +1,177 @@
+package archive
+
+import (
+	"encoding/binary"
+	"net/netip"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+)
+
+// appendRecord appends the encoding of r to b.
+func appendRecord(b []byte, r *flow.Record) []byte {
+	b = appendString(b, r.Listener)
+	b = appendAddr(b, r.Exporter)
+	b = binary.AppendUvarint(b, uint64(r.SourceID))
+	b = binary.AppendUvarint(b, uint64(r.TemplateID))
+	b = binary.AppendUvarint(b, uint64(r.Sequence))
+	b = binary.AppendVarint(b, timeToNanos(r.Received))
+	b = binary.AppendVarint(b, timeToNanos(r.Start))
+	b = binary.AppendVarint(b, timeToNanos(r.End))
+
+	b = appendAddr(b, r.SrcAddr)
+	b = appendAddr(b, r.DstAddr)
+	b = appendAddr(b, r.NextHop)
+	for _, n := range []uint64{
+		uint64(r.SrcPort), uint64(r.DstPort), uint64(r.Protocol),
+		uint64(r.TCPFlags), uint64(r.TOS), uint64(r.SrcMask),
+		uint64(r.DstMask), uint64(r.SrcAS), uint64(r.DstAS),
+		uint64(r.InputIf), uint64(r.OutputIf),
+		r.Bytes, r.Packets, r.Flows,
+	} {
+		b = binary.AppendUvarint(b, n)
+	}
+
+	b = binary.AppendUvarint(b, uint64(len(r.Fields)))
+	for _, f := range r.Fields {
+		b = binary.AppendUvarint(b, uint64(f.Type))
+		b = binary.AppendUvarint(b, uint64(len(f.Value)))
+		b = append(b, f.Value...)
+	}
+
+	b = binary.AppendUvarint(b, uint64(len(r.Enrichments)))
+	for k, v := range r.Enrichments {
+		b = appendString(b, k)
+		b = appendString(b, v)
+	}
+	return b
+}
+
+func appendString(b []byte, s string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+// appendAddr encodes an address as its length (0, 4 or 16) followed by
+// its bytes.
+func appendAddr(b []byte, a netip.Addr) []byte {
+	if !a.IsValid() {
+		return append(b, 0)
+	}
+	s := a.AsSlice()
+	b = append(b, byte(len(s)))
+	return append(b, s...)
+}
+
+// decoder reads the fields of an encoded record, remembering the first
+// error.
+type decoder struct {
+	b   []byte
+	err error
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	n, size := binary.Uvarint(d.b)
+	if size <= 0 {
+		d.err = ErrFormat
+		return 0
+	}
+	d.b = d.b[size:]
+	return n
+}
+
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	n, size := binary.Varint(d.b)
+	if size <= 0 {
+		d.err = ErrFormat
+		return 0
+	}
+	d.b = d.b[size:]
+	return n
+}
+
+func (d *decoder) bytes(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(d.b) {
+		d.err = ErrFormat
+		return nil
+	}
+	p := make([]byte, n)
+	copy(p, d.b)
+	d.b = d.b[n:]
+	return p
+}
+
+func (d *decoder) string() string {
+	return string(d.bytes(int(d.uvarint())))
+}
+
+func (d *decoder) addr() netip.Addr {
+	n := d.bytes(1)
+	if d.err != nil || n[0] == 0 {
+		return netip.Addr{}
+	}
+	a, ok := netip.AddrFromSlice(d.bytes(int(n[0])))
+	if !ok && d.err == nil {
+		d.err = ErrFormat
+	}
+	return a
+}
+
+// decodeRecord decodes a record encoded by appendRecord.
+func decodeRecord(b []byte) (*flow.Record, error) {
+	d := &decoder{b: b}
+	r := &flow.Record{}
+	r.Listener = d.string()
+	r.Exporter = d.addr()
+	r.SourceID = uint32(d.uvarint())
+	r.TemplateID = uint16(d.uvarint())
+	r.Sequence = uint32(d.uvarint())
+	r.Received = nanosToTime(d.varint())
+	r.Start = nanosToTime(d.varint())
+	r.End = nanosToTime(d.varint())
+
+	r.SrcAddr = d.addr()
+	r.DstAddr = d.addr()
+	r.NextHop = d.addr()
+	r.SrcPort = uint16(d.uvarint())
+	r.DstPort = uint16(d.uvarint())
+	r.Protocol = uint8(d.uvarint())
+	r.TCPFlags = uint8(d.uvarint())
+	r.TOS = uint8(d.uvarint())
+	r.SrcMask = uint8(d.uvarint())
+	r.DstMask = uint8(d.uvarint())
+	r.SrcAS = uint32(d.uvarint())
+	r.DstAS = uint32(d.uvarint())
+	r.InputIf = uint32(d.uvarint())
+	r.OutputIf = uint32(d.uvarint())
+	r.Bytes = d.uvarint()
+	r.Packets = d.uvarint()
+	r.Flows = d.uvarint()
+
+	nfields := int(d.uvarint())
+	for i := 0; i < nfields && d.err == nil; i++ {
+		ty := uint16(d.uvarint())
+		value := d.bytes(int(d.uvarint()))
+		r.Fields = append(r.Fields, flow.Field{Type: ty, Value: value})
+	}
+
+	nenrich := int(d.uvarint())
+	for i := 0; i < nenrich && d.err == nil; i++ {
+		k := d.string()
+		v := d.string()
+		r.Enrich(k, v)
+	}
+
+	if d.err != nil {
+		return nil, d.err
+	}
+	return r, nil
+}
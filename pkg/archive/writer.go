@@ -0,0 +1,156 @@
+package archive
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+)
+
+// DefaultBlockSize is the uncompressed size at which a block is written.
+const DefaultBlockSize = 1 << 20
+
+// Writer writes records to an archive.
+type Writer struct {
+	// BlockSize is the uncompressed size at which a block is written.
+	BlockSize int
+
+	w           io.Writer
+	compression uint8
+	offset      int64
+	index       []BlockInfo
+
+	rec     []byte
+	buf     []byte
+	count   int
+	minTime time.Time
+	maxTime time.Time
+	err     error
+}
+
+// NewWriter writes the archive header to w and returns a Writer that
+// compresses blocks with the given method.
+func NewWriter(w io.Writer, compression uint8) (*Writer, error) {
+	if compression > CompressionZstd {
+		return nil, ErrFormat
+	}
+	aw := &Writer{
+		BlockSize:   DefaultBlockSize,
+		w:           w,
+		compression: compression,
+	}
+	hdr := append([]byte(fileMagic), version, compression)
+	if err := aw.write(hdr); err != nil {
+		return nil, err
+	}
+	return aw, nil
+}
+
+func (w *Writer) write(p []byte) error {
+	if w.err != nil {
+		return w.err
+	}
+	n, err := w.w.Write(p)
+	w.offset += int64(n)
+	w.err = err
+	return err
+}
+
+// recordTimes returns the time range covered by r, falling back to the
+// time it was received when the exporter sent no flow times.
+func recordTimes(r *flow.Record) (time.Time, time.Time) {
+	start, end := r.Start, r.End
+	if start.IsZero() {
+		start = r.Received
+	}
+	if end.IsZero() {
+		end = r.Received
+	}
+	return start, end
+}
+
+// Write adds r to the current block, writing the block once it reaches
+// BlockSize.
+func (w *Writer) Write(r *flow.Record) error {
+	if w.err != nil {
+		return w.err
+	}
+	w.rec = appendRecord(w.rec[:0], r)
+	w.buf = binary.AppendUvarint(w.buf, uint64(len(w.rec)))
+	w.buf = append(w.buf, w.rec...)
+
+	start, end := recordTimes(r)
+	if w.count == 0 || start.Before(w.minTime) {
+		w.minTime = start
+	}
+	if w.count == 0 || end.After(w.maxTime) {
+		w.maxTime = end
+	}
+	w.count++
+
+	if len(w.buf) >= w.BlockSize {
+		return w.Flush()
+	}
+	return nil
+}
+
+// Flush writes the current block, if any, so that a reader of a stream
+// sees the records written so far without waiting for a full block.
+func (w *Writer) Flush() error {
+	if w.err != nil {
+		return w.err
+	}
+	if w.count == 0 {
+		return nil
+	}
+	data, err := compress(w.compression, w.buf)
+	if err != nil {
+		w.err = err
+		return err
+	}
+	info := BlockInfo{
+		Offset:  w.offset,
+		MinTime: w.minTime,
+		MaxTime: w.maxTime,
+		Count:   w.count,
+	}
+	hdr := make([]byte, 0, blockHeaderSize)
+	hdr = append(hdr, blockMagic...)
+	hdr = binary.BigEndian.AppendUint32(hdr, uint32(len(w.buf)))
+	hdr = binary.BigEndian.AppendUint32(hdr, uint32(len(data)))
+	hdr = binary.BigEndian.AppendUint32(hdr, uint32(w.count))
+	hdr = binary.BigEndian.AppendUint64(hdr, uint64(timeToNanos(w.minTime)))
+	hdr = binary.BigEndian.AppendUint64(hdr, uint64(timeToNanos(w.maxTime)))
+	if err := w.write(hdr); err != nil {
+		return err
+	}
+	if err := w.write(data); err != nil {
+		return err
+	}
+	w.index = append(w.index, info)
+	w.buf = w.buf[:0]
+	w.count = 0
+	return nil
+}
+
+// Close flushes the current block and writes the index and trailer. It
+// does not close the underlying writer.
+func (w *Writer) Close() error {
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	indexOffset := w.offset
+	b := make([]byte, 0, 8+len(w.index)*indexEntrySize+trailerSize)
+	b = append(b, indexMagic...)
+	b = binary.BigEndian.AppendUint32(b, uint32(len(w.index)))
+	for _, info := range w.index {
+		b = binary.BigEndian.AppendUint64(b, uint64(info.Offset))
+		b = binary.BigEndian.AppendUint64(b, uint64(timeToNanos(info.MinTime)))
+		b = binary.BigEndian.AppendUint64(b, uint64(timeToNanos(info.MaxTime)))
+		b = binary.BigEndian.AppendUint32(b, uint32(info.Count))
+	}
+	b = binary.BigEndian.AppendUint64(b, uint64(indexOffset))
+	b = append(b, trailerMagic...)
+	return w.write(b)
+}
@@ -0,0 +1,238 @@
+// Package asn maps addresses to the routed prefix and origin AS that
+// contain them, using a BGP RIB dump or a prefix to ASN list, and ASNs to
+// AS names.
+package asn
+
+import (
+	"bufio"
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/net2"
+	"github.com/brooksbp/go.netflow/pkg/reload"
+)
+
+// Route is a routed prefix and the AS that originates it.
+type Route struct {
+	Prefix netip.Prefix
+	ASN    uint32
+}
+
+// ReadPrefixes adds the routes of a text file with one PREFIX,ASN pair
+// per line, such as "1.0.0.0/24,13335", to t. The fields may also be
+// separated by whitespace, as in pyasn files, and the ASN may be written
+// AS13335. Blank lines, lines starting with # and a header line are
+// skipped.
+func ReadPrefixes(r io.Reader, t *net2.PrefixTable[uint32]) error {
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		prefix, asn, ok := splitLine(s.Text())
+		if !ok {
+			continue
+		}
+		p, err := netip.ParsePrefix(prefix)
+		if err != nil {
+			if line == 1 {
+				continue
+			}
+			return fmt.Errorf("asn: line %d: %w", line, err)
+		}
+		n, err := parseASN(asn)
+		if err != nil {
+			return fmt.Errorf("asn: line %d: %w", line, err)
+		}
+		t.Insert(p, n)
+	}
+	return s.Err()
+}
+
+// Names maps ASNs to AS names.
+type Names map[uint32]string
+
+// ReadNames reads a text file with one ASN and name per line, separated by
+// a comma or whitespace, such as "13335 CLOUDFLARENET, US" from RIPE's
+// asnames.txt. Blank lines, lines starting with # and a header line are
+// skipped.
+func ReadNames(r io.Reader) (Names, error) {
+	names := make(Names)
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		asn, name, ok := splitLine(s.Text())
+		if !ok {
+			continue
+		}
+		n, err := parseASN(asn)
+		if err != nil {
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("asn: line %d: %w", line, err)
+		}
+		names[n] = strings.Trim(name, `"`)
+	}
+	return names, s.Err()
+}
+
+// splitLine splits a line at its first comma or run of whitespace.
+func splitLine(line string) (string, string, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || line[0] == '#' {
+		return "", "", false
+	}
+	i := strings.IndexAny(line, ", \t")
+	if i < 0 {
+		return line, "", true
+	}
+	return line[:i], strings.TrimLeft(line[i+1:], ", \t"), true
+}
+
+func parseASN(s string) (uint32, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "AS"), "as")
+	n, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ASN %q", s)
+	}
+	return uint32(n), nil
+}
+
+// open opens path, decompressing it if it ends in .gz or .bz2.
+func open(path string) (io.Reader, io.Closer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	var r io.Reader = f
+	switch {
+	case strings.HasSuffix(path, ".gz"):
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			f.Close()
+			return nil, nil, fmt.Errorf("%s: %w", path, err)
+		}
+		r = zr
+	case strings.HasSuffix(path, ".bz2"):
+		r = bzip2.NewReader(f)
+	}
+	return bufio.NewReaderSize(r, 1<<16), f, nil
+}
+
+// LoadTable reads the routes in path, which is either an MRT RIB dump or a
+// prefix list as read by ReadPrefixes. The format is detected from the
+// contents, and files ending in .gz or .bz2 are decompressed.
+func LoadTable(path string) (*net2.PrefixTable[uint32], error) {
+	r, c, err := open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	t := net2.NewPrefixTable[uint32]()
+	head, _ := r.(*bufio.Reader).Peek(6)
+	if isMRT(head) {
+		err = ReadMRT(r, t)
+	} else {
+		err = ReadPrefixes(r, t)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return t, nil
+}
+
+// LoadNames reads the AS names in path, decompressing it if it ends in
+// .gz or .bz2.
+func LoadNames(path string) (Names, error) {
+	r, c, err := open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	names, err := ReadNames(r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return names, nil
+}
+
+// DB answers route and AS name lookups from a routes file and a names
+// file, either of which may be omitted, and reloads them when they change.
+// It is safe for concurrent use, including while it is reloaded.
+type DB struct {
+	table net2.AtomicPrefixTable[uint32]
+	names atomic.Pointer[Names]
+	files [2]*reload.File
+}
+
+// Open loads the routes in routesPath, as read by LoadTable, and the AS
+// names in namesPath, as read by LoadNames. Either path may be empty.
+func Open(routesPath, namesPath string) (*DB, error) {
+	db := &DB{}
+	db.names.Store(&Names{})
+	db.files = [2]*reload.File{
+		reload.NewFile(routesPath, func(path string) error {
+			t, err := LoadTable(path)
+			if err == nil {
+				db.table.Store(t)
+			}
+			return err
+		}),
+		reload.NewFile(namesPath, func(path string) error {
+			names, err := LoadNames(path)
+			if err == nil {
+				db.names.Store(&names)
+			}
+			return err
+		}),
+	}
+	if _, err := db.Reload(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// Reload loads each file again if its size or modification time changed
+// since it was last loaded, and reports whether any did. Lookups use the
+// previous contents until the new file has loaded, and keep using them if
+// it fails to load.
+func (db *DB) Reload() (bool, error) {
+	var reloaded bool
+	for _, f := range db.files {
+		ok, err := f.Reload()
+		if err != nil {
+			return reloaded, err
+		}
+		reloaded = reloaded || ok
+	}
+	return reloaded, nil
+}
+
+// Watch calls Reload every interval until stop is closed, passing errors
+// and successful reloads to report.
+func (db *DB) Watch(interval time.Duration, stop <-chan struct{}, report func(reloaded bool, err error)) {
+	reload.Watch(interval, stop, db.Reload, report)
+}
+
+// Lookup returns the most specific route containing addr.
+func (db *DB) Lookup(addr netip.Addr) (Route, bool) {
+	p, asn, ok := db.table.Lookup(addr)
+	return Route{p, asn}, ok
+}
+
+// Name returns the name of AS asn, or "" if it is unknown.
+func (db *DB) Name(asn uint32) string {
+	return (*db.names.Load())[asn]
+}
+
+// Len returns the number of routes loaded.
+func (db *DB) Len() int {
+	return db.table.Load().Len()
+}
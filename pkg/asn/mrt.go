@@ -0,0 +1,265 @@
+package asn
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+
+	"github.com/brooksbp/go.netflow/pkg/net2"
+)
+
+// MRT record types and subtypes (RFC 6396, RFC 8050) that carry RIB
+// entries.
+const (
+	mrtTableDump   = 12
+	mrtTableDumpV2 = 13
+	mrtBGP4MP      = 16
+	mrtBGP4MPET    = 17
+
+	tableDumpAFIIPv4 = 1
+	tableDumpAFIIPv6 = 2
+
+	ribIPv4Unicast        = 2
+	ribIPv6Unicast        = 4
+	ribIPv4UnicastAddPath = 8
+	ribIPv6UnicastAddPath = 10
+)
+
+// BGP path attribute and AS_PATH segment types (RFC 4271).
+const (
+	attrASPath = 2
+
+	segmentASSet      = 1
+	segmentASSequence = 2
+)
+
+var errShortMRT = errors.New("asn: truncated MRT record")
+
+// isMRT reports whether b, the first bytes of a file, look like an MRT
+// record header.
+func isMRT(b []byte) bool {
+	if len(b) < 6 {
+		return false
+	}
+	switch binary.BigEndian.Uint16(b[4:6]) {
+	case mrtTableDump, mrtTableDumpV2, mrtBGP4MP, mrtBGP4MPET:
+		return true
+	}
+	return false
+}
+
+// ReadMRT adds the routes of an MRT RIB dump, in the TABLE_DUMP or
+// TABLE_DUMP_V2 format of RouteViews and RIPE RIS, to t. The origin AS of
+// a prefix is taken from the AS_PATH of its first RIB entry; other record
+// types, such as BGP4MP updates, are skipped.
+func ReadMRT(r io.Reader, t *net2.PrefixTable[uint32]) error {
+	var header [12]byte
+	var body []byte
+	for {
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			if err == io.ErrUnexpectedEOF {
+				return errShortMRT
+			}
+			return err
+		}
+		ty := binary.BigEndian.Uint16(header[4:6])
+		subtype := binary.BigEndian.Uint16(header[6:8])
+		n := binary.BigEndian.Uint32(header[8:12])
+		if uint32(cap(body)) < n {
+			body = make([]byte, n)
+		}
+		body = body[:n]
+		if _, err := io.ReadFull(r, body); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return errShortMRT
+			}
+			return err
+		}
+
+		var err error
+		switch ty {
+		case mrtTableDumpV2:
+			err = readRIB(subtype, body, t)
+		case mrtTableDump:
+			err = readTableDump(subtype, body, t)
+		}
+		if err != nil {
+			return fmt.Errorf("asn: MRT type %d subtype %d: %w", ty, subtype, err)
+		}
+	}
+}
+
+// readRIB reads a TABLE_DUMP_V2 RIB record.
+func readRIB(subtype uint16, b []byte, t *net2.PrefixTable[uint32]) error {
+	var addrLen int
+	var addPath bool
+	switch subtype {
+	case ribIPv4Unicast:
+		addrLen = 4
+	case ribIPv6Unicast:
+		addrLen = 16
+	case ribIPv4UnicastAddPath:
+		addrLen, addPath = 4, true
+	case ribIPv6UnicastAddPath:
+		addrLen, addPath = 16, true
+	default:
+		return nil
+	}
+
+	// Sequence number, prefix length and prefix.
+	if len(b) < 5 {
+		return errShortMRT
+	}
+	bits := int(b[4])
+	n := (bits + 7) / 8
+	if bits > addrLen*8 || len(b) < 5+n+2 {
+		return errShortMRT
+	}
+	prefix, err := makePrefix(b[5:5+n], addrLen, bits)
+	if err != nil {
+		return err
+	}
+	b = b[5+n:]
+
+	entries := int(binary.BigEndian.Uint16(b))
+	b = b[2:]
+	for i := 0; i < entries; i++ {
+		// Peer index, originated time and, with ADD-PATH, path ID.
+		skip := 6
+		if addPath {
+			skip += 4
+		}
+		if len(b) < skip+2 {
+			return errShortMRT
+		}
+		n := int(binary.BigEndian.Uint16(b[skip:]))
+		b = b[skip+2:]
+		if len(b) < n {
+			return errShortMRT
+		}
+		asn, ok, err := originAS(b[:n], 4)
+		if err != nil {
+			return err
+		}
+		if ok {
+			t.Insert(prefix, asn)
+			return nil
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// readTableDump reads a legacy TABLE_DUMP record, whose AS_PATH holds
+// 2 byte ASNs.
+func readTableDump(subtype uint16, b []byte, t *net2.PrefixTable[uint32]) error {
+	var addrLen int
+	switch subtype {
+	case tableDumpAFIIPv4:
+		addrLen = 4
+	case tableDumpAFIIPv6:
+		addrLen = 16
+	default:
+		return nil
+	}
+
+	// View number, sequence number, prefix, prefix length, status,
+	// originated time, peer address, peer AS and attribute length.
+	fixed := 4 + addrLen + 2 + 4 + addrLen + 2 + 2
+	if len(b) < fixed {
+		return errShortMRT
+	}
+	bits := int(b[4+addrLen])
+	if bits > addrLen*8 {
+		return errShortMRT
+	}
+	prefix, err := makePrefix(b[4:4+addrLen], addrLen, bits)
+	if err != nil {
+		return err
+	}
+	n := int(binary.BigEndian.Uint16(b[fixed-2:]))
+	b = b[fixed:]
+	if len(b) < n {
+		return errShortMRT
+	}
+	asn, ok, err := originAS(b[:n], 2)
+	if err != nil || !ok {
+		return err
+	}
+	t.Insert(prefix, asn)
+	return nil
+}
+
+func makePrefix(b []byte, addrLen, bits int) (netip.Prefix, error) {
+	a := make([]byte, addrLen)
+	copy(a, b)
+	addr, _ := netip.AddrFromSlice(a)
+	return addr.Prefix(bits)
+}
+
+// originAS returns the origin AS in the AS_PATH attribute of attrs: the
+// last AS of the path, or the first AS of a trailing AS_SET. It reports
+// false for an empty or missing AS_PATH.
+func originAS(attrs []byte, asnLen int) (uint32, bool, error) {
+	for len(attrs) > 0 {
+		if len(attrs) < 3 {
+			return 0, false, errShortMRT
+		}
+		flags, ty := attrs[0], attrs[1]
+		var n, hdr int
+		if flags&0x10 != 0 { // Extended Length
+			if len(attrs) < 4 {
+				return 0, false, errShortMRT
+			}
+			n, hdr = int(binary.BigEndian.Uint16(attrs[2:4])), 4
+		} else {
+			n, hdr = int(attrs[2]), 3
+		}
+		if len(attrs) < hdr+n {
+			return 0, false, errShortMRT
+		}
+		if ty == attrASPath {
+			return lastAS(attrs[hdr:hdr+n], asnLen)
+		}
+		attrs = attrs[hdr+n:]
+	}
+	return 0, false, nil
+}
+
+func lastAS(path []byte, asnLen int) (uint32, bool, error) {
+	var asn uint32
+	var ok bool
+	for len(path) > 0 {
+		if len(path) < 2 {
+			return 0, false, errShortMRT
+		}
+		ty, count := path[0], int(path[1])
+		path = path[2:]
+		if len(path) < count*asnLen {
+			return 0, false, errShortMRT
+		}
+		if count > 0 {
+			i := count - 1
+			if ty == segmentASSet {
+				i = 0
+			}
+			if ty == segmentASSet || ty == segmentASSequence {
+				asn, ok = readAS(path[i*asnLen:], asnLen), true
+			}
+		}
+		path = path[count*asnLen:]
+	}
+	return asn, ok, nil
+}
+
+func readAS(b []byte, n int) uint32 {
+	if n == 2 {
+		return uint32(binary.BigEndian.Uint16(b))
+	}
+	return binary.BigEndian.Uint32(b)
+}
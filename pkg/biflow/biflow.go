@@ -0,0 +1,292 @@
+// Package biflow stitches the two directions of a conversation, which
+// NetFlow v9 exports as separate records, into one bidirectional record
+// with initiator and responder counters.
+package biflow
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+	"github.com/brooksbp/go.netflow/pkg/net2"
+	"github.com/brooksbp/go.netflow/pkg/sites"
+)
+
+// Field types of the counters added to stitched records.
+const (
+	InitiatorOctets  = 231
+	ResponderOctets  = 232
+	InitiatorPackets = 298
+	ResponderPackets = 299
+)
+
+// DefaultMaxPending is the default of Config.MaxPending.
+const DefaultMaxPending = 100000
+
+// Config describes how records are stitched.
+type Config struct {
+	// Timeout is how long a record waits for its reverse direction before
+	// it is emitted on its own. Defaults to 30 seconds.
+	Timeout time.Duration
+	// MaxPending limits the number of records waiting for their reverse
+	// direction. When it is reached the oldest is emitted on its own.
+	// Defaults to DefaultMaxPending.
+	MaxPending int
+	// Emit receives stitched records and records whose reverse direction
+	// was not seen.
+	Emit func(r *flow.Record) error
+}
+
+// key identifies one direction of a conversation at one exporter.
+type key struct {
+	exporter netip.Addr
+	proto    uint8
+	src, dst netip.AddrPort
+}
+
+func keyOf(r *flow.Record) key {
+	return key{
+		exporter: r.Exporter,
+		proto:    r.Protocol,
+		src:      netip.AddrPortFrom(r.SrcAddr, r.SrcPort),
+		dst:      netip.AddrPortFrom(r.DstAddr, r.DstPort),
+	}
+}
+
+func (k key) reverse() key {
+	k.src, k.dst = k.dst, k.src
+	return k
+}
+
+// entry is a record waiting for its reverse direction.
+type entry struct {
+	r        *flow.Record
+	k        key
+	deadline time.Time
+	matched  bool
+}
+
+// Stitcher pairs records with their reverse direction. It is safe for
+// concurrent use.
+type Stitcher struct {
+	cfg Config
+
+	mu      sync.Mutex
+	pending map[key][]*entry
+	// queue holds entries in arrival order for expiry; matched entries
+	// are skipped.
+	queue []*entry
+	count int
+
+	// emitMu serializes calls to Emit, which Write and Flush make from
+	// different goroutines. It is taken before mu is released, so records
+	// are emitted in the order they left the pending set.
+	emitMu sync.Mutex
+}
+
+// New returns a Stitcher for cfg.
+func New(cfg Config) (*Stitcher, error) {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+	if cfg.MaxPending <= 0 {
+		cfg.MaxPending = DefaultMaxPending
+	}
+	if cfg.Emit == nil {
+		return nil, fmt.Errorf("biflow: missing Emit")
+	}
+	return &Stitcher{
+		cfg:     cfg,
+		pending: make(map[key][]*entry),
+	}, nil
+}
+
+// Write stitches r with a pending record of the reverse direction, or
+// holds it until the reverse direction arrives or Timeout passes.
+func (s *Stitcher) Write(r *flow.Record) error {
+	now := r.Received
+	if now.IsZero() {
+		now = time.Now()
+	}
+	k := keyOf(r)
+
+	s.mu.Lock()
+	var emit []*flow.Record
+	if e := s.take(k.reverse()); e != nil {
+		emit = append(emit, Stitch(e.r, r))
+	} else {
+		e := &entry{r: r, k: k, deadline: now.Add(s.cfg.Timeout)}
+		s.pending[k] = append(s.pending[k], e)
+		s.queue = append(s.queue, e)
+		s.count++
+		for s.count > s.cfg.MaxPending {
+			emit = append(emit, s.pop())
+		}
+	}
+	return s.unlockAndEmit(emit)
+}
+
+// take removes and returns the oldest pending entry for k.
+func (s *Stitcher) take(k key) *entry {
+	list := s.pending[k]
+	if len(list) == 0 {
+		return nil
+	}
+	e := list[0]
+	if len(list) == 1 {
+		delete(s.pending, k)
+	} else {
+		s.pending[k] = list[1:]
+	}
+	e.matched = true
+	s.count--
+	return e
+}
+
+// pop removes the oldest pending entry and returns its record.
+func (s *Stitcher) pop() *flow.Record {
+	for {
+		e := s.queue[0]
+		s.queue = s.queue[1:]
+		if !e.matched {
+			s.take(e.k)
+			return e.r
+		}
+	}
+}
+
+// Flush emits the records whose reverse direction did not arrive by now.
+// It should be called periodically.
+func (s *Stitcher) Flush(now time.Time) error {
+	s.mu.Lock()
+	var emit []*flow.Record
+	for len(s.queue) > 0 && (s.queue[0].matched || !s.queue[0].deadline.After(now)) {
+		if e := s.queue[0]; !e.matched {
+			emit = append(emit, s.pop())
+		} else {
+			s.queue = s.queue[1:]
+		}
+	}
+	return s.unlockAndEmit(emit)
+}
+
+// Close emits every pending record.
+func (s *Stitcher) Close() error {
+	s.mu.Lock()
+	var emit []*flow.Record
+	for s.count > 0 {
+		emit = append(emit, s.pop())
+	}
+	s.queue = nil
+	return s.unlockAndEmit(emit)
+}
+
+// unlockAndEmit releases mu and passes records to Emit, one caller at a
+// time.
+func (s *Stitcher) unlockAndEmit(records []*flow.Record) error {
+	s.emitMu.Lock()
+	defer s.emitMu.Unlock()
+	s.mu.Unlock()
+
+	var err error
+	for _, r := range records {
+		if e := s.cfg.Emit(r); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// Initiator reports whether a, rather than its reverse direction b,
+// started the conversation: the record that started first, or when both
+// started at the same time, the one sent to the service port chosen by
+// net2.DefaultClassifier.
+func Initiator(a, b *flow.Record) bool {
+	if !a.Start.IsZero() && !b.Start.IsZero() && !a.Start.Equal(b.Start) {
+		return a.Start.Before(b.Start)
+	}
+	_, side := net2.DefaultClassifier.ServicePort(a.Protocol, a.SrcPort, a.DstPort)
+	return side != net2.SideSrc
+}
+
+// Stitch combines two records of opposite directions into one record in
+// the direction of the initiator. Its Bytes, Packets and Flows, and
+// IN_BYTES and IN_PKTS fields, count both directions; the
+// initiator/responder fields count each. Enrichments of the initiator win
+// over those of the responder, whose source and destination keys are
+// swapped first.
+func Stitch(a, b *flow.Record) *flow.Record {
+	fwd, rev := a, b
+	if !Initiator(a, b) {
+		fwd, rev = b, a
+	}
+	r := *fwd
+	r.Fields = append([]flow.Field(nil), fwd.Fields...)
+	if !rev.Start.IsZero() && (r.Start.IsZero() || rev.Start.Before(r.Start)) {
+		r.Start = rev.Start
+	}
+	if rev.End.After(r.End) {
+		r.End = rev.End
+	}
+	if rev.Received.After(r.Received) {
+		r.Received = rev.Received
+	}
+	r.TCPFlags |= rev.TCPFlags
+	r.Bytes = fwd.Bytes + rev.Bytes
+	r.Packets = fwd.Packets + rev.Packets
+	r.Flows = fwd.Flows + rev.Flows
+	r.Enrichments = nil
+	for k, v := range rev.Enrichments {
+		if k, v, ok := reverseEnrichment(k, v); ok {
+			r.Enrich(k, v)
+		}
+	}
+	for k, v := range fwd.Enrichments {
+		r.Enrich(k, v)
+	}
+
+	setField(&r, 1, r.Bytes)   // IN_BYTES
+	setField(&r, 2, r.Packets) // IN_PKTS
+	setField(&r, InitiatorOctets, fwd.Bytes)
+	setField(&r, ResponderOctets, rev.Bytes)
+	setField(&r, InitiatorPackets, fwd.Packets)
+	setField(&r, ResponderPackets, rev.Packets)
+	return &r
+}
+
+// reverseEnrichment returns enrichment k=v of a record as it applies to
+// the reverse direction: source and destination, and input and output
+// interfaces, are swapped, and inbound and outbound flows are flipped.
+// Enrichments of the next hop don't apply and are dropped.
+func reverseEnrichment(k, v string) (string, string, bool) {
+	switch {
+	case k == "direction":
+		switch sites.Direction(v) {
+		case sites.Inbound:
+			v = string(sites.Outbound)
+		case sites.Outbound:
+			v = string(sites.Inbound)
+		}
+	case strings.HasPrefix(k, "src_"):
+		k = "dst_" + k[len("src_"):]
+	case strings.HasPrefix(k, "dst_"):
+		k = "src_" + k[len("dst_"):]
+	case strings.HasPrefix(k, "in_if_"):
+		k = "out_if_" + k[len("in_if_"):]
+	case strings.HasPrefix(k, "out_if_"):
+		k = "in_if_" + k[len("out_if_"):]
+	case strings.HasPrefix(k, "next_hop_"):
+		return "", "", false
+	}
+	return k, v, true
+}
+
+// setField sets field ty of r to the 8 byte value v, adding it if r has
+// no such field.
+func setField(r *flow.Record, ty uint16, v uint64) {
+	r.SetField(ty, binary.BigEndian.AppendUint64(nil, v))
+}
@@ -0,0 +1,111 @@
+package biflow
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+)
+
+func TestStitchEnrichments(t *testing.T) {
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	client := netip.MustParseAddr("10.0.0.1")
+	server := netip.MustParseAddr("192.0.2.1")
+
+	// fwd is sent by the client and starts first; rev is the server's
+	// reply, seen on the same router in through wan and out through lan.
+	fwd := &flow.Record{
+		SrcAddr: client, DstAddr: server, SrcPort: 51000, DstPort: 443, Protocol: 6,
+		Start: start, End: start.Add(time.Second), Bytes: 100, Packets: 2, Flows: 1,
+		Enrichments: map[string]string{
+			"src_host":      "client.example",
+			"src_site":      "hq",
+			"in_if_name":    "lan",
+			"app":           "https",
+			"next_hop_host": "gw1.example",
+		},
+	}
+	rev := &flow.Record{
+		SrcAddr: server, DstAddr: client, SrcPort: 443, DstPort: 51000, Protocol: 6,
+		Start: start.Add(time.Millisecond), End: start.Add(time.Second), Bytes: 900, Packets: 3, Flows: 1,
+		Enrichments: map[string]string{
+			"src_host":      "server.example",
+			"dst_host":      "stale.example",
+			"src_country":   "NL",
+			"src_as_name":   "EXAMPLE-AS",
+			"dst_site":      "branch",
+			"in_if_name":    "wan",
+			"in_if_speed":   "10000000000",
+			"out_if_name":   "lan2",
+			"out_if_role":   "access",
+			"direction":     "inbound",
+			"app":           "tls",
+			"next_hop_host": "gw.example",
+		},
+	}
+	want := map[string]string{
+		// The initiator's own values.
+		"src_host":      "client.example",
+		"src_site":      "hq",
+		"in_if_name":    "lan",
+		"app":           "https",
+		"next_hop_host": "gw1.example",
+		// The responder's, turned around.
+		"dst_host":     "server.example",
+		"dst_country":  "NL",
+		"dst_as_name":  "EXAMPLE-AS",
+		"out_if_name":  "wan",
+		"out_if_speed": "10000000000",
+		"in_if_role":   "access",
+		"direction":    "outbound",
+	}
+
+	for _, order := range [][2]*flow.Record{{fwd, rev}, {rev, fwd}} {
+		r := Stitch(order[0], order[1])
+		if r.SrcAddr != client || r.DstAddr != server {
+			t.Fatalf("stitched %v -> %v, want %v -> %v", r.SrcAddr, r.DstAddr, client, server)
+		}
+		for k, v := range want {
+			if got := r.Enrichments[k]; got != v {
+				t.Errorf("%s = %q, want %q", k, got, v)
+			}
+		}
+		for k, v := range r.Enrichments {
+			if _, ok := want[k]; !ok {
+				t.Errorf("unexpected %s = %q", k, v)
+			}
+		}
+		if r.Bytes != 1000 || r.Packets != 5 || r.Flows != 2 {
+			t.Errorf("got %d bytes, %d packets, %d flows; want 1000, 5, 2", r.Bytes, r.Packets, r.Flows)
+		}
+	}
+	if fwd.Enrichments["dst_host"] != "" || rev.Enrichments["dst_host"] != "stale.example" {
+		t.Error("Stitch changed its inputs' enrichments")
+	}
+}
+
+func TestReverseEnrichment(t *testing.T) {
+	tests := []struct {
+		k, v   string
+		wk, wv string
+		ok     bool
+	}{
+		{"src_host", "a", "dst_host", "a", true},
+		{"dst_city", "b", "src_city", "b", true},
+		{"in_if_desc", "c", "out_if_desc", "c", true},
+		{"out_if_name", "d", "in_if_name", "d", true},
+		{"direction", "inbound", "direction", "outbound", true},
+		{"direction", "outbound", "direction", "inbound", true},
+		{"direction", "internal", "direction", "internal", true},
+		{"direction", "transit", "direction", "transit", true},
+		{"app", "dns", "app", "dns", true},
+		{"next_hop_host", "gw", "", "", false},
+	}
+	for _, tt := range tests {
+		k, v, ok := reverseEnrichment(tt.k, tt.v)
+		if k != tt.wk || v != tt.wv || ok != tt.ok {
+			t.Errorf("reverseEnrichment(%q, %q) = %q, %q, %v; want %q, %q, %v", tt.k, tt.v, k, v, ok, tt.wk, tt.wv, tt.ok)
+		}
+	}
+}
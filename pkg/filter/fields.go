@@ -0,0 +1,422 @@
+package filter
+
+import (
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+	"github.com/brooksbp/go.netflow/pkg/net2"
+)
+
+type kind int
+
+const (
+	kindUint kind = iota
+	kindAddr
+	kindString
+	kindRaw
+)
+
+// field describes how to read a field from a record. Fields with several
+// getters, such as port, match when any of them does.
+type field struct {
+	kind  kind
+	uints []func(r *flow.Record) (uint64, bool)
+	addrs []func(r *flow.Record) netip.Addr
+	strs  []func(r *flow.Record) (string, bool)
+	// parse converts the values of kindUint fields. what names what it
+	// expects in error messages.
+	parse func(s string) (uint64, bool)
+	what  string
+	// mask makes = match when all bits of the value are set.
+	mask bool
+	// raw is the nfv9.FieldMap type of kindRaw fields.
+	raw uint16
+}
+
+// comparison is a parsed FIELD OP VALUES term.
+type comparison struct {
+	field  string
+	op     string
+	opTok  token
+	values []token
+}
+
+func uintField(get func(r *flow.Record) uint64, parse func(s string) (uint64, bool), what string) *field {
+	return &field{
+		kind:  kindUint,
+		uints: []func(r *flow.Record) (uint64, bool){func(r *flow.Record) (uint64, bool) { return get(r), true }},
+		parse: parse,
+		what:  what,
+	}
+}
+
+func addrField(gets ...func(r *flow.Record) netip.Addr) *field {
+	return &field{kind: kindAddr, addrs: gets}
+}
+
+// either returns a field matching when a or b does.
+func either(a, b *field) *field {
+	f := *a
+	f.uints = append(append([]func(r *flow.Record) (uint64, bool){}, a.uints...), b.uints...)
+	f.strs = append(append([]func(r *flow.Record) (string, bool){}, a.strs...), b.strs...)
+	return &f
+}
+
+func srcAddr(r *flow.Record) netip.Addr { return r.SrcAddr }
+func dstAddr(r *flow.Record) netip.Addr { return r.DstAddr }
+
+var fields = map[string]*field{
+	"src":      addrField(srcAddr),
+	"dst":      addrField(dstAddr),
+	"host":     addrField(srcAddr, dstAddr),
+	"ip":       addrField(srcAddr, dstAddr),
+	"net":      addrField(srcAddr, dstAddr),
+	"next_hop": addrField(func(r *flow.Record) netip.Addr { return r.NextHop }),
+	"exporter": addrField(func(r *flow.Record) netip.Addr { return r.Exporter }),
+
+	"src_port":  uintField(func(r *flow.Record) uint64 { return uint64(r.SrcPort) }, parsePort, "port"),
+	"dst_port":  uintField(func(r *flow.Record) uint64 { return uint64(r.DstPort) }, parsePort, "port"),
+	"src_as":    uintField(func(r *flow.Record) uint64 { return uint64(r.SrcAS) }, parseNumber, "AS number"),
+	"dst_as":    uintField(func(r *flow.Record) uint64 { return uint64(r.DstAS) }, parseNumber, "AS number"),
+	"src_mask":  uintField(func(r *flow.Record) uint64 { return uint64(r.SrcMask) }, parseNumber, "prefix length"),
+	"dst_mask":  uintField(func(r *flow.Record) uint64 { return uint64(r.DstMask) }, parseNumber, "prefix length"),
+	"in_if":     uintField(func(r *flow.Record) uint64 { return uint64(r.InputIf) }, parseNumber, "interface index"),
+	"out_if":    uintField(func(r *flow.Record) uint64 { return uint64(r.OutputIf) }, parseNumber, "interface index"),
+	"proto":     uintField(func(r *flow.Record) uint64 { return uint64(r.Protocol) }, parseProtocol, "protocol"),
+	"bytes":     uintField(func(r *flow.Record) uint64 { return r.Bytes }, parseNumber, "number"),
+	"packets":   uintField(func(r *flow.Record) uint64 { return r.Packets }, parseNumber, "number"),
+	"flows":     uintField(func(r *flow.Record) uint64 { return r.Flows }, parseNumber, "number"),
+	"tos":       uintField(func(r *flow.Record) uint64 { return uint64(r.TOS) }, parseNumber, "number"),
+	"tcp_flags": uintField(func(r *flow.Record) uint64 { return uint64(r.TCPFlags) }, parseNumber, "number"),
+	"template":  uintField(func(r *flow.Record) uint64 { return uint64(r.TemplateID) }, parseNumber, "template ID"),
+	"source_id": uintField(func(r *flow.Record) uint64 { return uint64(r.SourceID) }, parseNumber, "source ID"),
+	"dscp":      uintField(func(r *flow.Record) uint64 { return uint64(r.DSCP()) }, parseDSCP, "DSCP"),
+	"flags": {
+		kind:  kindUint,
+		uints: []func(r *flow.Record) (uint64, bool){func(r *flow.Record) (uint64, bool) { return uint64(r.TCPFlags), true }},
+		parse: parseTCPFlags,
+		what:  "TCP flags",
+		mask:  true,
+	},
+	"ip_version": {
+		kind: kindUint,
+		uints: []func(r *flow.Record) (uint64, bool){func(r *flow.Record) (uint64, bool) {
+			switch a := r.SrcAddr.Unmap(); {
+			case a.Is4():
+				return 4, true
+			case a.Is6():
+				return 6, true
+			}
+			return 0, false
+		}},
+		what: "IP version",
+	},
+	"duration": {
+		kind: kindUint,
+		uints: []func(r *flow.Record) (uint64, bool){func(r *flow.Record) (uint64, bool) {
+			if r.Start.IsZero() || r.End.IsZero() {
+				return 0, false
+			}
+			return uint64(r.Duration().Milliseconds()), true
+		}},
+		parse: parseDuration,
+		what:  "duration",
+	},
+	"icmp_type": {
+		kind: kindString,
+		strs: []func(r *flow.Record) (string, bool){func(r *flow.Record) (string, bool) {
+			typ, _, ok := r.ICMP()
+			return net2.ICMPTypeName(r.Protocol, typ), ok
+		}},
+	},
+	"listener": {
+		kind: kindString,
+		strs: []func(r *flow.Record) (string, bool){func(r *flow.Record) (string, bool) { return r.Listener, true }},
+	},
+	"direction": enrichmentField("direction"),
+	"src_site":  enrichmentField("src_site"),
+	"dst_site":  enrichmentField("dst_site"),
+}
+
+// enrichmentField returns a string field reading the enrichment key.
+// Records without it don't match.
+func enrichmentField(key string) *field {
+	return &field{
+		kind: kindString,
+		strs: []func(r *flow.Record) (string, bool){func(r *flow.Record) (string, bool) {
+			v, ok := r.Enrichments[key]
+			return v, ok
+		}},
+	}
+}
+
+func init() {
+	fields["port"] = either(fields["src_port"], fields["dst_port"])
+	fields["as"] = either(fields["src_as"], fields["dst_as"])
+	fields["if"] = either(fields["in_if"], fields["out_if"])
+	fields["site"] = either(fields["src_site"], fields["dst_site"])
+}
+
+// rawField returns a field reading the template field of type ty.
+func rawField(ty uint16) *field {
+	return &field{kind: kindRaw, raw: ty}
+}
+
+// compile type checks c and returns its predicate.
+func (f *field) compile(c comparison) (predicate, error) {
+	if c.op == "!=" {
+		c.op = "="
+		m, err := f.compile(c)
+		if err != nil {
+			return nil, err
+		}
+		return func(r *flow.Record) bool { return !m(r) }, nil
+	}
+	switch f.kind {
+	case kindUint:
+		return f.compileUint(c)
+	case kindAddr:
+		return f.compileAddr(c)
+	case kindString:
+		return f.compileString(c)
+	}
+	return f.compileRaw(c)
+}
+
+func (f *field) compileUint(c comparison) (predicate, error) {
+	parse := f.parse
+	if parse == nil {
+		parse = parseNumber
+	}
+	want := make([]uint64, len(c.values))
+	for i, v := range c.values {
+		n, ok := parse(v.text)
+		if !ok {
+			return nil, errorf(v, "%s: expected %s, got %q", c.field, f.what, v.text)
+		}
+		want[i] = n
+	}
+	var cmp func(x uint64) bool
+	switch c.op {
+	case "=", "in":
+		cmp = func(x uint64) bool {
+			for _, n := range want {
+				if x == n || f.mask && x&n == n {
+					return true
+				}
+			}
+			return false
+		}
+	case "<":
+		cmp = func(x uint64) bool { return x < want[0] }
+	case "<=":
+		cmp = func(x uint64) bool { return x <= want[0] }
+	case ">":
+		cmp = func(x uint64) bool { return x > want[0] }
+	case ">=":
+		cmp = func(x uint64) bool { return x >= want[0] }
+	}
+	gets := f.uints
+	return func(r *flow.Record) bool {
+		for _, get := range gets {
+			if x, ok := get(r); ok && cmp(x) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// equalityOnly rejects ordering operators for fields that have no order.
+func equalityOnly(c comparison, what string) error {
+	if c.op != "=" && c.op != "in" {
+		return errorf(c.opTok, "operator %s is not valid for %s field %s", c.op, what, c.field)
+	}
+	return nil
+}
+
+func (f *field) compileAddr(c comparison) (predicate, error) {
+	if err := equalityOnly(c, "address"); err != nil {
+		return nil, err
+	}
+	want := make([]netip.Prefix, len(c.values))
+	for i, v := range c.values {
+		p, ok := parsePrefix(v.text)
+		if !ok {
+			return nil, errorf(v, "%s: expected address or prefix, got %q", c.field, v.text)
+		}
+		want[i] = p
+	}
+	gets := f.addrs
+	return func(r *flow.Record) bool {
+		for _, get := range gets {
+			// IPv4 addresses exported in IPv6 fields match IPv4
+			// prefixes.
+			a := get(r).Unmap()
+			for _, p := range want {
+				if p.Contains(a) {
+					return true
+				}
+			}
+		}
+		return false
+	}, nil
+}
+
+func (f *field) compileString(c comparison) (predicate, error) {
+	if err := equalityOnly(c, "string"); err != nil {
+		return nil, err
+	}
+	gets := f.strs
+	return func(r *flow.Record) bool {
+		for _, get := range gets {
+			s, ok := get(r)
+			if !ok {
+				continue
+			}
+			for _, v := range c.values {
+				if s == v.text {
+					return true
+				}
+			}
+		}
+		return false
+	}, nil
+}
+
+// compileRaw compares a template field as the kind of its first value.
+func (f *field) compileRaw(c comparison) (predicate, error) {
+	ty := f.raw
+	get := func(r *flow.Record) (flow.Field, bool) { return r.Field(ty) }
+	first := c.values[0].text
+	if _, ok := parseNumber(first); ok {
+		return (&field{
+			kind: kindUint,
+			uints: []func(r *flow.Record) (uint64, bool){func(r *flow.Record) (uint64, bool) {
+				fv, ok := get(r)
+				return fv.Uint(), ok
+			}},
+			what: "number",
+		}).compile(c)
+	}
+	if _, ok := parsePrefix(first); ok {
+		return (&field{
+			kind: kindAddr,
+			addrs: []func(r *flow.Record) netip.Addr{func(r *flow.Record) netip.Addr {
+				fv, _ := get(r)
+				return fv.Addr()
+			}},
+		}).compile(c)
+	}
+	if _, err := net.ParseMAC(first); err == nil {
+		for i, v := range c.values {
+			mac, err := net.ParseMAC(v.text)
+			if err != nil {
+				return nil, errorf(v, "%s: expected MAC address, got %q", c.field, v.text)
+			}
+			c.values[i].text = string(mac)
+		}
+		return (&field{
+			kind: kindString,
+			strs: []func(r *flow.Record) (string, bool){func(r *flow.Record) (string, bool) {
+				fv, ok := get(r)
+				return string(fv.Value), ok
+			}},
+		}).compile(c)
+	}
+	return (&field{
+		kind: kindString,
+		strs: []func(r *flow.Record) (string, bool){func(r *flow.Record) (string, bool) {
+			fv, ok := get(r)
+			return fv.String(), ok
+		}},
+	}).compile(c)
+}
+
+// parseNumber parses a decimal, 0x hexadecimal or k/M/G/T suffixed
+// number.
+func parseNumber(s string) (uint64, bool) {
+	mult := map[byte]float64{'k': 1e3, 'K': 1e3, 'M': 1e6, 'G': 1e9, 'T': 1e12}[s[len(s)-1]]
+	if mult != 0 {
+		x, err := strconv.ParseFloat(s[:len(s)-1], 64)
+		if err != nil || x < 0 {
+			return 0, false
+		}
+		return uint64(x * mult), true
+	}
+	n, err := strconv.ParseUint(s, 0, 64)
+	return n, err == nil
+}
+
+func parseProtocol(s string) (uint64, bool) {
+	if n, err := strconv.ParseUint(s, 10, 8); err == nil {
+		return n, true
+	}
+	for n, entry := range net2.IPProtocolMap {
+		if strings.EqualFold(entry.Keyword, s) {
+			return uint64(n), true
+		}
+	}
+	return 0, false
+}
+
+// parsePort parses a port number or a service name known to
+// net2.ServicePort.
+func parsePort(s string) (uint64, bool) {
+	if n, err := strconv.ParseUint(s, 10, 16); err == nil {
+		return n, true
+	}
+	port, ok := net2.ServicePort(s)
+	return uint64(port), ok
+}
+
+// parseDuration parses milliseconds or a time.Duration into
+// milliseconds.
+func parseDuration(s string) (uint64, bool) {
+	if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return n, true
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, false
+	}
+	return uint64(d.Milliseconds()), true
+}
+
+// parseDSCP parses a DSCP name such as EF or a number.
+func parseDSCP(s string) (uint64, bool) {
+	dscp, ok := net2.ParseDSCP(s)
+	return uint64(dscp), ok
+}
+
+// parseTCPFlags parses a number, names such as "SYN,ACK" or nfdump
+// letters such as "SA", see net2.ParseTCPFlags.
+func parseTCPFlags(s string) (uint64, bool) {
+	f, ok := net2.ParseTCPFlags(s)
+	return uint64(f), ok
+}
+
+// parsePrefix parses an address, as a single-address prefix, or a
+// prefix.
+func parsePrefix(s string) (netip.Prefix, bool) {
+	if strings.Contains(s, "/") {
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, false
+		}
+		if a := p.Addr(); a.Is4In6() && p.Bits() >= 96 {
+			p = netip.PrefixFrom(a.Unmap(), p.Bits()-96)
+		}
+		return p.Masked(), true
+	}
+	a, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+	a = a.Unmap()
+	return netip.PrefixFrom(a, a.BitLen()), true
+}
@@ -0,0 +1,115 @@
+// Package filter compiles nfdump-like filter expressions into predicates
+// over flow records, e.g.
+//
+//	src net 10.0.0.0/8 and proto tcp and dst port in [80, 443] and bytes > 1M
+//
+// An expression is a sequence of comparisons joined by "and" ("&&"), "or"
+// ("||") and "not" ("!"), with parentheses for grouping. Comparisons that
+// follow each other without an operator are joined by "and".
+//
+// A comparison is a field, an operator and a value:
+//
+//	FIELD = VALUE    also ==, !=, <, <=, > and >=
+//	FIELD in [VALUE, VALUE, ...]
+//	FIELD VALUE      same as FIELD = VALUE
+//
+// Fields of the unified record model are
+//
+//	src, dst, host      addresses; dst net 10.0.0.0/8, src host 10.1.2.3
+//	next_hop, exporter  addresses
+//	src_port, dst_port, port
+//	src_as, dst_as, as
+//	src_mask, dst_mask
+//	in_if, out_if, if   also "in if N" and "out if N"
+//	proto               a number or name, e.g. tcp
+//	ip_version          4 or 6
+//	bytes, packets, flows, tos, tcp_flags, template, source_id
+//	flags               TCP flags that must all be set, e.g. flags SA or
+//	                    flags syn
+//	dscp                a number or name, e.g. dscp EF
+//	icmp_type           an ICMP or ICMPv6 type name, e.g. echo-request
+//	duration            milliseconds, or a duration such as 10s
+//	listener            a listener name
+//	direction           inbound, outbound, internal or transit
+//	src_site, dst_site, site
+//	                    the site labels of the addresses' networks
+//
+// "src" and "dst" qualify host, ip, net, port, as, mask and site; without
+// a qualifier, port, host, as, if and site match either side. Address values may
+// be IPv4 or IPv6 prefixes, which match every address they contain;
+// IPv4-mapped IPv6 addresses match as IPv4. Numbers may have a
+// k, M, G or T suffix (powers of 1000). != matches exactly the records =
+// does not.
+//
+// Any name in nfv9.FieldMap, e.g. IN_BYTES or L4_DST_PORT, compares the
+// raw template field as a number, address, MAC address or formatted
+// string, depending on the value it is compared with. Records without
+// the field don't match.
+package filter
+
+import (
+	"fmt"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+)
+
+// Filter is a compiled filter expression.
+type Filter struct {
+	expr  string
+	match func(r *flow.Record) bool
+}
+
+// Compile parses expr. An empty expression matches every record.
+func Compile(expr string) (*Filter, error) {
+	toks, err := lex(expr)
+	if err != nil {
+		return nil, err
+	}
+	f := &Filter{expr: expr}
+	if len(toks) == 1 {
+		f.match = func(r *flow.Record) bool { return true }
+		return f, nil
+	}
+	p := &parser{toks: toks}
+	match, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, errorf(t, "unexpected %s", t)
+	}
+	f.match = match
+	return f, nil
+}
+
+// Match reports whether r matches the filter. A nil Filter matches every
+// record.
+func (f *Filter) Match(r *flow.Record) bool {
+	if f == nil {
+		return true
+	}
+	return f.match(r)
+}
+
+// String returns the expression the filter was compiled from.
+func (f *Filter) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.expr
+}
+
+// Error describes a syntax or type error in an expression.
+type Error struct {
+	// Column is the 1-based position of the offending token.
+	Column int
+	Msg    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("filter: column %d: %s", e.Column, e.Msg)
+}
+
+func errorf(t token, format string, args ...any) error {
+	return &Error{Column: t.pos, Msg: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,219 @@
+package filter
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+)
+
+// testRecords are matched by TestMatch, by name.
+func testRecords() map[string]*flow.Record {
+	start := time.Date(2022, 3, 11, 18, 0, 0, 0, time.UTC)
+	return map[string]*flow.Record{
+		// A TCP flow to an HTTPS server.
+		"A": {
+			Listener: "edge",
+			Exporter: netip.MustParseAddr("192.0.2.1"),
+			SrcAddr:  netip.MustParseAddr("10.0.0.1"),
+			DstAddr:  netip.MustParseAddr("192.0.2.10"),
+			SrcPort:  51000,
+			DstPort:  443,
+			Protocol: 6,
+			TCPFlags: 0x12, // SYN|ACK
+			TOS:      0xb8, // EF
+			SrcAS:    64500,
+			DstAS:    15169,
+			InputIf:  3,
+			OutputIf: 7,
+			Start:    start,
+			End:      start.Add(10 * time.Second),
+			Bytes:    2000000,
+			Packets:  1500,
+			Flows:    1,
+			Enrichments: map[string]string{
+				"direction": "outbound",
+				"src_site":  "hq",
+			},
+		},
+		// An IPv6 DNS reply.
+		"B": {
+			Listener: "core",
+			SrcAddr:  netip.MustParseAddr("2001:db8::5"),
+			DstAddr:  netip.MustParseAddr("2001:db8:1::1"),
+			SrcPort:  53,
+			DstPort:  40000,
+			Protocol: 17,
+			InputIf:  7,
+			OutputIf: 3,
+			Bytes:    500,
+			Packets:  2,
+			Flows:    1,
+			Fields: []flow.Field{
+				{Type: 10, Value: []byte{0, 7}},                               // INPUT_SNMP
+				{Type: 56, Value: []byte{0x00, 0x11, 0x22, 0x33, 0x44, 0x55}}, // IN_SRC_MAC
+			},
+			Enrichments: map[string]string{
+				"direction": "inbound",
+				"dst_site":  "dc",
+			},
+		},
+		// An ICMP echo request to an IPv4-mapped IPv6 address.
+		"C": {
+			Listener: "edge",
+			SrcAddr:  netip.MustParseAddr("192.0.2.10"),
+			DstAddr:  netip.MustParseAddr("::ffff:10.0.0.1"),
+			Protocol: 1,
+			Bytes:    84,
+			Packets:  1,
+			Flows:    1,
+			Fields: []flow.Field{
+				{Type: 32, Value: []byte{8, 0}}, // ICMP_TYPE
+			},
+		},
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string // names of the matching records
+	}{
+		{"", "ABC"},
+
+		// Protocols, by name or number.
+		{"proto tcp", "A"},
+		{"proto 17", "B"},
+		{"proto ICMP", "C"},
+
+		// Addresses and CIDR prefixes.
+		{"src net 10.0.0.0/8", "A"},
+		{"dst net 10.0.0.0/8", "C"},
+		{"host 10.0.0.1", "AC"},
+		{"net 2001:db8::/32", "B"},
+		{"dst ::ffff:10.0.0.0/104", "C"},
+		{"src 192.0.2.10/32", "C"},
+		{"src ip 192.0.2.0/24 or dst host 192.0.2.0/24", "AC"},
+		{"exporter 192.0.2.1", "A"},
+
+		// Ports, by number or service name.
+		{"port https", "A"},
+		{"dst port in [80, 443]", "A"},
+		{"port 53", "B"},
+		{"src port > 1024", "A"},
+
+		// Numbers, suffixes and comparisons.
+		{"bytes > 1M", "A"},
+		{"bytes >= 500 and bytes < 1k", "B"},
+		{"packets != 1", "AB"},
+		{"packets == 2", "B"},
+		{"tos > 0", "A"},
+
+		// "and" binds tighter than "or", and "not" tighter than both.
+		{"proto icmp or proto tcp and bytes > 1k", "AC"},
+		{"(proto icmp or proto tcp) and bytes > 1k", "A"},
+		{"not proto tcp and bytes > 100", "B"},
+		{"not (proto tcp and bytes > 100)", "BC"},
+		{"!(proto tcp || proto udp)", "C"},
+		{"proto tcp && bytes > 1M || listener core", "AB"},
+		{"not not proto tcp", "A"},
+		// Terms without an operator are joined by "and".
+		{"proto tcp dst port 443", "A"},
+		{"proto tcp dst port 80", ""},
+
+		// Named values.
+		{"flags SA", "A"},
+		{"flags syn", "A"},
+		{"flags FIN", ""},
+		{"dscp EF", "A"},
+		{"dscp 0", "BC"},
+		{"icmp_type echo-request", "C"},
+		{"duration >= 10s", "A"},
+		{"duration < 10000", ""},
+
+		// Interfaces, AS numbers and versions.
+		{"in if 3", "A"},
+		{"out if 3", "B"},
+		{"if 7", "AB"},
+		{"src as 64500", "A"},
+		{"as 15169", "A"},
+		{"ip_version 6", "B"},
+		{"ip_version 4", "AC"},
+
+		// Strings and enrichments.
+		{"listener edge", "AC"},
+		{"listener != edge", "B"},
+		{"direction inbound", "B"},
+		{"direction != inbound", "AC"},
+		{"src_site hq", "A"},
+		{"site dc", "B"},
+
+		// Raw template fields.
+		{"INPUT_SNMP = 7", "B"},
+		{"IN_SRC_MAC 00:11:22:33:44:55", "B"},
+		{"INPUT_SNMP in [0x1, 0x7]", "B"},
+		{"IN_SRC_MAC != 00:11:22:33:44:55", "AC"},
+	}
+	records := testRecords()
+	for _, tt := range tests {
+		f, err := Compile(tt.expr)
+		if err != nil {
+			t.Errorf("Compile(%q): %v", tt.expr, err)
+			continue
+		}
+		var got string
+		for _, name := range []string{"A", "B", "C"} {
+			if f.Match(records[name]) {
+				got += name
+			}
+		}
+		if got != tt.want {
+			t.Errorf("%q matches %q, want %q", tt.expr, got, tt.want)
+		}
+		if f.String() != tt.expr {
+			t.Errorf("String() = %q, want %q", f.String(), tt.expr)
+		}
+	}
+
+	var nilFilter *Filter
+	if !nilFilter.Match(records["A"]) {
+		t.Error("nil filter does not match")
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		expr   string
+		column int
+		msg    string
+	}{
+		{"proto tcp and", 14, "expected field name, got end of filter"},
+		{"bogus 1", 1, `unknown field "bogus"`},
+		{"proto xyz", 7, `proto: expected protocol, got "xyz"`},
+		{"src port 70000", 10, `src port: expected port, got "70000"`},
+		{"src net 10.0.0.0/33", 9, "expected address or prefix"},
+		{"listener < edge", 10, "operator < is not valid for string field listener"},
+		{"src > 10.0.0.1", 5, "operator > is not valid for address field src"},
+		{"(proto tcp", 11, `expected ")", got end of filter`},
+		{"proto tcp )", 11, `unexpected ")"`},
+		{"port in [80 443]", 13, `expected "," or "]", got "443"`},
+		{"dst port in 80", 13, `expected "[" after in`},
+		{"bytes > ", 9, "expected value after bytes >"},
+		{"proto tcp & bytes 1", 11, `unexpected '&'`},
+		{"flags XYZ", 7, "expected TCP flags"},
+	}
+	for _, tt := range tests {
+		_, err := Compile(tt.expr)
+		var fe *Error
+		if !errors.As(err, &fe) {
+			t.Errorf("Compile(%q) = %v, want *Error", tt.expr, err)
+			continue
+		}
+		if fe.Column != tt.column || !strings.Contains(fe.Msg, tt.msg) {
+			t.Errorf("Compile(%q) = column %d: %s; want column %d: %s", tt.expr, fe.Column, fe.Msg, tt.column, tt.msg)
+		}
+	}
+}
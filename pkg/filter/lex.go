@@ -0,0 +1,98 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokWord
+	tokOp
+	tokLParen
+	tokRParen
+	tokLBracket
+	tokRBracket
+	tokComma
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "end of filter"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// is reports whether t is the keyword or operator s.
+func (t token) is(s string) bool {
+	return (t.kind == tokWord || t.kind == tokOp) && strings.EqualFold(t.text, s)
+}
+
+// ops are the operators, longest first.
+var ops = []string{"==", "!=", "<=", ">=", "&&", "||", "=", "<", ">", "!"}
+
+const punct = "()[],=!<>&|"
+
+// lex splits expr into tokens, ending with a tokEOF.
+func lex(expr string) ([]token, error) {
+	var toks []token
+	i := 0
+	for i < len(expr) {
+		c := expr[i]
+		pos := i + 1
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case c == '(':
+			toks = append(toks, token{tokLParen, "(", pos})
+			i++
+			continue
+		case c == ')':
+			toks = append(toks, token{tokRParen, ")", pos})
+			i++
+			continue
+		case c == '[':
+			toks = append(toks, token{tokLBracket, "[", pos})
+			i++
+			continue
+		case c == ']':
+			toks = append(toks, token{tokRBracket, "]", pos})
+			i++
+			continue
+		case c == ',':
+			toks = append(toks, token{tokComma, ",", pos})
+			i++
+			continue
+		case strings.IndexByte(punct, c) >= 0:
+			op := ""
+			for _, o := range ops {
+				if strings.HasPrefix(expr[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, &Error{Column: pos, Msg: fmt.Sprintf("unexpected %q", c)}
+			}
+			toks = append(toks, token{tokOp, op, pos})
+			i += len(op)
+			continue
+		}
+		j := i
+		for j < len(expr) && !strings.ContainsRune(" \t\n\r"+punct, rune(expr[j])) {
+			j++
+		}
+		toks = append(toks, token{tokWord, expr[i:j], pos})
+		i = j
+	}
+	return append(toks, token{tokEOF, "", len(expr) + 1}), nil
+}
@@ -0,0 +1,214 @@
+package filter
+
+import (
+	"strings"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+	"github.com/brooksbp/go.netflow/pkg/nfv9"
+)
+
+type predicate = func(r *flow.Record) bool
+
+// parser is a recursive descent parser that compiles while it parses.
+type parser struct {
+	toks []token
+	i    int
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.i]
+	if t.kind != tokEOF {
+		p.i++
+	}
+	return t
+}
+
+// parseOr parses and-expressions joined by "or".
+func (p *parser) parseOr() (predicate, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().is("or") || p.peek().is("||") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(r *flow.Record) bool { return l(r) || right(r) }
+	}
+	return left, nil
+}
+
+// parseAnd parses terms joined by "and" or by nothing.
+func (p *parser) parseAnd() (predicate, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.is("and") || t.is("&&") {
+			p.next()
+		} else if !startsTerm(t) {
+			return left, nil
+		}
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(r *flow.Record) bool { return l(r) && right(r) }
+	}
+}
+
+func startsTerm(t token) bool {
+	switch t.kind {
+	case tokLParen:
+		return true
+	case tokOp:
+		return t.text == "!"
+	case tokWord:
+		return !t.is("and") && !t.is("or")
+	}
+	return false
+}
+
+// parseNot parses a negated term, a parenthesized expression or a
+// comparison.
+func (p *parser) parseNot() (predicate, error) {
+	t := p.peek()
+	switch {
+	case t.is("not") || t.is("!"):
+		p.next()
+		m, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return func(r *flow.Record) bool { return !m(r) }, nil
+	case t.kind == tokLParen:
+		p.next()
+		m, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.kind != tokRParen {
+			return nil, errorf(t, "expected \")\", got %s", t)
+		}
+		return m, nil
+	}
+	return p.parseComparison()
+}
+
+// qualifiers map the words that may follow "src" or "dst" to the suffix
+// of the field they select.
+var qualifiers = map[string]string{
+	"host": "",
+	"ip":   "",
+	"net":  "",
+	"port": "_port",
+	"as":   "_as",
+	"mask": "_mask",
+	"site": "_site",
+}
+
+// parseField parses a field name, including "src"/"dst" qualifiers and
+// "in if"/"out if".
+func (p *parser) parseField() (*field, token, error) {
+	t := p.next()
+	name := strings.ToLower(t.text)
+	if t.kind == tokWord && (name == "in" || name == "out") && p.peek().is("if") {
+		t.text += " " + p.next().text
+		return fields[name+"_if"], t, nil
+	}
+	if t.kind != tokWord || t.is("and") || t.is("or") || t.is("in") {
+		return nil, t, errorf(t, "expected field name, got %s", t)
+	}
+	if name == "src" || name == "dst" {
+		if q := p.peek(); q.kind == tokWord {
+			if suffix, ok := qualifiers[strings.ToLower(q.text)]; ok {
+				p.next()
+				name += suffix
+				t.text += " " + q.text
+			}
+		}
+	}
+	if f, ok := fields[name]; ok {
+		return f, t, nil
+	}
+	if ty, ok := nfv9.FieldType(strings.ToUpper(t.text)); ok {
+		return rawField(uint16(ty)), t, nil
+	}
+	return nil, t, errorf(t, "unknown field %q", t.text)
+}
+
+var comparators = map[string]string{
+	"=":  "=",
+	"==": "=",
+	"!=": "!=",
+	"<":  "<",
+	"<=": "<=",
+	">":  ">",
+	">=": ">=",
+}
+
+// parseComparison parses FIELD OP VALUE, FIELD in [VALUE, ...] or FIELD
+// VALUE.
+func (p *parser) parseComparison() (predicate, error) {
+	f, ft, err := p.parseField()
+	if err != nil {
+		return nil, err
+	}
+	c := comparison{field: ft.text, op: "=", opTok: ft}
+	t := p.peek()
+	switch {
+	case t.kind == tokOp && comparators[t.text] != "":
+		p.next()
+		c.op, c.opTok = comparators[t.text], t
+		v := p.next()
+		if v.kind != tokWord {
+			return nil, errorf(v, "expected value after %s %s, got %s", ft.text, t.text, v)
+		}
+		c.values = []token{v}
+	case t.is("in"):
+		p.next()
+		c.op, c.opTok = "in", t
+		if c.values, err = p.parseList(); err != nil {
+			return nil, err
+		}
+	case t.kind == tokWord && !t.is("and") && !t.is("or") && !t.is("not"):
+		p.next()
+		c.values = []token{t}
+	default:
+		return nil, errorf(t, "expected operator or value after %q, got %s", ft.text, t)
+	}
+	return f.compile(c)
+}
+
+// parseList parses [VALUE, ...].
+func (p *parser) parseList() ([]token, error) {
+	if t := p.next(); t.kind != tokLBracket {
+		return nil, errorf(t, "expected \"[\" after in, got %s", t)
+	}
+	var values []token
+	for {
+		v := p.next()
+		if v.kind != tokWord {
+			return nil, errorf(v, "expected value, got %s", v)
+		}
+		values = append(values, v)
+		switch t := p.next(); t.kind {
+		case tokComma:
+			continue
+		case tokRBracket:
+			return values, nil
+		default:
+			return nil, errorf(t, "expected \",\" or \"]\", got %s", t)
+		}
+	}
+}
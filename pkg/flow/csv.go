@@ -0,0 +1,122 @@
+package flow
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/brooksbp/go.netflow/pkg/nfv9"
+)
+
+// Columns that describe where a record came from, or that hold typed
+// record fields whatever the IP version, rather than one of its template
+// fields. They may be selected alongside nfv9.FieldMap names.
+var metaColumns = map[string]func(r *Record) string{
+	"RECEIVED":    func(r *Record) string { return formatTime(r.Received) },
+	"LISTENER":    func(r *Record) string { return r.Listener },
+	"EXPORTER":    func(r *Record) string { return addrString(r.Exporter) },
+	"SOURCE_ID":   func(r *Record) string { return strconv.FormatUint(uint64(r.SourceID), 10) },
+	"TEMPLATE_ID": func(r *Record) string { return strconv.FormatUint(uint64(r.TemplateID), 10) },
+	"START":       func(r *Record) string { return formatTime(r.Start) },
+	"END":         func(r *Record) string { return formatTime(r.End) },
+	"SRC_ADDR":    func(r *Record) string { return addrString(r.SrcAddr) },
+	"DST_ADDR":    func(r *Record) string { return addrString(r.DstAddr) },
+	"NEXT_HOP":    func(r *Record) string { return addrString(r.NextHop) },
+}
+
+// DefaultColumns are written when no columns are selected.
+var DefaultColumns = []string{
+	"RECEIVED", "EXPORTER", "START", "END",
+	"SRC_ADDR", "DST_ADDR", "L4_SRC_PORT", "L4_DST_PORT",
+	"PROTOCOL", "IN_PKTS", "IN_BYTES", "INPUT_SNMP", "OUTPUT_SNMP",
+}
+
+// Column types that are not nfv9 field types.
+const (
+	columnMeta       = -1
+	columnEnrichment = -2
+)
+
+// CSVEncoder writes records as delimited text with a header row. Every
+// row has the same columns regardless of the template a record was
+// decoded with; fields a record lacks are left empty.
+type CSVEncoder struct {
+	w       *csv.Writer
+	columns []string
+	types   []int
+	header  bool
+}
+
+// NewCSVEncoder returns an encoder writing the named columns separated by
+// comma. Column names are nfv9.FieldMap names, one of RECEIVED,
+// LISTENER, EXPORTER, SOURCE_ID, TEMPLATE_ID, START, END, SRC_ADDR,
+// DST_ADDR and NEXT_HOP, or lower case enrichment keys such as src_host
+// or dst_country. SRC_ADDR, DST_ADDR and NEXT_HOP hold IPv4 and IPv6
+// addresses alike.
+func NewCSVEncoder(w io.Writer, comma rune, columns []string) (*CSVEncoder, error) {
+	if len(columns) == 0 {
+		columns = DefaultColumns
+	}
+	e := &CSVEncoder{
+		w:       csv.NewWriter(w),
+		columns: columns,
+		types:   make([]int, len(columns)),
+	}
+	e.w.Comma = comma
+	for i, name := range columns {
+		if _, ok := metaColumns[name]; ok {
+			e.types[i] = columnMeta
+			continue
+		}
+		if name != "" && name == strings.ToLower(name) {
+			e.types[i] = columnEnrichment
+			continue
+		}
+		ty, ok := nfv9.FieldType(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+		e.types[i] = ty
+	}
+	return e, nil
+}
+
+// Encode writes r as one row, preceded by the header row on the first
+// call.
+func (e *CSVEncoder) Encode(r *Record) error {
+	if !e.header {
+		if err := e.w.Write(e.columns); err != nil {
+			return err
+		}
+		e.header = true
+	}
+	row := make([]string, len(e.columns))
+	for i, name := range e.columns {
+		switch e.types[i] {
+		case columnMeta:
+			row[i] = metaColumns[name](r)
+			continue
+		case columnEnrichment:
+			row[i] = r.Enrichments[name]
+			continue
+		}
+		if f, ok := r.Field(uint16(e.types[i])); ok {
+			row[i] = r.fieldString(f)
+		}
+	}
+	if err := e.w.Write(row); err != nil {
+		return err
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func addrString(a netip.Addr) string {
+	if !a.IsValid() {
+		return ""
+	}
+	return a.String()
+}
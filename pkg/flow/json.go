@@ -0,0 +1,181 @@
+package flow
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/net2"
+)
+
+// TimeFormat is the layout used for timestamps in encoded records.
+const TimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// JSONEncoder writes records as newline-delimited JSON objects.
+//
+// Every object has the following members. Members marked optional are
+// omitted when the record does not carry the corresponding value.
+//
+//	received       string  time the packet was received (TimeFormat, UTC)
+//	listener       string  name of the listener, optional
+//	exporter       string  address of the exporter, optional
+//	source_id      number  exporter's observation domain / source ID
+//	template_id    number  template the record was decoded with
+//	sequence       number  export packet sequence number
+//	start          string  first packet of the flow (TimeFormat), optional
+//	end            string  last packet of the flow (TimeFormat), optional
+//	duration_ms    number  end - start in milliseconds
+//	src_addr       string  optional
+//	dst_addr       string  optional
+//	next_hop       string  optional
+//	src_port       number
+//	dst_port       number
+//	protocol       number  IP protocol number
+//	protocol_name  string  IANA keyword for protocol, optional
+//	tcp_flags      number
+//	tos            number
+//	src_mask       number
+//	dst_mask       number
+//	src_as         number
+//	dst_as         number
+//	input_if       number
+//	output_if      number
+//	bytes          number
+//	packets        number
+//	flows          number  1 unless the exporter sent FLOWS
+//	fields         object  other known fields by FieldMap name, formatted
+//	                       by the field's String func, optional
+//	unknown        array   {"type": number, "value": hex string} for each
+//	                       field missing from FieldMap; only written when
+//	                       RawUnknown is set, optional
+//	enrichments    object  enrichment key to value, optional
+//
+// New members may be added; existing members keep their name and type.
+type JSONEncoder struct {
+	// RawUnknown includes fields that are missing from nfv9.FieldMap.
+	RawUnknown bool
+
+	enc *json.Encoder
+}
+
+func NewJSONEncoder(w io.Writer) *JSONEncoder {
+	return &JSONEncoder{enc: json.NewEncoder(w)}
+}
+
+type jsonField struct {
+	Type  uint16 `json:"type"`
+	Value string `json:"value"`
+}
+
+type jsonRecord struct {
+	Received     string            `json:"received"`
+	Listener     string            `json:"listener,omitempty"`
+	Exporter     string            `json:"exporter,omitempty"`
+	SourceID     uint32            `json:"source_id"`
+	TemplateID   uint16            `json:"template_id"`
+	Sequence     uint32            `json:"sequence"`
+	Start        string            `json:"start,omitempty"`
+	End          string            `json:"end,omitempty"`
+	DurationMS   int64             `json:"duration_ms"`
+	SrcAddr      string            `json:"src_addr,omitempty"`
+	DstAddr      string            `json:"dst_addr,omitempty"`
+	NextHop      string            `json:"next_hop,omitempty"`
+	SrcPort      uint16            `json:"src_port"`
+	DstPort      uint16            `json:"dst_port"`
+	Protocol     uint8             `json:"protocol"`
+	ProtocolName string            `json:"protocol_name,omitempty"`
+	TCPFlags     uint8             `json:"tcp_flags"`
+	TOS          uint8             `json:"tos"`
+	SrcMask      uint8             `json:"src_mask"`
+	DstMask      uint8             `json:"dst_mask"`
+	SrcAS        uint32            `json:"src_as"`
+	DstAS        uint32            `json:"dst_as"`
+	InputIf      uint32            `json:"input_if"`
+	OutputIf     uint32            `json:"output_if"`
+	Bytes        uint64            `json:"bytes"`
+	Packets      uint64            `json:"packets"`
+	Flows        uint64            `json:"flows"`
+	Fields       map[string]string `json:"fields,omitempty"`
+	Unknown      []jsonField       `json:"unknown,omitempty"`
+	Enrichments  map[string]string `json:"enrichments,omitempty"`
+}
+
+// typedFields are the field types decoded into Record's typed fields,
+// which are not repeated under "fields".
+var typedFields = map[uint16]bool{
+	1: true, 2: true, 3: true, 4: true, 5: true, 6: true, 7: true, 8: true,
+	9: true, 10: true, 11: true, 12: true, 13: true, 14: true, 15: true,
+	16: true, 17: true, 21: true, 22: true, 27: true, 28: true, 29: true,
+	30: true, 62: true,
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(TimeFormat)
+}
+
+// Encode writes r followed by a newline.
+func (e *JSONEncoder) Encode(r *Record) error {
+	jr := jsonRecord{
+		Received:    formatTime(r.Received),
+		Listener:    r.Listener,
+		SourceID:    r.SourceID,
+		TemplateID:  r.TemplateID,
+		Sequence:    r.Sequence,
+		Start:       formatTime(r.Start),
+		End:         formatTime(r.End),
+		DurationMS:  r.Duration().Milliseconds(),
+		SrcPort:     r.SrcPort,
+		DstPort:     r.DstPort,
+		Protocol:    r.Protocol,
+		TCPFlags:    r.TCPFlags,
+		TOS:         r.TOS,
+		SrcMask:     r.SrcMask,
+		DstMask:     r.DstMask,
+		SrcAS:       r.SrcAS,
+		DstAS:       r.DstAS,
+		InputIf:     r.InputIf,
+		OutputIf:    r.OutputIf,
+		Bytes:       r.Bytes,
+		Packets:     r.Packets,
+		Flows:       r.Flows,
+		Enrichments: r.Enrichments,
+	}
+	if r.Exporter.IsValid() {
+		jr.Exporter = r.Exporter.String()
+	}
+	if r.SrcAddr.IsValid() {
+		jr.SrcAddr = r.SrcAddr.String()
+	}
+	if r.DstAddr.IsValid() {
+		jr.DstAddr = r.DstAddr.String()
+	}
+	if r.NextHop.IsValid() {
+		jr.NextHop = r.NextHop.String()
+	}
+	if entry, ok := net2.IPProtocolMap[int(r.Protocol)]; ok {
+		jr.ProtocolName = entry.Keyword
+	}
+	for _, f := range r.Fields {
+		if typedFields[f.Type] {
+			continue
+		}
+		if !f.Known() {
+			if e.RawUnknown {
+				jr.Unknown = append(jr.Unknown, jsonField{f.Type, hex.EncodeToString(f.Value)})
+			}
+			continue
+		}
+		if f.zeroMAC() {
+			continue
+		}
+		if jr.Fields == nil {
+			jr.Fields = make(map[string]string)
+		}
+		jr.Fields[f.Name()] = r.fieldString(f)
+	}
+	return e.enc.Encode(&jr)
+}
@@ -0,0 +1,289 @@
+// Package flow defines a decoded flow record that is independent of the
+// export protocol it was received in.
+package flow
+
+import (
+	"net"
+	"net/netip"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/net2"
+	"github.com/brooksbp/go.netflow/pkg/nfv9"
+)
+
+// Record is a single decoded flow.
+type Record struct {
+	// Where the record came from.
+	Listener   string
+	Exporter   netip.Addr
+	SourceID   uint32
+	TemplateID uint16
+	Sequence   uint32
+	Received   time.Time
+
+	// Absolute start and end of the flow.
+	Start time.Time
+	End   time.Time
+
+	SrcAddr  netip.Addr
+	DstAddr  netip.Addr
+	NextHop  netip.Addr
+	SrcPort  uint16
+	DstPort  uint16
+	Protocol uint8
+	TCPFlags uint8
+	TOS      uint8
+	SrcMask  uint8
+	DstMask  uint8
+	SrcAS    uint32
+	DstAS    uint32
+	InputIf  uint32
+	OutputIf uint32
+
+	Bytes   uint64
+	Packets uint64
+	Flows   uint64
+
+	// Fields holds every field of the record in template order, including
+	// the ones that are also decoded into the typed fields above.
+	Fields []Field
+
+	// Enrichments holds values attached to the record after decoding,
+	// keyed by name, e.g. "src_host".
+	Enrichments map[string]string
+}
+
+// Field is a single raw template field.
+type Field struct {
+	Type  uint16
+	Value []byte
+}
+
+// Known reports whether the field type is listed in nfv9.FieldMap.
+func (f Field) Known() bool {
+	_, ok := nfv9.FieldMap[int(f.Type)]
+	return ok
+}
+
+// Name returns the field's name from nfv9.FieldMap, or "" if the type is
+// unknown.
+func (f Field) Name() string {
+	return nfv9.FieldMap[int(f.Type)].Name
+}
+
+// String formats the field's value using nfv9.FieldMap.
+func (f Field) String() string {
+	entry, ok := nfv9.FieldMap[int(f.Type)]
+	if !ok || entry.String == nil {
+		return nfv9.StringDefault(f.Value)
+	}
+	return entry.String(f.Value)
+}
+
+// Uint returns the field's value as a big-endian unsigned integer.
+func (f Field) Uint() uint64 {
+	var n uint64
+	for _, b := range f.Value {
+		n = n<<8 | uint64(b)
+	}
+	return n
+}
+
+// Addr returns the field's value as an IPv4 or IPv6 address.
+func (f Field) Addr() netip.Addr {
+	addr, _ := netip.AddrFromSlice(f.Value)
+	return addr
+}
+
+// Field returns the first field of type ty and whether it was present.
+func (r *Record) Field(ty uint16) (Field, bool) {
+	for _, f := range r.Fields {
+		if f.Type == ty {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+// SetField sets the value of the first field of type ty, adding the field
+// if the record has none.
+func (r *Record) SetField(ty uint16, value []byte) {
+	for i := range r.Fields {
+		if r.Fields[i].Type == ty {
+			r.Fields[i].Value = value
+			return
+		}
+	}
+	r.Fields = append(r.Fields, Field{Type: ty, Value: value})
+}
+
+// Enrich sets the enrichment key to value.
+func (r *Record) Enrich(key, value string) {
+	if r.Enrichments == nil {
+		r.Enrichments = make(map[string]string)
+	}
+	r.Enrichments[key] = value
+}
+
+// Duration returns the time between the first and last packet of the flow.
+func (r *Record) Duration() time.Duration {
+	return r.End.Sub(r.Start)
+}
+
+// FromNFV9 decodes a NetFlow v9 data record using the template it was
+// exported with. Field values are copied, so the record does not alias
+// dr.
+func FromNFV9(h *nfv9.Header, t *nfv9.Template, dr *nfv9.DataRecord) *Record {
+	r := &Record{
+		SourceID:   h.SourceID,
+		TemplateID: t.TemplateID,
+		Sequence:   h.SequenceNumber,
+	}
+
+	// FIRST_SWITCHED and LAST_SWITCHED are relative to the exporter's boot
+	// time.
+	boot := time.Unix(int64(h.UNIXSeconds), 0).Add(-time.Duration(h.SystemUptime) * time.Millisecond)
+
+	var i int
+	for _, tl := range t.Fields {
+		n := int(tl.Length)
+		if i+n > len(dr.Fields) {
+			break
+		}
+		value := make([]byte, n)
+		copy(value, dr.Fields[i:i+n])
+		i += n
+
+		f := Field{Type: tl.Type, Value: value}
+		r.Fields = append(r.Fields, f)
+
+		switch tl.Type {
+		case 1: // IN_BYTES
+			r.Bytes = f.Uint()
+		case 2: // IN_PKTS
+			r.Packets = f.Uint()
+		case 3: // FLOWS
+			r.Flows = f.Uint()
+		case 4: // PROTOCOL
+			r.Protocol = uint8(f.Uint())
+		case 5: // SRC_TOS
+			r.TOS = uint8(f.Uint())
+		case 6: // TCP_FLAGS
+			r.TCPFlags = uint8(f.Uint())
+		case 7: // L4_SRC_PORT
+			r.SrcPort = uint16(f.Uint())
+		case 8, 27: // IPV4_SRC_ADDR, IPV6_SRC_ADDR
+			r.SrcAddr = f.Addr()
+		case 9, 29: // SRC_MASK, IPV6_SRC_MASK
+			r.SrcMask = uint8(f.Uint())
+		case 10: // INPUT_SNMP
+			r.InputIf = uint32(f.Uint())
+		case 11: // L4_DST_PORT
+			r.DstPort = uint16(f.Uint())
+		case 12, 28: // IPV4_DST_ADDR, IPV6_DST_ADDR
+			r.DstAddr = f.Addr()
+		case 13, 30: // DST_MASK, IPV6_DST_MASK
+			r.DstMask = uint8(f.Uint())
+		case 14: // OUTPUT_SNMP
+			r.OutputIf = uint32(f.Uint())
+		case 15, 62: // IPV4_NEXT_HOP, IPV6_NEXT_HOP
+			r.NextHop = f.Addr()
+		case 16: // SRC_AS
+			r.SrcAS = uint32(f.Uint())
+		case 17: // DST_AS
+			r.DstAS = uint32(f.Uint())
+		case 21: // LAST_SWITCHED
+			r.End = boot.Add(time.Duration(f.Uint()) * time.Millisecond)
+		case 22: // FIRST_SWITCHED
+			r.Start = boot.Add(time.Duration(f.Uint()) * time.Millisecond)
+		}
+	}
+	if r.Flows == 0 {
+		r.Flows = 1
+	}
+	return r
+}
+
+// MAC returns the hardware address in field ty, or nil if the record has
+// no such field or it is all zeros.
+func (r *Record) MAC(ty uint16) net.HardwareAddr {
+	f, ok := r.Field(ty)
+	if !ok || net2.MACIsZero(f.Value) {
+		return nil
+	}
+	return net.HardwareAddr(f.Value)
+}
+
+// zeroMAC reports whether f is an IN_SRC_MAC, OUT_DST_MAC, IN_DST_MAC or
+// OUT_SRC_MAC field of all zeros, which exporters send for addresses they
+// don't know. MAC and the encoders treat such fields as absent.
+func (f Field) zeroMAC() bool {
+	switch f.Type {
+	case 56, 57, 80, 81:
+		return net2.MACIsZero(f.Value)
+	}
+	return false
+}
+
+// Flags returns the record's TCP flags, which print by name, e.g.
+// "SYN|ACK".
+func (r *Record) Flags() net2.TCPFlags {
+	return net2.TCPFlags(r.TCPFlags)
+}
+
+// DSCP returns the DSCP of the record's TOS byte.
+func (r *Record) DSCP() uint8 {
+	return net2.DSCP(r.TOS)
+}
+
+// ECN returns the ECN codepoint of the record's TOS byte.
+func (r *Record) ECN() uint8 {
+	return net2.ECN(r.TOS)
+}
+
+// ICMP returns the ICMP or ICMPv6 type and code of the record, and
+// whether it is an ICMP flow. They are read from the ICMP type fields, or
+// from L4_DST_PORT, where many exporters put type * 256 + code.
+func (r *Record) ICMP() (typ, code uint8, ok bool) {
+	if r.Protocol != 1 && r.Protocol != 58 {
+		return 0, 0, false
+	}
+	if f, ok := r.Field(32); ok { // ICMP_TYPE
+		n := f.Uint()
+		return uint8(n >> 8), uint8(n), true
+	}
+	if f, ok := r.Field(139); ok { // icmpTypeCodeIPv6
+		n := f.Uint()
+		return uint8(n >> 8), uint8(n), true
+	}
+	tf, cf := uint16(176), uint16(177) // icmpTypeIPv4, icmpCodeIPv4
+	if r.Protocol == 58 {
+		tf, cf = 178, 179 // icmpTypeIPv6, icmpCodeIPv6
+	}
+	if t, ok := r.Field(tf); ok {
+		c, _ := r.Field(cf)
+		return uint8(t.Uint()), uint8(c.Uint()), true
+	}
+	return uint8(r.DstPort >> 8), uint8(r.DstPort), true
+}
+
+// ICMPName returns the name of the record's ICMP message, e.g.
+// "echo-request", or "" if it is not an ICMP flow.
+func (r *Record) ICMPName() string {
+	typ, code, ok := r.ICMP()
+	if !ok {
+		return ""
+	}
+	return net2.FormatICMP(r.Protocol, typ, code)
+}
+
+// fieldString formats field f of the record. ICMP_TYPE, which is shared
+// by ICMP and ICMPv6, is named by the record's protocol.
+func (r *Record) fieldString(f Field) string {
+	if f.Type == 32 { // ICMP_TYPE
+		if name := r.ICMPName(); name != "" {
+			return name
+		}
+	}
+	return f.String()
+}
@@ -0,0 +1,107 @@
+package flow
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/brooksbp/go.netflow/pkg/net2"
+)
+
+// TextEncoder writes records as NAME: value pairs in template order,
+// with the record's listener and exporter first, reverse DNS names and
+// interface names next to the addresses and ifIndexes they name, MAC
+// addresses with their vendors, and the remaining enrichments last, sorted
+// by key.
+type TextEncoder struct {
+	w io.Writer
+}
+
+func NewTextEncoder(w io.Writer) *TextEncoder {
+	return &TextEncoder{w: w}
+}
+
+func (e *TextEncoder) Encode(r *Record) error {
+	fmt.Fprint(e.w, "LISTENER: ", r.Listener, " EXPORTER: ", r.Exporter, " ")
+	for _, field := range r.Fields {
+		if field.zeroMAC() {
+			continue
+		}
+		name := field.Name()
+		dataStr := r.fieldString(field)
+		if name == "" {
+			name = strconv.Itoa(int(field.Type))
+		}
+
+		fmt.Fprint(e.w, name, ": ")
+		switch name {
+		case "IPV4_SRC_ADDR", "IPV6_SRC_ADDR":
+			e.printHost(r, "src_host", dataStr)
+		case "IPV4_DST_ADDR", "IPV6_DST_ADDR":
+			e.printHost(r, "dst_host", dataStr)
+		case "IPV4_NEXT_HOP", "IPV6_NEXT_HOP":
+			e.printHost(r, "next_hop_host", dataStr)
+		case "INPUT_SNMP":
+			e.printInterface(r, "in_if_", dataStr)
+		case "OUTPUT_SNMP":
+			e.printInterface(r, "out_if_", dataStr)
+		case "L4_SRC_PORT", "L4_DST_PORT":
+			if service := net2.ServiceName(r.Protocol, uint16(field.Uint())); service != "" {
+				fmt.Fprint(e.w, service)
+			} else {
+				fmt.Fprint(e.w, dataStr)
+			}
+		case "IN_SRC_MAC", "IN_DST_MAC", "OUT_SRC_MAC", "OUT_DST_MAC":
+			fmt.Fprint(e.w, net2.FormatMAC(field.Value))
+		default:
+			fmt.Fprint(e.w, dataStr)
+		}
+		fmt.Fprint(e.w, " ")
+	}
+	keys := make([]string, 0, len(r.Enrichments))
+	for k := range r.Enrichments {
+		if !strings.HasSuffix(k, "_host") && !inline[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprint(e.w, k, ": ", r.Enrichments[k], " ")
+	}
+	_, err := fmt.Fprint(e.w, "\n\n")
+	return err
+}
+
+// inline are the enrichments printed next to the fields they describe
+// rather than after the fields.
+var inline = map[string]bool{
+	"in_if_name":  true,
+	"in_if_role":  true,
+	"out_if_name": true,
+	"out_if_role": true,
+}
+
+// printInterface prints the interface name and role, e.g. "Gi0/1
+// (uplink)", in place of its ifIndex when they are known.
+func (e *TextEncoder) printInterface(r *Record, prefix, dataStr string) {
+	name, role := r.Enrichments[prefix+"name"], r.Enrichments[prefix+"role"]
+	if name == "" {
+		name = dataStr
+	}
+	if role != "" {
+		fmt.Fprint(e.w, name, " (", role, ")")
+	} else {
+		fmt.Fprint(e.w, name)
+	}
+}
+
+func (e *TextEncoder) printHost(r *Record, key, dataStr string) {
+	if names, ok := r.Enrichments[key]; ok {
+		fmt.Fprint(e.w, "[", names, "]")
+		fmt.Fprint(e.w, " (", dataStr, ")")
+	} else {
+		fmt.Fprint(e.w, dataStr)
+	}
+}
@@ -0,0 +1,138 @@
+// Package flowgrpc serves decoded flows over the gRPC FlowService defined
+// in pkg/flowpb.
+package flowgrpc
+
+import (
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/brooksbp/go.netflow/pkg/filter"
+	"github.com/brooksbp/go.netflow/pkg/flow"
+	"github.com/brooksbp/go.netflow/pkg/flowpb"
+)
+
+// DefaultBuffer is the number of flows queued per subscriber.
+const DefaultBuffer = 10000
+
+// Server streams the records passed to Write to FlowService subscribers.
+type Server struct {
+	flowpb.UnimplementedFlowServiceServer
+
+	// Compile turns a subscription filter into a predicate. It defaults
+	// to CompileFilter.
+	Compile func(expr string) (func(r *flow.Record) bool, error)
+	// Buffer is the number of flows queued per subscriber. Flows are
+	// dropped for subscribers whose queue is full.
+	Buffer int
+
+	mu     sync.Mutex
+	subs   map[*subscriber]bool
+	closed bool
+}
+
+type subscriber struct {
+	match func(r *flow.Record) bool
+	ch    chan *flowpb.Flow
+}
+
+// CompileFilter compiles a filter expression, see package filter.
+func CompileFilter(expr string) (func(r *flow.Record) bool, error) {
+	f, err := filter.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	return f.Match, nil
+}
+
+func NewServer() *Server {
+	return &Server{
+		Compile: CompileFilter,
+		Buffer:  DefaultBuffer,
+		subs:    make(map[*subscriber]bool),
+	}
+}
+
+// Write sends r to every subscriber whose filter matches it, without
+// blocking.
+func (s *Server) Write(r *flow.Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var f *flowpb.Flow
+	for sub := range s.subs {
+		if !sub.match(r) {
+			continue
+		}
+		if f == nil {
+			f = flowpb.FromRecord(r)
+		}
+		select {
+		case sub.ch <- f:
+		default:
+		}
+	}
+	return nil
+}
+
+// Close ends every subscription.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sub := range s.subs {
+		close(sub.ch)
+		delete(s.subs, sub)
+	}
+	s.closed = true
+	return nil
+}
+
+// Subscribe implements flowpb.FlowServiceServer.
+func (s *Server) Subscribe(req *flowpb.SubscribeRequest, stream flowpb.FlowService_SubscribeServer) error {
+	match, err := s.Compile(req.GetFilter())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	buffer := s.Buffer
+	if buffer <= 0 {
+		buffer = DefaultBuffer
+	}
+	sub := &subscriber{
+		match: match,
+		ch:    make(chan *flowpb.Flow, buffer),
+	}
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return status.Error(codes.Unavailable, "server closed")
+	}
+	s.subs[sub] = true
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		if s.subs[sub] {
+			delete(s.subs, sub)
+			close(sub.ch)
+		}
+		s.mu.Unlock()
+	}()
+
+	ctx := stream.Context()
+	for {
+		select {
+		case f, ok := <-sub.ch:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(f); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
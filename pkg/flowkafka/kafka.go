@@ -0,0 +1,55 @@
+package flowkafka
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// kafkaClient is a Client backed by a kafka-go Writer.
+type kafkaClient struct {
+	w *kafka.Writer
+}
+
+// NewKafkaClient returns a Client producing to topic on brokers.
+// Compression is "", "gzip", "snappy", "lz4" or "zstd". Messages with the
+// same key go to the same partition.
+func NewKafkaClient(brokers []string, topic, compression string) (Client, error) {
+	w := &kafka.Writer{
+		Addr:     kafka.TCP(brokers...),
+		Topic:    topic,
+		Balancer: &kafka.Hash{},
+		// Batching and retries are done by the Producer; flush each
+		// Send promptly.
+		BatchTimeout: 10 * time.Millisecond,
+		MaxAttempts:  1,
+	}
+	switch compression {
+	case "":
+	case "gzip":
+		w.Compression = kafka.Gzip
+	case "snappy":
+		w.Compression = kafka.Snappy
+	case "lz4":
+		w.Compression = kafka.Lz4
+	case "zstd":
+		w.Compression = kafka.Zstd
+	default:
+		return nil, fmt.Errorf("flowkafka: unknown compression %q", compression)
+	}
+	return &kafkaClient{w: w}, nil
+}
+
+func (c *kafkaClient) Send(ctx context.Context, msgs []Message) error {
+	kmsgs := make([]kafka.Message, len(msgs))
+	for i, msg := range msgs {
+		kmsgs[i] = kafka.Message{Key: msg.Key, Value: msg.Value}
+	}
+	return c.w.WriteMessages(ctx, kmsgs...)
+}
+
+func (c *kafkaClient) Close() error {
+	return c.w.Close()
+}
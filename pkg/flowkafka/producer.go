@@ -0,0 +1,262 @@
+// Package flowkafka publishes flow records to Kafka.
+//
+// A Producer serializes records, buffers them in a bounded queue and sends
+// them in batches through a Client. NewKafkaClient returns a Client for a
+// real cluster; tests and other transports can supply their own.
+package flowkafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+)
+
+// Message is a single Kafka message.
+type Message struct {
+	Key   []byte
+	Value []byte
+}
+
+// Client sends batches of messages to a topic.
+type Client interface {
+	Send(ctx context.Context, msgs []Message) error
+	Close() error
+}
+
+// Message keys.
+const (
+	// KeyNone leaves messages unkeyed.
+	KeyNone = "none"
+	// KeyExporter keys messages by exporter address, keeping each
+	// exporter's records in one partition.
+	KeyExporter = "exporter"
+	// KeyFlow keys messages by the unordered pair of source and
+	// destination address, keeping both directions of a conversation in
+	// one partition.
+	KeyFlow = "flow"
+)
+
+// Config tunes a Producer.
+type Config struct {
+	// Key is KeyNone, KeyExporter or KeyFlow.
+	Key string
+	// BatchSize is the maximum number of messages per Send.
+	BatchSize int
+	// BatchTimeout is the longest a message waits for its batch to fill.
+	BatchTimeout time.Duration
+	// BufferSize bounds the number of queued messages. Records written
+	// while the queue is full are dropped.
+	BufferSize int
+	// MaxRetries is the number of times a failed batch is retried.
+	MaxRetries int
+	// RetryBackoff is the delay before the first retry. It doubles with
+	// every retry.
+	RetryBackoff time.Duration
+}
+
+// DefaultConfig is used for zero fields of a Config.
+var DefaultConfig = Config{
+	Key:          KeyExporter,
+	BatchSize:    1000,
+	BatchTimeout: time.Second,
+	BufferSize:   100000,
+	MaxRetries:   5,
+	RetryBackoff: 100 * time.Millisecond,
+}
+
+// ErrBufferFull is returned by Write when the queue is full.
+var ErrBufferFull = errors.New("flowkafka: buffer full")
+
+// ErrClosed is returned by Write after Close.
+var ErrClosed = errors.New("flowkafka: producer closed")
+
+// Stats counts messages by outcome.
+type Stats struct {
+	Sent    uint64
+	Dropped uint64
+	Failed  uint64
+	Queued  int
+}
+
+// Producer publishes records through a Client.
+type Producer struct {
+	client Client
+	ser    Serializer
+	cfg    Config
+
+	mu     sync.RWMutex
+	closed bool
+	queue  chan Message
+	done   chan struct{}
+
+	sent    atomic.Uint64
+	dropped atomic.Uint64
+	failed  atomic.Uint64
+}
+
+// NewProducer starts a Producer sending records serialized by ser through
+// c.
+func NewProducer(c Client, ser Serializer, cfg Config) (*Producer, error) {
+	if cfg.Key == "" {
+		cfg.Key = DefaultConfig.Key
+	}
+	switch cfg.Key {
+	case KeyNone, KeyExporter, KeyFlow:
+	default:
+		return nil, fmt.Errorf("flowkafka: unknown key %q", cfg.Key)
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultConfig.BatchSize
+	}
+	if cfg.BatchTimeout <= 0 {
+		cfg.BatchTimeout = DefaultConfig.BatchTimeout
+	}
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = DefaultConfig.BufferSize
+	}
+	if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = 0
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = DefaultConfig.RetryBackoff
+	}
+	p := &Producer{
+		client: c,
+		ser:    ser,
+		cfg:    cfg,
+		queue:  make(chan Message, cfg.BufferSize),
+		done:   make(chan struct{}),
+	}
+	go p.run()
+	return p, nil
+}
+
+func (p *Producer) key(r *flow.Record) []byte {
+	switch p.cfg.Key {
+	case KeyExporter:
+		if r.Exporter.IsValid() {
+			return r.Exporter.AsSlice()
+		}
+	case KeyFlow:
+		a, b := r.SrcAddr, r.DstAddr
+		if b.Less(a) {
+			a, b = b, a
+		}
+		var key []byte
+		if a.IsValid() {
+			key = append(key, a.AsSlice()...)
+		}
+		if b.IsValid() {
+			key = append(key, b.AsSlice()...)
+		}
+		return key
+	}
+	return nil
+}
+
+// Write serializes r and queues it without blocking. It returns
+// ErrBufferFull and drops r if the queue is full.
+func (p *Producer) Write(r *flow.Record) error {
+	value, err := p.ser.Serialize(r)
+	if err != nil {
+		return err
+	}
+	msg := Message{Key: p.key(r), Value: value}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return ErrClosed
+	}
+	select {
+	case p.queue <- msg:
+		return nil
+	default:
+		p.dropped.Add(1)
+		return ErrBufferFull
+	}
+}
+
+func (p *Producer) run() {
+	defer close(p.done)
+
+	batch := make([]Message, 0, p.cfg.BatchSize)
+	timer := time.NewTimer(p.cfg.BatchTimeout)
+	defer timer.Stop()
+	for {
+		select {
+		case msg, ok := <-p.queue:
+			if !ok {
+				p.send(batch)
+				return
+			}
+			batch = append(batch, msg)
+			if len(batch) < p.cfg.BatchSize {
+				continue
+			}
+		case <-timer.C:
+		}
+		p.send(batch)
+		batch = batch[:0]
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(p.cfg.BatchTimeout)
+	}
+}
+
+// send delivers a batch, retrying with exponential backoff.
+func (p *Producer) send(batch []Message) {
+	if len(batch) == 0 {
+		return
+	}
+	backoff := p.cfg.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		err := p.client.Send(context.Background(), batch)
+		if err == nil {
+			p.sent.Add(uint64(len(batch)))
+			return
+		}
+		if attempt >= p.cfg.MaxRetries {
+			p.failed.Add(uint64(len(batch)))
+			fmt.Fprintln(os.Stderr, "Error: kafka:", err, "dropping", len(batch), "messages")
+			return
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// Stats returns the producer's counters.
+func (p *Producer) Stats() Stats {
+	return Stats{
+		Sent:    p.sent.Load(),
+		Dropped: p.dropped.Load(),
+		Failed:  p.failed.Load(),
+		Queued:  len(p.queue),
+	}
+}
+
+// Close sends the queued messages and closes the client.
+func (p *Producer) Close() error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil
+	}
+	p.closed = true
+	close(p.queue)
+	p.mu.Unlock()
+
+	<-p.done
+	return p.client.Close()
+}
@@ -0,0 +1,290 @@
+package flowkafka
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+	"github.com/brooksbp/go.netflow/pkg/flowpb"
+)
+
+// fakeClient is a Client that records the batches it is sent.
+type fakeClient struct {
+	mu      sync.Mutex
+	batches [][]Message
+	sends   int
+	closed  bool
+
+	// fail is the number of Sends that fail before one succeeds.
+	fail int
+	// entered, if set, receives a value when Send is called, and
+	// release, if set, blocks Send until it is closed.
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (c *fakeClient) Send(ctx context.Context, msgs []Message) error {
+	if c.entered != nil {
+		c.entered <- struct{}{}
+	}
+	if c.release != nil {
+		<-c.release
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sends++
+	if c.fail > 0 {
+		c.fail--
+		return errors.New("broker unavailable")
+	}
+	c.batches = append(c.batches, append([]Message(nil), msgs...))
+	return nil
+}
+
+func (c *fakeClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
+
+func (c *fakeClient) messages() []Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var msgs []Message
+	for _, b := range c.batches {
+		msgs = append(msgs, b...)
+	}
+	return msgs
+}
+
+// rawSerializer serializes records as their byte count.
+type rawSerializer struct{}
+
+func (rawSerializer) Serialize(r *flow.Record) ([]byte, error) {
+	return []byte{byte(r.Bytes)}, nil
+}
+
+func testRecord() *flow.Record {
+	return &flow.Record{
+		Listener: "edge",
+		Exporter: netip.MustParseAddr("192.0.2.1"),
+		Received: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+		SrcAddr:  netip.MustParseAddr("10.0.0.2"),
+		DstAddr:  netip.MustParseAddr("10.0.0.1"),
+		SrcPort:  51000,
+		DstPort:  443,
+		Protocol: 6,
+		Bytes:    1500,
+		Packets:  3,
+		Flows:    1,
+	}
+}
+
+func TestProducerKey(t *testing.T) {
+	fwd := testRecord()
+	rev := testRecord()
+	rev.SrcAddr, rev.DstAddr = fwd.DstAddr, fwd.SrcAddr
+	noExporter := testRecord()
+	noExporter.Exporter = netip.Addr{}
+
+	tests := []struct {
+		key  string
+		r    *flow.Record
+		want []byte
+	}{
+		{KeyNone, fwd, nil},
+		{KeyExporter, fwd, []byte{192, 0, 2, 1}},
+		{KeyExporter, noExporter, nil},
+		{KeyFlow, fwd, []byte{10, 0, 0, 1, 10, 0, 0, 2}},
+		{KeyFlow, rev, []byte{10, 0, 0, 1, 10, 0, 0, 2}},
+	}
+	for _, tt := range tests {
+		c := &fakeClient{}
+		p, err := NewProducer(c, rawSerializer{}, Config{Key: tt.key, BatchTimeout: time.Millisecond})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := p.Write(tt.r); err != nil {
+			t.Fatal(err)
+		}
+		if err := p.Close(); err != nil {
+			t.Fatal(err)
+		}
+		msgs := c.messages()
+		if len(msgs) != 1 {
+			t.Fatalf("key %s: got %d messages, want 1", tt.key, len(msgs))
+		}
+		if !bytes.Equal(msgs[0].Key, tt.want) {
+			t.Errorf("key %s %v->%v: got key %v, want %v", tt.key, tt.r.SrcAddr, tt.r.DstAddr, msgs[0].Key, tt.want)
+		}
+	}
+	if _, err := NewProducer(&fakeClient{}, rawSerializer{}, Config{Key: "bogus"}); err == nil {
+		t.Error("unknown key: got no error")
+	}
+}
+
+func TestSerializers(t *testing.T) {
+	codec, err := goavro.NewCodec(AvroSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each decode returns the source address and byte count of a
+	// message value.
+	tests := []struct {
+		name   string
+		decode func([]byte) (string, uint64, error)
+	}{
+		{"json", func(b []byte) (string, uint64, error) {
+			var v struct {
+				SrcAddr string `json:"src_addr"`
+				Bytes   uint64 `json:"bytes"`
+			}
+			err := json.Unmarshal(b, &v)
+			return v.SrcAddr, v.Bytes, err
+		}},
+		{"protobuf", func(b []byte) (string, uint64, error) {
+			var f flowpb.Flow
+			if err := proto.Unmarshal(b, &f); err != nil {
+				return "", 0, err
+			}
+			addr, _ := netip.AddrFromSlice(f.SrcAddr)
+			return addr.String(), f.Bytes, nil
+		}},
+		{"avro", func(b []byte) (string, uint64, error) {
+			native, rest, err := codec.NativeFromBinary(b)
+			if err != nil {
+				return "", 0, err
+			}
+			if len(rest) != 0 {
+				return "", 0, errors.New("trailing data")
+			}
+			m := native.(map[string]interface{})
+			src := m["src_addr"].(map[string]interface{})["string"].(string)
+			return src, uint64(m["bytes"].(int64)), nil
+		}},
+	}
+	for _, tt := range tests {
+		ser, err := NewSerializer(tt.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := ser.Serialize(testRecord())
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		src, n, err := tt.decode(b)
+		if err != nil {
+			t.Fatalf("%s: decode: %v", tt.name, err)
+		}
+		if src != "10.0.0.2" || n != 1500 {
+			t.Errorf("%s: got src %s bytes %d, want 10.0.0.2 1500", tt.name, src, n)
+		}
+	}
+	if _, err := NewSerializer("xml"); err == nil {
+		t.Error("unknown serialization: got no error")
+	}
+}
+
+func TestProducerRetry(t *testing.T) {
+	tests := []struct {
+		fail, maxRetries int
+		sends            int
+		sent, failed     uint64
+	}{
+		{fail: 0, maxRetries: 2, sends: 1, sent: 3},
+		{fail: 2, maxRetries: 2, sends: 3, sent: 3},
+		{fail: 3, maxRetries: 2, sends: 3, failed: 3},
+		{fail: 1, maxRetries: 0, sends: 1, failed: 3},
+	}
+	for _, tt := range tests {
+		c := &fakeClient{fail: tt.fail}
+		p, err := NewProducer(c, rawSerializer{}, Config{
+			BatchSize:    3,
+			BatchTimeout: time.Hour,
+			MaxRetries:   tt.maxRetries,
+			RetryBackoff: time.Millisecond,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 3; i++ {
+			if err := p.Write(testRecord()); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := p.Close(); err != nil {
+			t.Fatal(err)
+		}
+		s := p.Stats()
+		if c.sends != tt.sends || s.Sent != tt.sent || s.Failed != tt.failed {
+			t.Errorf("fail %d retries %d: got %d sends, %d sent, %d failed; want %d, %d, %d",
+				tt.fail, tt.maxRetries, c.sends, s.Sent, s.Failed, tt.sends, tt.sent, tt.failed)
+		}
+		if !c.closed {
+			t.Errorf("fail %d retries %d: client not closed", tt.fail, tt.maxRetries)
+		}
+	}
+}
+
+func TestProducerBufferFull(t *testing.T) {
+	c := &fakeClient{
+		entered: make(chan struct{}, 10),
+		release: make(chan struct{}),
+	}
+	p, err := NewProducer(c, rawSerializer{}, Config{
+		BatchSize:    1,
+		BatchTimeout: time.Hour,
+		BufferSize:   2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first record is taken off the queue and blocks in Send, the
+	// next two fill the queue and the last is dropped.
+	for i := 0; i < 4; i++ {
+		r := testRecord()
+		r.Bytes = uint64(i)
+		err := p.Write(r)
+		if i < 3 && err != nil {
+			t.Fatalf("write %d: %v", i, err)
+		}
+		if i == 3 && err != ErrBufferFull {
+			t.Fatalf("write %d: got %v, want ErrBufferFull", i, err)
+		}
+		if i == 0 {
+			<-c.entered
+		}
+	}
+	if s := p.Stats(); s.Dropped != 1 || s.Queued != 2 {
+		t.Errorf("got %d dropped, %d queued; want 1, 2", s.Dropped, s.Queued)
+	}
+
+	close(c.release)
+	if err := p.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Write(testRecord()); err != ErrClosed {
+		t.Errorf("write after close: got %v, want ErrClosed", err)
+	}
+	var got []byte
+	for _, msg := range c.messages() {
+		got = append(got, msg.Value...)
+	}
+	if want := []byte{0, 1, 2}; !bytes.Equal(got, want) {
+		t.Errorf("sent %v, want %v", got, want)
+	}
+	if s := p.Stats(); s.Sent != 3 || s.Dropped != 1 {
+		t.Errorf("got %d sent, %d dropped; want 3, 1", s.Sent, s.Dropped)
+	}
+}
@@ -0,0 +1,191 @@
+// Package flowmetrics aggregates flow records into Prometheus counters
+// labelled by low-cardinality dimensions such as exporter, interface and
+// protocol.
+package flowmetrics
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+	"github.com/brooksbp/go.netflow/pkg/net2"
+)
+
+// Dimensions maps dimension names to the label value they take from a
+// record.
+var Dimensions = map[string]func(r *flow.Record) string{
+	"listener": func(r *flow.Record) string { return r.Listener },
+	"exporter": func(r *flow.Record) string {
+		if !r.Exporter.IsValid() {
+			return ""
+		}
+		return r.Exporter.String()
+	},
+	"input_if":  func(r *flow.Record) string { return strconv.FormatUint(uint64(r.InputIf), 10) },
+	"output_if": func(r *flow.Record) string { return strconv.FormatUint(uint64(r.OutputIf), 10) },
+	"protocol": func(r *flow.Record) string {
+		if entry, ok := net2.IPProtocolMap[int(r.Protocol)]; ok {
+			return entry.Keyword
+		}
+		return strconv.Itoa(int(r.Protocol))
+	},
+	"app": App,
+	"service": func(r *flow.Record) string {
+		return net2.DefaultClassifier.Service(r.Protocol, r.SrcPort, r.DstPort)
+	},
+	"src_as": func(r *flow.Record) string { return strconv.FormatUint(uint64(r.SrcAS), 10) },
+	"dst_as": func(r *flow.Record) string { return strconv.FormatUint(uint64(r.DstAS), 10) },
+	// Enrichments attached by site tagging.
+	"direction": func(r *flow.Record) string { return r.Enrichments["direction"] },
+	"src_site":  func(r *flow.Record) string { return r.Enrichments["src_site"] },
+	"dst_site":  func(r *flow.Record) string { return r.Enrichments["dst_site"] },
+}
+
+// App classifies a record's application by its service port, as chosen
+// by net2.DefaultClassifier: the service name if the port is known,
+// otherwise "well-known", "registered" or "dynamic" by IANA port range.
+// Records without ports are "none".
+func App(r *flow.Record) string {
+	port, side := net2.DefaultClassifier.ServicePort(r.Protocol, r.SrcPort, r.DstPort)
+	if side == net2.SideNone {
+		return "none"
+	}
+	if name := net2.ServiceName(r.Protocol, port); name != "" {
+		return name
+	}
+	return net2.ClassifyPort(port).String()
+}
+
+// Other is the label value of the series that counts records beyond the
+// series limit.
+const Other = "other"
+
+// Config selects the dimensions and limits of an Aggregator.
+type Config struct {
+	// Dimensions are names from Dimensions, used as labels in order.
+	Dimensions []string
+	// MaxSeries limits the number of label combinations tracked. Records
+	// with new combinations beyond the limit are counted in a single
+	// series whose labels are all Other. Zero means no limit.
+	MaxSeries int
+	// TopK exports only the K series with the most bytes on each scrape.
+	// Zero exports every series.
+	TopK int
+}
+
+type series struct {
+	labels                []string
+	bytes, packets, flows uint64
+}
+
+// Aggregator counts bytes, packets and flows per label combination. It
+// implements prometheus.Collector.
+type Aggregator struct {
+	cfg    Config
+	values []func(r *flow.Record) string
+
+	bytesDesc   *prometheus.Desc
+	packetsDesc *prometheus.Desc
+	flowsDesc   *prometheus.Desc
+
+	mu     sync.Mutex
+	series map[string]*series
+}
+
+// New returns an Aggregator for cfg.
+func New(cfg Config) (*Aggregator, error) {
+	if len(cfg.Dimensions) == 0 {
+		return nil, fmt.Errorf("flowmetrics: no dimensions")
+	}
+	a := &Aggregator{
+		cfg:    cfg,
+		series: make(map[string]*series),
+	}
+	seen := make(map[string]bool, len(cfg.Dimensions))
+	for _, d := range cfg.Dimensions {
+		value, ok := Dimensions[d]
+		if !ok {
+			return nil, fmt.Errorf("flowmetrics: unknown dimension %q", d)
+		}
+		if seen[d] {
+			return nil, fmt.Errorf("flowmetrics: repeated dimension %q", d)
+		}
+		seen[d] = true
+		a.values = append(a.values, value)
+	}
+	a.bytesDesc = prometheus.NewDesc("netflow_traffic_bytes_total",
+		"Bytes of flows decoded, by dimension.", cfg.Dimensions, nil)
+	a.packetsDesc = prometheus.NewDesc("netflow_traffic_packets_total",
+		"Packets of flows decoded, by dimension.", cfg.Dimensions, nil)
+	a.flowsDesc = prometheus.NewDesc("netflow_traffic_flows_total",
+		"Flows decoded, by dimension.", cfg.Dimensions, nil)
+	return a, nil
+}
+
+// Write counts r.
+func (a *Aggregator) Write(r *flow.Record) error {
+	labels := make([]string, len(a.values))
+	for i, value := range a.values {
+		labels[i] = value(r)
+	}
+	key := strings.Join(labels, "\x00")
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	s, ok := a.series[key]
+	if !ok {
+		if a.cfg.MaxSeries > 0 && len(a.series) >= a.cfg.MaxSeries {
+			for i := range labels {
+				labels[i] = Other
+			}
+			key = strings.Join(labels, "\x00")
+			s, ok = a.series[key]
+		}
+		if !ok {
+			s = &series{labels: labels}
+			a.series[key] = s
+		}
+	}
+	s.bytes += r.Bytes
+	s.packets += r.Packets
+	s.flows += r.Flows
+	return nil
+}
+
+// Close implements the collector's Output interface.
+func (a *Aggregator) Close() error {
+	return nil
+}
+
+// Describe implements prometheus.Collector.
+func (a *Aggregator) Describe(ch chan<- *prometheus.Desc) {
+	ch <- a.bytesDesc
+	ch <- a.packetsDesc
+	ch <- a.flowsDesc
+}
+
+// Collect implements prometheus.Collector.
+func (a *Aggregator) Collect(ch chan<- prometheus.Metric) {
+	a.mu.Lock()
+	all := make([]series, 0, len(a.series))
+	for _, s := range a.series {
+		all = append(all, *s)
+	}
+	a.mu.Unlock()
+
+	if a.cfg.TopK > 0 && len(all) > a.cfg.TopK {
+		sort.Slice(all, func(i, j int) bool { return all[i].bytes > all[j].bytes })
+		all = all[:a.cfg.TopK]
+	}
+	for _, s := range all {
+		ch <- prometheus.MustNewConstMetric(a.bytesDesc, prometheus.CounterValue, float64(s.bytes), s.labels...)
+		ch <- prometheus.MustNewConstMetric(a.packetsDesc, prometheus.CounterValue, float64(s.packets), s.labels...)
+		ch <- prometheus.MustNewConstMetric(a.flowsDesc, prometheus.CounterValue, float64(s.flows), s.labels...)
+	}
+}
@@ -0,0 +1,139 @@
+// Package flowparquet writes flow records to Apache Parquet files.
+package flowparquet
+
+import (
+	"io"
+	"net/netip"
+	"time"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+)
+
+// Row is the Parquet schema of a flow record. Addresses are strings,
+// counters are unsigned 64-bit integers and times are TIMESTAMP_MILLIS.
+// Optional columns are null when the exporter did not send the value, or
+// for received, when the record has no time received.
+type Row struct {
+	Received   *int64 `parquet:"name=received, type=INT64, convertedtype=TIMESTAMP_MILLIS, repetitiontype=OPTIONAL"`
+	Listener   string `parquet:"name=listener, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Exporter   string `parquet:"name=exporter, type=BYTE_ARRAY, convertedtype=UTF8"`
+	SourceID   int32  `parquet:"name=source_id, type=INT32, convertedtype=UINT_32"`
+	TemplateID int32  `parquet:"name=template_id, type=INT32, convertedtype=UINT_16"`
+	Sequence   int32  `parquet:"name=sequence, type=INT32, convertedtype=UINT_32"`
+
+	Start *int64 `parquet:"name=start, type=INT64, convertedtype=TIMESTAMP_MILLIS, repetitiontype=OPTIONAL"`
+	End   *int64 `parquet:"name=end, type=INT64, convertedtype=TIMESTAMP_MILLIS, repetitiontype=OPTIONAL"`
+
+	SrcAddr  *string `parquet:"name=src_addr, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	DstAddr  *string `parquet:"name=dst_addr, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	NextHop  *string `parquet:"name=next_hop, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	SrcPort  int32   `parquet:"name=src_port, type=INT32, convertedtype=UINT_16"`
+	DstPort  int32   `parquet:"name=dst_port, type=INT32, convertedtype=UINT_16"`
+	Protocol int32   `parquet:"name=protocol, type=INT32, convertedtype=UINT_8"`
+	TCPFlags int32   `parquet:"name=tcp_flags, type=INT32, convertedtype=UINT_8"`
+	TOS      int32   `parquet:"name=tos, type=INT32, convertedtype=UINT_8"`
+	SrcMask  int32   `parquet:"name=src_mask, type=INT32, convertedtype=UINT_8"`
+	DstMask  int32   `parquet:"name=dst_mask, type=INT32, convertedtype=UINT_8"`
+	SrcAS    int32   `parquet:"name=src_as, type=INT32, convertedtype=UINT_32"`
+	DstAS    int32   `parquet:"name=dst_as, type=INT32, convertedtype=UINT_32"`
+	InputIf  int32   `parquet:"name=input_if, type=INT32, convertedtype=UINT_32"`
+	OutputIf int32   `parquet:"name=output_if, type=INT32, convertedtype=UINT_32"`
+
+	Bytes   int64 `parquet:"name=bytes, type=INT64, convertedtype=UINT_64"`
+	Packets int64 `parquet:"name=packets, type=INT64, convertedtype=UINT_64"`
+	Flows   int64 `parquet:"name=flows, type=INT64, convertedtype=UINT_64"`
+
+	Enrichments map[string]string `parquet:"name=enrichments, type=MAP, convertedtype=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+}
+
+// DefaultRowGroupSize is the target size of a row group in bytes.
+const DefaultRowGroupSize = 128 << 20
+
+// Encoder writes records as rows of a single Parquet file.
+type Encoder struct {
+	pw *writer.ParquetWriter
+}
+
+// NewEncoder returns an Encoder writing to w with row groups of about
+// rowGroupSize bytes, or DefaultRowGroupSize if rowGroupSize is 0. The
+// file is complete once Close returns.
+func NewEncoder(w io.Writer, rowGroupSize int64) (*Encoder, error) {
+	pw, err := writer.NewParquetWriterFromWriter(w, new(Row), 1)
+	if err != nil {
+		return nil, err
+	}
+	if rowGroupSize > 0 {
+		pw.RowGroupSize = rowGroupSize
+	} else {
+		pw.RowGroupSize = DefaultRowGroupSize
+	}
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+	return &Encoder{pw: pw}, nil
+}
+
+// millis returns t in Unix milliseconds, or nil for the zero time, whose
+// UnixMilli is far before 1970.
+func millis(t time.Time) *int64 {
+	if t.IsZero() {
+		return nil
+	}
+	ms := t.UnixMilli()
+	return &ms
+}
+
+func addr(a netip.Addr) *string {
+	if !a.IsValid() {
+		return nil
+	}
+	s := a.String()
+	return &s
+}
+
+// NewRow converts r to a Row.
+func NewRow(r *flow.Record) *Row {
+	row := &Row{
+		Received:    millis(r.Received),
+		Listener:    r.Listener,
+		SourceID:    int32(r.SourceID),
+		TemplateID:  int32(r.TemplateID),
+		Sequence:    int32(r.Sequence),
+		Start:       millis(r.Start),
+		End:         millis(r.End),
+		SrcAddr:     addr(r.SrcAddr),
+		DstAddr:     addr(r.DstAddr),
+		NextHop:     addr(r.NextHop),
+		SrcPort:     int32(r.SrcPort),
+		DstPort:     int32(r.DstPort),
+		Protocol:    int32(r.Protocol),
+		TCPFlags:    int32(r.TCPFlags),
+		TOS:         int32(r.TOS),
+		SrcMask:     int32(r.SrcMask),
+		DstMask:     int32(r.DstMask),
+		SrcAS:       int32(r.SrcAS),
+		DstAS:       int32(r.DstAS),
+		InputIf:     int32(r.InputIf),
+		OutputIf:    int32(r.OutputIf),
+		Bytes:       int64(r.Bytes),
+		Packets:     int64(r.Packets),
+		Flows:       int64(r.Flows),
+		Enrichments: r.Enrichments,
+	}
+	if r.Exporter.IsValid() {
+		row.Exporter = r.Exporter.String()
+	}
+	return row
+}
+
+// Encode appends r to the file.
+func (e *Encoder) Encode(r *flow.Record) error {
+	return e.pw.Write(NewRow(r))
+}
+
+// Close flushes the last row group and writes the file footer. It does
+// not close the underlying writer.
+func (e *Encoder) Close() error {
+	return e.pw.WriteStop()
+}
@@ -0,0 +1,112 @@
+// Package flowpb holds the Protocol Buffers representation of flow
+// records, generated from flow.proto.
+package flowpb
+
+import (
+	"net/netip"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+)
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func fromTimestamp(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func addrBytes(a netip.Addr) []byte {
+	if !a.IsValid() {
+		return nil
+	}
+	return a.AsSlice()
+}
+
+func fromAddrBytes(b []byte) netip.Addr {
+	a, _ := netip.AddrFromSlice(b)
+	return a
+}
+
+// FromRecord converts r to its protobuf representation.
+func FromRecord(r *flow.Record) *Flow {
+	f := &Flow{
+		Received:    timestamp(r.Received),
+		Listener:    r.Listener,
+		Exporter:    addrBytes(r.Exporter),
+		SourceId:    r.SourceID,
+		TemplateId:  uint32(r.TemplateID),
+		Sequence:    r.Sequence,
+		Start:       timestamp(r.Start),
+		End:         timestamp(r.End),
+		SrcAddr:     addrBytes(r.SrcAddr),
+		DstAddr:     addrBytes(r.DstAddr),
+		NextHop:     addrBytes(r.NextHop),
+		SrcPort:     uint32(r.SrcPort),
+		DstPort:     uint32(r.DstPort),
+		Protocol:    uint32(r.Protocol),
+		TcpFlags:    uint32(r.TCPFlags),
+		Tos:         uint32(r.TOS),
+		SrcMask:     uint32(r.SrcMask),
+		DstMask:     uint32(r.DstMask),
+		SrcAs:       r.SrcAS,
+		DstAs:       r.DstAS,
+		InputIf:     r.InputIf,
+		OutputIf:    r.OutputIf,
+		Bytes:       r.Bytes,
+		Packets:     r.Packets,
+		Flows:       r.Flows,
+		Enrichments: r.Enrichments,
+	}
+	for _, field := range r.Fields {
+		f.Fields = append(f.Fields, &Field{Type: uint32(field.Type), Value: field.Value})
+	}
+	return f
+}
+
+// Record converts f back to a flow.Record.
+func (f *Flow) Record() *flow.Record {
+	r := &flow.Record{
+		Received:   fromTimestamp(f.Received),
+		Listener:   f.Listener,
+		Exporter:   fromAddrBytes(f.Exporter),
+		SourceID:   f.SourceId,
+		TemplateID: uint16(f.TemplateId),
+		Sequence:   f.Sequence,
+		Start:      fromTimestamp(f.Start),
+		End:        fromTimestamp(f.End),
+		SrcAddr:    fromAddrBytes(f.SrcAddr),
+		DstAddr:    fromAddrBytes(f.DstAddr),
+		NextHop:    fromAddrBytes(f.NextHop),
+		SrcPort:    uint16(f.SrcPort),
+		DstPort:    uint16(f.DstPort),
+		Protocol:   uint8(f.Protocol),
+		TCPFlags:   uint8(f.TcpFlags),
+		TOS:        uint8(f.Tos),
+		SrcMask:    uint8(f.SrcMask),
+		DstMask:    uint8(f.DstMask),
+		SrcAS:      f.SrcAs,
+		DstAS:      f.DstAs,
+		InputIf:    f.InputIf,
+		OutputIf:   f.OutputIf,
+		Bytes:      f.Bytes,
+		Packets:    f.Packets,
+		Flows:      f.Flows,
+	}
+	for _, field := range f.Fields {
+		r.Fields = append(r.Fields, flow.Field{Type: uint16(field.Type), Value: field.Value})
+	}
+	for k, v := range f.Enrichments {
+		r.Enrich(k, v)
+	}
+	return r
+}
@@ -0,0 +1,426 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: flow.proto
+
+package flowpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Field struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Field) Reset() {
+	*x = Field{}
+	mi := &file_flow_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Field) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Field) ProtoMessage() {}
+
+func (x *Field) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Field.ProtoReflect.Descriptor instead.
+func (*Field) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Field) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *Field) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type Flow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Received      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=received,proto3" json:"received,omitempty"`
+	Listener      string                 `protobuf:"bytes,2,opt,name=listener,proto3" json:"listener,omitempty"`
+	Exporter      []byte                 `protobuf:"bytes,3,opt,name=exporter,proto3" json:"exporter,omitempty"`
+	SourceId      uint32                 `protobuf:"varint,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TemplateId    uint32                 `protobuf:"varint,5,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Sequence      uint32                 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end,proto3" json:"end,omitempty"`
+	SrcAddr       []byte                 `protobuf:"bytes,9,opt,name=src_addr,json=srcAddr,proto3" json:"src_addr,omitempty"`
+	DstAddr       []byte                 `protobuf:"bytes,10,opt,name=dst_addr,json=dstAddr,proto3" json:"dst_addr,omitempty"`
+	NextHop       []byte                 `protobuf:"bytes,11,opt,name=next_hop,json=nextHop,proto3" json:"next_hop,omitempty"`
+	SrcPort       uint32                 `protobuf:"varint,12,opt,name=src_port,json=srcPort,proto3" json:"src_port,omitempty"`
+	DstPort       uint32                 `protobuf:"varint,13,opt,name=dst_port,json=dstPort,proto3" json:"dst_port,omitempty"`
+	Protocol      uint32                 `protobuf:"varint,14,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	TcpFlags      uint32                 `protobuf:"varint,15,opt,name=tcp_flags,json=tcpFlags,proto3" json:"tcp_flags,omitempty"`
+	Tos           uint32                 `protobuf:"varint,16,opt,name=tos,proto3" json:"tos,omitempty"`
+	SrcMask       uint32                 `protobuf:"varint,17,opt,name=src_mask,json=srcMask,proto3" json:"src_mask,omitempty"`
+	DstMask       uint32                 `protobuf:"varint,18,opt,name=dst_mask,json=dstMask,proto3" json:"dst_mask,omitempty"`
+	SrcAs         uint32                 `protobuf:"varint,19,opt,name=src_as,json=srcAs,proto3" json:"src_as,omitempty"`
+	DstAs         uint32                 `protobuf:"varint,20,opt,name=dst_as,json=dstAs,proto3" json:"dst_as,omitempty"`
+	InputIf       uint32                 `protobuf:"varint,21,opt,name=input_if,json=inputIf,proto3" json:"input_if,omitempty"`
+	OutputIf      uint32                 `protobuf:"varint,22,opt,name=output_if,json=outputIf,proto3" json:"output_if,omitempty"`
+	Bytes         uint64                 `protobuf:"varint,23,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Packets       uint64                 `protobuf:"varint,24,opt,name=packets,proto3" json:"packets,omitempty"`
+	Flows         uint64                 `protobuf:"varint,25,opt,name=flows,proto3" json:"flows,omitempty"`
+	Fields        []*Field               `protobuf:"bytes,26,rep,name=fields,proto3" json:"fields,omitempty"`
+	Enrichments   map[string]string      `protobuf:"bytes,27,rep,name=enrichments,proto3" json:"enrichments,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Flow) Reset() {
+	*x = Flow{}
+	mi := &file_flow_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Flow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Flow) ProtoMessage() {}
+
+func (x *Flow) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Flow.ProtoReflect.Descriptor instead.
+func (*Flow) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Flow) GetReceived() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Received
+	}
+	return nil
+}
+
+func (x *Flow) GetListener() string {
+	if x != nil {
+		return x.Listener
+	}
+	return ""
+}
+
+func (x *Flow) GetExporter() []byte {
+	if x != nil {
+		return x.Exporter
+	}
+	return nil
+}
+
+func (x *Flow) GetSourceId() uint32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *Flow) GetTemplateId() uint32 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *Flow) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Flow) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Flow) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *Flow) GetSrcAddr() []byte {
+	if x != nil {
+		return x.SrcAddr
+	}
+	return nil
+}
+
+func (x *Flow) GetDstAddr() []byte {
+	if x != nil {
+		return x.DstAddr
+	}
+	return nil
+}
+
+func (x *Flow) GetNextHop() []byte {
+	if x != nil {
+		return x.NextHop
+	}
+	return nil
+}
+
+func (x *Flow) GetSrcPort() uint32 {
+	if x != nil {
+		return x.SrcPort
+	}
+	return 0
+}
+
+func (x *Flow) GetDstPort() uint32 {
+	if x != nil {
+		return x.DstPort
+	}
+	return 0
+}
+
+func (x *Flow) GetProtocol() uint32 {
+	if x != nil {
+		return x.Protocol
+	}
+	return 0
+}
+
+func (x *Flow) GetTcpFlags() uint32 {
+	if x != nil {
+		return x.TcpFlags
+	}
+	return 0
+}
+
+func (x *Flow) GetTos() uint32 {
+	if x != nil {
+		return x.Tos
+	}
+	return 0
+}
+
+func (x *Flow) GetSrcMask() uint32 {
+	if x != nil {
+		return x.SrcMask
+	}
+	return 0
+}
+
+func (x *Flow) GetDstMask() uint32 {
+	if x != nil {
+		return x.DstMask
+	}
+	return 0
+}
+
+func (x *Flow) GetSrcAs() uint32 {
+	if x != nil {
+		return x.SrcAs
+	}
+	return 0
+}
+
+func (x *Flow) GetDstAs() uint32 {
+	if x != nil {
+		return x.DstAs
+	}
+	return 0
+}
+
+func (x *Flow) GetInputIf() uint32 {
+	if x != nil {
+		return x.InputIf
+	}
+	return 0
+}
+
+func (x *Flow) GetOutputIf() uint32 {
+	if x != nil {
+		return x.OutputIf
+	}
+	return 0
+}
+
+func (x *Flow) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *Flow) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *Flow) GetFlows() uint64 {
+	if x != nil {
+		return x.Flows
+	}
+	return 0
+}
+
+func (x *Flow) GetFields() []*Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *Flow) GetEnrichments() map[string]string {
+	if x != nil {
+		return x.Enrichments
+	}
+	return nil
+}
+
+var File_flow_proto protoreflect.FileDescriptor
+
+const file_flow_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"flow.proto\x12\x0fnetflow.flow.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"1\n" +
+	"\x05Field\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\"\x9e\a\n" +
+	"\x04Flow\x126\n" +
+	"\breceived\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\breceived\x12\x1a\n" +
+	"\blistener\x18\x02 \x01(\tR\blistener\x12\x1a\n" +
+	"\bexporter\x18\x03 \x01(\fR\bexporter\x12\x1b\n" +
+	"\tsource_id\x18\x04 \x01(\rR\bsourceId\x12\x1f\n" +
+	"\vtemplate_id\x18\x05 \x01(\rR\n" +
+	"templateId\x12\x1a\n" +
+	"\bsequence\x18\x06 \x01(\rR\bsequence\x120\n" +
+	"\x05start\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x19\n" +
+	"\bsrc_addr\x18\t \x01(\fR\asrcAddr\x12\x19\n" +
+	"\bdst_addr\x18\n" +
+	" \x01(\fR\adstAddr\x12\x19\n" +
+	"\bnext_hop\x18\v \x01(\fR\anextHop\x12\x19\n" +
+	"\bsrc_port\x18\f \x01(\rR\asrcPort\x12\x19\n" +
+	"\bdst_port\x18\r \x01(\rR\adstPort\x12\x1a\n" +
+	"\bprotocol\x18\x0e \x01(\rR\bprotocol\x12\x1b\n" +
+	"\ttcp_flags\x18\x0f \x01(\rR\btcpFlags\x12\x10\n" +
+	"\x03tos\x18\x10 \x01(\rR\x03tos\x12\x19\n" +
+	"\bsrc_mask\x18\x11 \x01(\rR\asrcMask\x12\x19\n" +
+	"\bdst_mask\x18\x12 \x01(\rR\adstMask\x12\x15\n" +
+	"\x06src_as\x18\x13 \x01(\rR\x05srcAs\x12\x15\n" +
+	"\x06dst_as\x18\x14 \x01(\rR\x05dstAs\x12\x19\n" +
+	"\binput_if\x18\x15 \x01(\rR\ainputIf\x12\x1b\n" +
+	"\toutput_if\x18\x16 \x01(\rR\boutputIf\x12\x14\n" +
+	"\x05bytes\x18\x17 \x01(\x04R\x05bytes\x12\x18\n" +
+	"\apackets\x18\x18 \x01(\x04R\apackets\x12\x14\n" +
+	"\x05flows\x18\x19 \x01(\x04R\x05flows\x12.\n" +
+	"\x06fields\x18\x1a \x03(\v2\x16.netflow.flow.v1.FieldR\x06fields\x12H\n" +
+	"\venrichments\x18\x1b \x03(\v2&.netflow.flow.v1.Flow.EnrichmentsEntryR\venrichments\x1a>\n" +
+	"\x10EnrichmentsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B+Z)github.com/brooksbp/go.netflow/pkg/flowpbb\x06proto3"
+
+var (
+	file_flow_proto_rawDescOnce sync.Once
+	file_flow_proto_rawDescData []byte
+)
+
+func file_flow_proto_rawDescGZIP() []byte {
+	file_flow_proto_rawDescOnce.Do(func() {
+		file_flow_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_flow_proto_rawDesc), len(file_flow_proto_rawDesc)))
+	})
+	return file_flow_proto_rawDescData
+}
+
+var file_flow_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_flow_proto_goTypes = []any{
+	(*Field)(nil),                 // 0: netflow.flow.v1.Field
+	(*Flow)(nil),                  // 1: netflow.flow.v1.Flow
+	nil,                           // 2: netflow.flow.v1.Flow.EnrichmentsEntry
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_flow_proto_depIdxs = []int32{
+	3, // 0: netflow.flow.v1.Flow.received:type_name -> google.protobuf.Timestamp
+	3, // 1: netflow.flow.v1.Flow.start:type_name -> google.protobuf.Timestamp
+	3, // 2: netflow.flow.v1.Flow.end:type_name -> google.protobuf.Timestamp
+	0, // 3: netflow.flow.v1.Flow.fields:type_name -> netflow.flow.v1.Field
+	2, // 4: netflow.flow.v1.Flow.enrichments:type_name -> netflow.flow.v1.Flow.EnrichmentsEntry
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_flow_proto_init() }
+func file_flow_proto_init() {
+	if File_flow_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_flow_proto_rawDesc), len(file_flow_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_flow_proto_goTypes,
+		DependencyIndexes: file_flow_proto_depIdxs,
+		MessageInfos:      file_flow_proto_msgTypes,
+	}.Build()
+	File_flow_proto = out.File
+	file_flow_proto_goTypes = nil
+	file_flow_proto_depIdxs = nil
+}
@@ -0,0 +1,110 @@
+// Package geoip looks up the location of addresses in a local MaxMind DB
+// (MMDB) file, such as GeoLite2-City, and reloads the file when it
+// changes.
+package geoip
+
+import (
+	"net/netip"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/oschwald/maxminddb-golang"
+
+	"github.com/brooksbp/go.netflow/pkg/reload"
+)
+
+// Location is the part of a GeoIP City or Country record used to enrich
+// flows. Fields the database lacks are left zero.
+type Location struct {
+	// Country is the ISO 3166-1 country code, e.g. "US".
+	Country   string
+	City      string
+	Latitude  float64
+	Longitude float64
+	// HasCoordinates is set when the database has a location for the
+	// address.
+	HasCoordinates bool
+}
+
+// record is the MMDB layout of GeoIP2 City and Country databases.
+type record struct {
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+	Location struct {
+		Latitude  *float64 `maxminddb:"latitude"`
+		Longitude *float64 `maxminddb:"longitude"`
+	} `maxminddb:"location"`
+}
+
+// DB is a GeoIP database. It is safe for concurrent use, including while
+// it is reloaded.
+type DB struct {
+	reader atomic.Pointer[maxminddb.Reader]
+	file   *reload.File
+}
+
+// Open loads the MMDB file at path.
+func Open(path string) (*DB, error) {
+	db := &DB{}
+	db.file = reload.NewFile(path, db.load)
+	if _, err := db.Reload(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+func (db *DB) load(path string) error {
+	// The file is read into memory rather than mapped, so that replacing
+	// it never invalidates a reader still in use.
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	r, err := maxminddb.FromBytes(b)
+	if err != nil {
+		return err
+	}
+	db.reader.Store(r)
+	return nil
+}
+
+// Reload loads the file again if its size or modification time changed
+// since it was last loaded, and reports whether it did. Lookups use the
+// previous contents until the new file has loaded, and keep using them if
+// it fails to load.
+func (db *DB) Reload() (bool, error) {
+	return db.file.Reload()
+}
+
+// Watch calls Reload every interval until stop is closed, passing errors
+// and successful reloads to report.
+func (db *DB) Watch(interval time.Duration, stop <-chan struct{}, report func(reloaded bool, err error)) {
+	reload.Watch(interval, stop, db.Reload, report)
+}
+
+// Lookup returns the location of addr and whether the database has one.
+func (db *DB) Lookup(addr netip.Addr) (Location, bool) {
+	if !addr.IsValid() {
+		return Location{}, false
+	}
+	var rec record
+	_, ok, err := db.reader.Load().LookupNetwork(addr.AsSlice(), &rec)
+	if err != nil || !ok {
+		return Location{}, false
+	}
+	loc := Location{
+		Country: rec.Country.ISOCode,
+		City:    rec.City.Names["en"],
+	}
+	if rec.Location.Latitude != nil && rec.Location.Longitude != nil {
+		loc.Latitude = *rec.Location.Latitude
+		loc.Longitude = *rec.Location.Longitude
+		loc.HasCoordinates = true
+	}
+	return loc, loc.Country != "" || loc.City != "" || loc.HasCoordinates
+}
@@ -0,0 +1,323 @@
+// Package iface names the interfaces that exporters report by SNMP
+// ifIndex, using a static file and the interface names exporters send in
+// NetFlow v9 options data.
+//
+// The file has one interface per line, as comma-separated values:
+//
+//	# exporter, ifindex, name, description, speed, role
+//	192.168.88.1, 13, Gi0/1, Uplink to ISP, 1G, uplink
+//	192.168.88.1, 2, Gi0/2, , 1G, lan
+//	*, 1, lo0
+//
+// Only the exporter, ifindex and name are required. An exporter of *
+// applies to every exporter without an entry of its own. Speed is in bits
+// per second, with an optional k, M, G or T suffix (powers of 1000).
+package iface
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/nfv9"
+	"github.com/brooksbp/go.netflow/pkg/reload"
+)
+
+// Interface describes one interface of an exporter. Fields that are not
+// known are empty.
+type Interface struct {
+	Name        string
+	Description string
+	// Speed in bits per second.
+	Speed uint64
+	Role  string
+}
+
+// String returns the interface name followed by its role in
+// parentheses, e.g. "Gi0/1 (uplink)".
+func (i Interface) String() string {
+	if i.Role == "" {
+		return i.Name
+	}
+	if i.Name == "" {
+		return "(" + i.Role + ")"
+	}
+	return i.Name + " (" + i.Role + ")"
+}
+
+// merge returns i with the fields of o that are set.
+func (i Interface) merge(o Interface) Interface {
+	if o.Name != "" {
+		i.Name = o.Name
+	}
+	if o.Description != "" {
+		i.Description = o.Description
+	}
+	if o.Speed != 0 {
+		i.Speed = o.Speed
+	}
+	if o.Role != "" {
+		i.Role = o.Role
+	}
+	return i
+}
+
+// Key identifies an interface. A zero Exporter matches every exporter.
+type Key struct {
+	Exporter netip.Addr
+	Index    uint32
+}
+
+// Read reads interfaces in the format described in the package comment.
+func Read(r io.Reader) (map[Key]Interface, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	m := make(map[Key]Interface)
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return m, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("iface: %w", err)
+		}
+		line, _ := cr.FieldPos(0)
+		for i := range rec {
+			rec[i] = strings.TrimSpace(rec[i])
+		}
+		if len(rec) < 3 {
+			return nil, fmt.Errorf("iface: line %d: want exporter, ifindex and name", line)
+		}
+		var k Key
+		if rec[0] != "*" {
+			if k.Exporter, err = netip.ParseAddr(rec[0]); err != nil {
+				return nil, fmt.Errorf("iface: line %d: %w", line, err)
+			}
+			k.Exporter = k.Exporter.Unmap()
+		}
+		index, err := strconv.ParseUint(rec[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("iface: line %d: invalid ifindex %q", line, rec[1])
+		}
+		k.Index = uint32(index)
+
+		i := Interface{Name: rec[2]}
+		if len(rec) > 3 {
+			i.Description = rec[3]
+		}
+		if len(rec) > 4 && rec[4] != "" {
+			if i.Speed, err = parseSpeed(rec[4]); err != nil {
+				return nil, fmt.Errorf("iface: line %d: %w", line, err)
+			}
+		}
+		if len(rec) > 5 {
+			i.Role = rec[5]
+		}
+		m[k] = i
+	}
+}
+
+func parseSpeed(s string) (uint64, error) {
+	mult := uint64(1)
+	if n := len(s); n > 0 {
+		switch s[n-1] {
+		case 'k', 'K':
+			mult = 1e3
+		case 'M':
+			mult = 1e6
+		case 'G':
+			mult = 1e9
+		case 'T':
+			mult = 1e12
+		}
+		if mult != 1 {
+			s = s[:n-1]
+		}
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid speed %q", s)
+	}
+	return n * mult, nil
+}
+
+// Table holds the interfaces read from a file and learned from options
+// data. Entries from the file take precedence, field by field, over
+// learned ones. It is safe for concurrent use.
+type Table struct {
+	file *reload.File
+
+	mu      sync.RWMutex
+	static  map[Key]Interface
+	learned map[Key]Interface
+}
+
+// New returns a table without a file, which only holds learned
+// interfaces.
+func New() *Table {
+	return &Table{learned: make(map[Key]Interface)}
+}
+
+// Open returns a table reading interfaces from the file at path.
+func Open(path string) (*Table, error) {
+	t := New()
+	t.file = reload.NewFile(path, t.load)
+	if _, err := t.Reload(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *Table) load(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	static, err := Read(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	t.mu.Lock()
+	t.static = static
+	t.mu.Unlock()
+	return nil
+}
+
+// Reload reads the file again if its size or modification time changed
+// since it was last read, and reports whether it did. The previous
+// entries stay in use if the file fails to load.
+func (t *Table) Reload() (bool, error) {
+	if t.file == nil {
+		return false, nil
+	}
+	return t.file.Reload()
+}
+
+// Watch calls Reload every interval until stop is closed, passing errors
+// and successful reloads to report.
+func (t *Table) Watch(interval time.Duration, stop <-chan struct{}, report func(reloaded bool, err error)) {
+	reload.Watch(interval, stop, t.Reload, report)
+}
+
+// Lookup returns the interface of exporter with the ifIndex index.
+func (t *Table) Lookup(exporter netip.Addr, index uint32) (Interface, bool) {
+	k := Key{exporter.Unmap(), index}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	i, ok := t.learned[k]
+	s, sok := t.static[k]
+	if !sok {
+		s, sok = t.static[Key{Index: index}]
+	}
+	if sok {
+		i, ok = i.merge(s), true
+	}
+	return i, ok
+}
+
+// Learn records the name and description of an interface, as sent by its
+// exporter.
+func (t *Table) Learn(exporter netip.Addr, index uint32, name, description string) {
+	k := Key{exporter.Unmap(), index}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.learned[k] = t.learned[k].merge(Interface{Name: name, Description: description})
+}
+
+// LearnNFV9 learns the interface described by an options data record, if
+// it has an IF_NAME or IF_DESC field and an ifIndex, in an Interface
+// scope field or an INPUT_SNMP option field. It reports whether it did.
+func (t *Table) LearnNFV9(exporter netip.Addr, tmpl *nfv9.Template, dr *nfv9.DataRecord) bool {
+	if !tmpl.Options {
+		return false
+	}
+	var index uint32
+	var hasIndex bool
+	var name, description string
+	var off int
+	for i, tl := range tmpl.Fields {
+		n := int(tl.Length)
+		if off+n > len(dr.Fields) {
+			break
+		}
+		v := dr.Fields[off : off+n]
+		off += n
+
+		if i < int(tmpl.ScopeFieldCount) {
+			if tl.Type == nfv9.ScopeInterface {
+				index, hasIndex = uint32(uintValue(v)), true
+			}
+			continue
+		}
+		switch tl.Type {
+		case 10: // INPUT_SNMP
+			if !hasIndex {
+				index, hasIndex = uint32(uintValue(v)), true
+			}
+		case 82: // IF_NAME
+			name = stringValue(v)
+		case 83: // IF_DESC
+			description = stringValue(v)
+		}
+	}
+	if !hasIndex || (name == "" && description == "") {
+		return false
+	}
+	t.Learn(exporter, index, name, description)
+	return true
+}
+
+func uintValue(b []byte) uint64 {
+	var n uint64
+	for _, c := range b {
+		n = n<<8 | uint64(c)
+	}
+	return n
+}
+
+// stringValue returns a fixed length, NUL padded string field.
+func stringValue(b []byte) string {
+	if i := strings.IndexByte(string(b), 0); i >= 0 {
+		b = b[:i]
+	}
+	return strings.TrimSpace(string(b))
+}
+
+// Each calls fn for every interface in the table, with the entries from
+// the file merged in, until fn returns false. Entries for every exporter
+// have a zero Exporter.
+func (t *Table) Each(fn func(k Key, i Interface) bool) {
+	t.mu.RLock()
+	all := make(map[Key]Interface, len(t.learned)+len(t.static))
+	for k, i := range t.learned {
+		all[k] = i
+	}
+	for k, s := range t.static {
+		all[k] = all[k].merge(s)
+	}
+	for k, i := range t.learned {
+		if _, ok := t.static[k]; !ok {
+			if s, ok := t.static[Key{Index: k.Index}]; ok {
+				all[k] = i.merge(s)
+			}
+		}
+	}
+	t.mu.RUnlock()
+
+	for k, i := range all {
+		if !fn(k, i) {
+			return
+		}
+	}
+}
@@ -0,0 +1,145 @@
+//go:build ignore
+
+// gen_services writes the built-in service name table from the IANA
+// service name and port number registry, or from a file in the format of
+// /etc/services.
+//
+//	go run gen_services.go -out services_table.go
+//	go run gen_services.go -in /etc/services -out services_table.go
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/brooksbp/go.netflow/pkg/net2"
+)
+
+const ianaURL = "https://www.iana.org/assignments/service-names-port-numbers/service-names-port-numbers.csv"
+
+var (
+	flagIn  = flag.String("in", ianaURL, "IANA registry CSV or /etc/services file, as a path or URL.")
+	flagOut = flag.String("out", "services_table.go", "File to write.")
+)
+
+func main() {
+	flag.Parse()
+
+	b, err := read(*flagIn)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var services []net2.Service
+	if bytes.HasPrefix(b, []byte("Service Name,")) {
+		services, err = readIANA(bytes.NewReader(b))
+	} else {
+		services, err = net2.ReadServices(bytes.NewReader(b))
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Keep the first name of each port, as the registry lists the
+	// current name before historical ones.
+	seen := make(map[[2]int]bool)
+	var unique []net2.Service
+	for _, s := range services {
+		k := [2]int{int(s.Protocol), int(s.Port)}
+		if !seen[k] {
+			seen[k] = true
+			unique = append(unique, s)
+		}
+	}
+	sort.SliceStable(unique, func(i, j int) bool {
+		if unique[i].Protocol != unique[j].Protocol {
+			return unique[i].Protocol < unique[j].Protocol
+		}
+		return unique[i].Port < unique[j].Port
+	})
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by gen_services.go from %s; DO NOT EDIT.\n\n", source(*flagIn))
+	fmt.Fprintf(&out, "package net2\n\n")
+	fmt.Fprintf(&out, "// ianaServices are the service names of the IANA registry.\n")
+	fmt.Fprintf(&out, "var ianaServices = []Service{\n")
+	for _, s := range unique {
+		fmt.Fprintf(&out, "\t{%d, %d, %q},\n", s.Protocol, s.Port, s.Name)
+	}
+	fmt.Fprintf(&out, "}\n")
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*flagOut, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func read(in string) ([]byte, error) {
+	if !strings.HasPrefix(in, "http://") && !strings.HasPrefix(in, "https://") {
+		return os.ReadFile(in)
+	}
+	resp, err := http.Get(in)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", in, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// source names the input in the generated file's header.
+func source(in string) string {
+	if in == ianaURL {
+		return "the IANA registry"
+	}
+	return filepath.Base(in)
+}
+
+// readIANA reads the registry CSV, whose columns start with Service Name,
+// Port Number and Transport Protocol. Port ranges are expanded and
+// entries without a name or port are skipped.
+func readIANA(r io.Reader) ([]net2.Service, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	var services []net2.Service
+	for _, rec := range records[1:] {
+		if len(rec) < 3 || rec[0] == "" || rec[1] == "" {
+			continue
+		}
+		proto, ok := net2.ServiceProtocols[strings.ToLower(rec[2])]
+		if !ok {
+			continue
+		}
+		lo, hi, _ := strings.Cut(rec[1], "-")
+		if hi == "" {
+			hi = lo
+		}
+		first, err1 := strconv.ParseUint(lo, 10, 16)
+		last, err2 := strconv.ParseUint(hi, 10, 16)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		for port := first; port <= last; port++ {
+			services = append(services, net2.Service{Protocol: proto, Port: uint16(port), Name: rec[0]})
+		}
+	}
+	return services, nil
+}
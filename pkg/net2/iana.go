@@ -151,7 +151,9 @@ var IPProtocolMap = map[int]IPProtocol{
 	142: IPProtocol{"ROHC", "Robust Header Compression"},
 }
 
-// TODO: fill..
+// TCPUDPPortMap holds the display names of common services by port and
+// protocol keyword. They take precedence over the registry names returned
+// by ServiceName, which callers should use instead.
 var TCPUDPPortMap = map[int]map[string]string{
 	0: {
 		"TCP": "Programming technique for specifying system-allocated (dynamic) ports",
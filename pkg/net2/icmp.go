@@ -0,0 +1,136 @@
+package net2
+
+import "strconv"
+
+// ICMPType is the name of an ICMP message type and of its codes.
+type ICMPType struct {
+	Name  string
+	Codes map[uint8]string
+}
+
+// ICMPv4Types are the ICMP message types of IANA's ICMP parameters
+// registry, in the keyword style of iptables.
+var ICMPv4Types = map[uint8]ICMPType{
+	0: {"echo-reply", nil},
+	3: {"destination-unreachable", map[uint8]string{
+		0:  "network-unreachable",
+		1:  "host-unreachable",
+		2:  "protocol-unreachable",
+		3:  "port-unreachable",
+		4:  "fragmentation-needed",
+		5:  "source-route-failed",
+		6:  "network-unknown",
+		7:  "host-unknown",
+		8:  "source-host-isolated",
+		9:  "network-prohibited",
+		10: "host-prohibited",
+		11: "tos-network-unreachable",
+		12: "tos-host-unreachable",
+		13: "communication-prohibited",
+		14: "host-precedence-violation",
+		15: "precedence-cutoff",
+	}},
+	4: {"source-quench", nil},
+	5: {"redirect", map[uint8]string{
+		0: "network-redirect",
+		1: "host-redirect",
+		2: "tos-network-redirect",
+		3: "tos-host-redirect",
+	}},
+	8:  {"echo-request", nil},
+	9:  {"router-advertisement", nil},
+	10: {"router-solicitation", nil},
+	11: {"time-exceeded", map[uint8]string{
+		0: "ttl-zero-during-transit",
+		1: "ttl-zero-during-reassembly",
+	}},
+	12: {"parameter-problem", map[uint8]string{
+		0: "ip-header-bad",
+		1: "required-option-missing",
+		2: "bad-length",
+	}},
+	13: {"timestamp-request", nil},
+	14: {"timestamp-reply", nil},
+	15: {"information-request", nil},
+	16: {"information-reply", nil},
+	17: {"address-mask-request", nil},
+	18: {"address-mask-reply", nil},
+	30: {"traceroute", nil},
+	42: {"extended-echo-request", nil},
+	43: {"extended-echo-reply", nil},
+}
+
+// ICMPv6Types are the ICMPv6 message types of IANA's ICMPv6 parameters
+// registry.
+var ICMPv6Types = map[uint8]ICMPType{
+	1: {"destination-unreachable", map[uint8]string{
+		0: "no-route",
+		1: "communication-prohibited",
+		2: "beyond-scope",
+		3: "address-unreachable",
+		4: "port-unreachable",
+		5: "failed-policy",
+		6: "reject-route",
+		7: "source-routing-header-error",
+	}},
+	2: {"packet-too-big", nil},
+	3: {"time-exceeded", map[uint8]string{
+		0: "ttl-zero-during-transit",
+		1: "ttl-zero-during-reassembly",
+	}},
+	4: {"parameter-problem", map[uint8]string{
+		0: "bad-header",
+		1: "unknown-header-type",
+		2: "unknown-option",
+	}},
+	128: {"echo-request", nil},
+	129: {"echo-reply", nil},
+	130: {"multicast-listener-query", nil},
+	131: {"multicast-listener-report", nil},
+	132: {"multicast-listener-done", nil},
+	133: {"router-solicitation", nil},
+	134: {"router-advertisement", nil},
+	135: {"neighbor-solicitation", nil},
+	136: {"neighbor-advertisement", nil},
+	137: {"redirect", nil},
+	138: {"router-renumbering", nil},
+	143: {"multicast-listener-report-v2", nil},
+	160: {"extended-echo-request", nil},
+	161: {"extended-echo-reply", nil},
+}
+
+// icmpTypes returns the types of the IP protocol proto: ICMPv6 for 58 and
+// ICMP otherwise.
+func icmpTypes(proto uint8) map[uint8]ICMPType {
+	if proto == 58 {
+		return ICMPv6Types
+	}
+	return ICMPv4Types
+}
+
+// FormatICMP returns the name of an ICMP message of the IP protocol proto,
+// 1 or 58: the name of its code if it has one, e.g. "port-unreachable",
+// or else the name of its type, followed by "/CODE" for nonzero codes,
+// e.g. "echo-request". Unknown types are shown as "TYPE/CODE".
+func FormatICMP(proto, typ, code uint8) string {
+	t, ok := icmpTypes(proto)[typ]
+	if !ok {
+		return strconv.Itoa(int(typ)) + "/" + strconv.Itoa(int(code))
+	}
+	if name, ok := t.Codes[code]; ok {
+		return name
+	}
+	if code == 0 {
+		return t.Name
+	}
+	return t.Name + "/" + strconv.Itoa(int(code))
+}
+
+// ICMPTypeName returns the name of the ICMP message type typ of the IP
+// protocol proto, 1 or 58, or its decimal value if it has none.
+func ICMPTypeName(proto, typ uint8) string {
+	if t, ok := icmpTypes(proto)[typ]; ok {
+		return t.Name
+	}
+	return strconv.Itoa(int(typ))
+}
@@ -0,0 +1,196 @@
+package net2
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// OUI is a block of MAC addresses assigned to an organization: an MA-L
+// (24-bit), MA-M (28-bit) or MA-S (36-bit) assignment of the IEEE
+// registration authority.
+type OUI struct {
+	// Prefix holds the assigned bits, left-aligned in the low 48 bits.
+	Prefix uint64
+	// Bits is the length of the assignment.
+	Bits         int
+	Organization string
+}
+
+// String returns the assigned prefix, e.g. "d4:ca:6d" or "70:b3:d5:4a:b/36".
+func (o OUI) String() string {
+	mac := make(net.HardwareAddr, 6)
+	for i := range mac {
+		mac[i] = byte(o.Prefix >> (40 - 8*i))
+	}
+	s := mac[:o.Bits/8].String()
+	if o.Bits%8 != 0 {
+		s += ":" + strconv.FormatUint(uint64(mac[o.Bits/8]>>4), 16) + "/" + strconv.Itoa(o.Bits)
+	}
+	return s
+}
+
+// ReadOUIs reads the registry CSV files published by the IEEE, oui.csv,
+// mam.csv and oui36.csv, whose columns are Registry, Assignment,
+// Organization Name and Organization Address. The assignment is 6, 7 or 9
+// hexadecimal digits.
+func ReadOUIs(r io.Reader) ([]OUI, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	var ouis []OUI
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return ouis, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("oui: %w", err)
+		}
+		line, _ := cr.FieldPos(0)
+		if len(rec) < 3 {
+			return nil, fmt.Errorf("oui: line %d: want registry, assignment and organization", line)
+		}
+		if line == 1 && rec[1] == "Assignment" {
+			continue
+		}
+		hex := strings.TrimSpace(rec[1])
+		n, err := strconv.ParseUint(hex, 16, 64)
+		if err != nil || len(hex) != 6 && len(hex) != 7 && len(hex) != 9 {
+			return nil, fmt.Errorf("oui: line %d: invalid assignment %q", line, rec[1])
+		}
+		bits := 4 * len(hex)
+		ouis = append(ouis, OUI{n << (48 - bits), bits, strings.TrimSpace(rec[2])})
+	}
+}
+
+// ouiTable indexes assignments by length and prefix.
+type ouiTable map[int]map[uint64]OUI
+
+// ouiBits are the assignment lengths, longest first.
+var ouiBits = [...]int{36, 28, 24}
+
+var (
+	// ouis is replaced, not changed, by AddOUIs, so lookups need no lock.
+	ouis   atomic.Pointer[ouiTable]
+	ouisMu sync.Mutex
+)
+
+// AddOUIs adds assignments to the registry used by LookupOUI, replacing
+// the organizations of prefixes that already have one.
+func AddOUIs(o []OUI) {
+	ouisMu.Lock()
+	defer ouisMu.Unlock()
+
+	t := make(ouiTable, len(ouiBits))
+	if old := ouis.Load(); old != nil {
+		for bits, m := range *old {
+			t[bits] = make(map[uint64]OUI, len(m))
+			for k, v := range m {
+				t[bits][k] = v
+			}
+		}
+	}
+	for _, oui := range o {
+		if t[oui.Bits] == nil {
+			t[oui.Bits] = make(map[uint64]OUI)
+		}
+		t[oui.Bits][oui.Prefix] = oui
+	}
+	ouis.Store(&t)
+}
+
+// LoadOUIs adds the assignments in the IEEE registry CSV file at path to
+// the registry used by LookupOUI.
+func LoadOUIs(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	o, err := ReadOUIs(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	AddOUIs(o)
+	return nil
+}
+
+// macUint returns the 48 bits of an EUI-48 address.
+func macUint(mac net.HardwareAddr) uint64 {
+	var n uint64
+	for _, b := range mac[:6] {
+		n = n<<8 | uint64(b)
+	}
+	return n
+}
+
+// LookupOUI returns the most specific assignment containing mac, an
+// EUI-48 address. Assignments are only known once loaded with LoadOUIs
+// or AddOUIs.
+func LookupOUI(mac net.HardwareAddr) (OUI, bool) {
+	t := ouis.Load()
+	if t == nil || len(mac) != 6 {
+		return OUI{}, false
+	}
+	n := macUint(mac)
+	for _, bits := range ouiBits {
+		if oui, ok := (*t)[bits][n&^(1<<(48-bits)-1)]; ok {
+			return oui, true
+		}
+	}
+	return OUI{}, false
+}
+
+// MACIsZero reports whether mac is empty or all zeros, as exporters send
+// for addresses they don't know.
+func MACIsZero(mac net.HardwareAddr) bool {
+	for _, b := range mac {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// MACIsMulticast reports whether mac is a group address, including the
+// broadcast address.
+func MACIsMulticast(mac net.HardwareAddr) bool {
+	return len(mac) > 0 && mac[0]&0x01 != 0
+}
+
+// MACIsLocal reports whether mac is locally administered rather than
+// assigned by its manufacturer, e.g. a randomized or virtual address.
+func MACIsLocal(mac net.HardwareAddr) bool {
+	return len(mac) > 0 && mac[0]&0x02 != 0
+}
+
+// FormatMAC returns mac followed by its organization, or by whether it is
+// a broadcast, multicast or locally administered address, e.g.
+// "d4:ca:6d:84:30:8a (Routerboard.com)". All-zero addresses are "".
+func FormatMAC(mac net.HardwareAddr) string {
+	if MACIsZero(mac) {
+		return ""
+	}
+	var notes []string
+	if oui, ok := LookupOUI(mac); ok && oui.Organization != "" {
+		notes = append(notes, oui.Organization)
+	}
+	switch {
+	case len(mac) == 6 && macUint(mac) == 1<<48-1:
+		notes = append(notes, "broadcast")
+	case MACIsMulticast(mac):
+		notes = append(notes, "multicast")
+	case MACIsLocal(mac):
+		notes = append(notes, "locally administered")
+	}
+	if len(notes) == 0 {
+		return mac.String()
+	}
+	return mac.String() + " (" + strings.Join(notes, ", ") + ")"
+}
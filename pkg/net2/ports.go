@@ -0,0 +1,172 @@
+package net2
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PortRange is an inclusive range of ports.
+type PortRange struct {
+	First, Last uint16
+}
+
+// Contains reports whether port is in the range.
+func (r PortRange) Contains(port uint16) bool {
+	return r.First <= port && port <= r.Last
+}
+
+// Ephemeral port ranges that operating systems pick client ports from.
+var (
+	// EphemeralIANA is the dynamic range of RFC 6335, used by Windows
+	// since Vista, macOS and the BSDs.
+	EphemeralIANA = PortRange{49152, 65535}
+	// EphemeralLinux is the default ip_local_port_range of Linux.
+	EphemeralLinux = PortRange{32768, 60999}
+	// EphemeralWindowsXP is the range of Windows XP and Server 2003.
+	EphemeralWindowsXP = PortRange{1025, 5000}
+)
+
+// DefaultEphemeral are the ranges that ServicePort treats as client
+// ports. EphemeralWindowsXP is left out because it covers many registered
+// services.
+var DefaultEphemeral = []PortRange{EphemeralIANA, EphemeralLinux}
+
+// PortClass is the IANA range of a port.
+type PortClass int
+
+const (
+	WellKnown  PortClass = iota // 0-1023
+	Registered                  // 1024-49151
+	Dynamic                     // 49152-65535
+)
+
+func (c PortClass) String() string {
+	switch c {
+	case WellKnown:
+		return "well-known"
+	case Registered:
+		return "registered"
+	}
+	return "dynamic"
+}
+
+// ClassifyPort returns the IANA range of port.
+func ClassifyPort(port uint16) PortClass {
+	switch {
+	case port < 1024:
+		return WellKnown
+	case port < 49152:
+		return Registered
+	}
+	return Dynamic
+}
+
+// HasPorts reports whether the IP protocol proto has ports: TCP, UDP,
+// DCCP, SCTP and UDP-Lite.
+func HasPorts(proto uint8) bool {
+	switch proto {
+	case 6, 17, 33, 132, 136:
+		return true
+	}
+	return false
+}
+
+// Side is the end of a flow that a port belongs to.
+type Side int
+
+const (
+	SideNone Side = iota
+	SideSrc
+	SideDst
+)
+
+// ServiceClassifier decides which end of a flow is the service and which
+// is the client.
+type ServiceClassifier struct {
+	// Ephemeral are the ranges client ports are picked from.
+	Ephemeral []PortRange
+}
+
+// DefaultClassifier uses DefaultEphemeral.
+var DefaultClassifier = &ServiceClassifier{Ephemeral: DefaultEphemeral}
+
+// isEphemeral reports whether port is in one of c's ephemeral ranges.
+func (c *ServiceClassifier) isEphemeral(port uint16) bool {
+	for _, r := range c.Ephemeral {
+		if r.Contains(port) {
+			return true
+		}
+	}
+	return false
+}
+
+// score ranks how likely port is to be the service end of a flow.
+func (c *ServiceClassifier) score(proto uint8, port uint16) int {
+	named := ServiceName(proto, port) != ""
+	switch {
+	case port == 0:
+		return 0
+	case port < 1024 && named:
+		return 5
+	case port < 1024:
+		return 4
+	case named && !c.isEphemeral(port):
+		return 3
+	case named:
+		return 2
+	case !c.isEphemeral(port):
+		return 1
+	}
+	return 0
+}
+
+// ServicePort returns the service port of a flow from src to dst ports
+// and the end it is on. Ports are ranked, from most to least likely to
+// be the service: named well-known ports, other well-known ports, named
+// ports outside the ephemeral ranges, named ports inside them, and other
+// ports outside them. Ties go to the lower port, and then to the
+// destination, so between two ephemeral ports, e.g. peer-to-peer
+// traffic, the lower one is the service port. Port 0 is never the service
+// port: flows of protocols without ports, or with both ports 0, have
+// none.
+func (c *ServiceClassifier) ServicePort(proto uint8, src, dst uint16) (uint16, Side) {
+	if !HasPorts(proto) {
+		return 0, SideNone
+	}
+	s, d := c.score(proto, src), c.score(proto, dst)
+	switch {
+	case s == 0 && d == 0:
+		// Both ports are ephemeral, e.g. peer-to-peer traffic; the lower
+		// one is the better guess.
+		if src == 0 && dst == 0 {
+			return 0, SideNone
+		}
+		if src != 0 && (dst == 0 || src < dst) {
+			return src, SideSrc
+		}
+		return dst, SideDst
+	case s > d, s == d && src < dst:
+		return src, SideSrc
+	}
+	return dst, SideDst
+}
+
+// Service returns a normalized label for the service of a flow: the
+// service name of its service port, e.g. "https", or PROTOCOL/PORT for
+// ports without a name, e.g. "tcp/8443". Flows without ports are labelled
+// by their protocol keyword, e.g. "icmp".
+func (c *ServiceClassifier) Service(proto uint8, src, dst uint16) string {
+	port, side := c.ServicePort(proto, src, dst)
+	keyword := strconv.Itoa(int(proto))
+	if entry, ok := IPProtocolMap[int(proto)]; ok {
+		keyword = entry.Keyword
+	}
+	keyword = strings.ToLower(keyword)
+	if side == SideNone {
+		return keyword
+	}
+	if name := ServiceName(proto, port); name != "" {
+		return strings.ToLower(name)
+	}
+	return keyword + "/" + strconv.Itoa(int(port))
+}
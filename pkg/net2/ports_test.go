@@ -0,0 +1,74 @@
+package net2
+
+import "testing"
+
+func TestServicePort(t *testing.T) {
+	tests := []struct {
+		name     string
+		c        *ServiceClassifier
+		proto    uint8
+		src, dst uint16
+		port     uint16
+		side     Side
+	}{
+		// Well-known ports beat ephemeral ones, on either end.
+		{"client to https", DefaultClassifier, 6, 51000, 443, 443, SideDst},
+		{"https to client", DefaultClassifier, 6, 443, 51000, 443, SideSrc},
+		{"udp domain", DefaultClassifier, 17, 53, 51000, 53, SideSrc},
+
+		// The ranks, from most to least likely.
+		{"named beats unnamed well-known", DefaultClassifier, 6, 1023, 443, 443, SideDst},
+		{"well-known beats named registered", DefaultClassifier, 6, 3306, 1023, 1023, SideDst},
+		{"named beats named ephemeral", DefaultClassifier, 6, 33434, 8080, 8080, SideDst},
+		{"named ephemeral beats unnamed", DefaultClassifier, 6, 40000, 51000, 40000, SideSrc},
+		{"unnamed registered beats ephemeral", DefaultClassifier, 6, 50000, 31000, 31000, SideDst},
+
+		// Between two ephemeral ports the lower one is the service.
+		{"both ephemeral", DefaultClassifier, 6, 60000, 50000, 50000, SideDst},
+		{"both ephemeral reversed", DefaultClassifier, 6, 50000, 60000, 50000, SideSrc},
+
+		// Port 0 is never the service port.
+		{"zero ports", DefaultClassifier, 6, 0, 0, 0, SideNone},
+		{"zero source", DefaultClassifier, 6, 0, 51000, 51000, SideDst},
+		{"zero destination", DefaultClassifier, 6, 51000, 0, 51000, SideSrc},
+		{"zero and well-known", DefaultClassifier, 17, 0, 443, 443, SideDst},
+
+		// Ties go to the lower port, then to the destination.
+		{"tied well-known", DefaultClassifier, 6, 443, 53, 53, SideDst},
+		{"tied well-known reversed", DefaultClassifier, 6, 53, 443, 53, SideSrc},
+		{"same port", DefaultClassifier, 6, 443, 443, 443, SideDst},
+		{"same ephemeral port", DefaultClassifier, 6, 50000, 50000, 50000, SideDst},
+
+		// The ephemeral ranges are configurable.
+		{"no ephemeral ranges", &ServiceClassifier{}, 6, 60000, 31000, 31000, SideDst},
+		{"windows xp range", &ServiceClassifier{Ephemeral: []PortRange{EphemeralWindowsXP}}, 6, 3306, 31000, 3306, SideSrc},
+
+		{"no ports", DefaultClassifier, 1, 0, 2048, 0, SideNone},
+	}
+	for _, tt := range tests {
+		port, side := tt.c.ServicePort(tt.proto, tt.src, tt.dst)
+		if port != tt.port || side != tt.side {
+			t.Errorf("%s: ServicePort(%d, %d, %d) = %d, %v; want %d, %v", tt.name, tt.proto, tt.src, tt.dst, port, side, tt.port, tt.side)
+		}
+	}
+}
+
+func TestService(t *testing.T) {
+	tests := []struct {
+		proto    uint8
+		src, dst uint16
+		want     string
+	}{
+		{6, 51000, 443, "https"},
+		// Names are lowercased, whatever their source.
+		{17, 53, 51000, "dns"},
+		{6, 60000, 50000, "tcp/50000"},
+		{6, 0, 0, "tcp"},
+		{1, 0, 2048, "icmp"},
+	}
+	for _, tt := range tests {
+		if got := DefaultClassifier.Service(tt.proto, tt.src, tt.dst); got != tt.want {
+			t.Errorf("Service(%d, %d, %d) = %q, want %q", tt.proto, tt.src, tt.dst, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,307 @@
+package net2
+
+import (
+	"math/bits"
+	"net/netip"
+	"sync/atomic"
+)
+
+// PrefixTable maps IPv4 and IPv6 prefixes to values of type T and finds
+// the longest prefix containing an address. It is a path-compressed
+// binary trie, so a lookup visits at most one node per distinct prefix
+// length on the path to the address, and does not allocate.
+//
+// IPv4-mapped IPv6 prefixes and addresses are treated as IPv4.
+//
+// A PrefixTable is safe for concurrent lookups, but not for lookups
+// concurrent with changes. Tables that are reloaded while in use should
+// be rebuilt and swapped in with an AtomicPrefixTable. A nil
+// *PrefixTable is an empty table that can be read but not changed.
+type PrefixTable[T any] struct {
+	root4, root6 *prefixNode[T]
+	n            int
+}
+
+type prefixNode[T any] struct {
+	// The prefix's address, IPv4 in the first 4 bytes, and length.
+	key   [16]byte
+	bits  int
+	child [2]*prefixNode[T]
+	value T
+	// set is false for nodes that only join two subtrees.
+	set bool
+}
+
+// NewPrefixTable returns an empty table.
+func NewPrefixTable[T any]() *PrefixTable[T] {
+	return &PrefixTable[T]{}
+}
+
+// prefixKey returns the key, length and root of p.
+func (t *PrefixTable[T]) prefixKey(p netip.Prefix) ([16]byte, int, **prefixNode[T], bool) {
+	if !p.IsValid() {
+		return [16]byte{}, 0, nil, false
+	}
+	addr, n := p.Addr(), p.Bits()
+	if addr.Is4In6() {
+		if n < 96 {
+			return [16]byte{}, 0, nil, false
+		}
+		addr, n = addr.Unmap(), n-96
+	}
+	key, root := t.addrKey(addr)
+	return maskKey(key, n), n, root, true
+}
+
+// addrKey returns the key and root of addr.
+func (t *PrefixTable[T]) addrKey(addr netip.Addr) ([16]byte, **prefixNode[T]) {
+	addr = addr.Unmap()
+	if addr.Is4() {
+		var key [16]byte
+		a := addr.As4()
+		copy(key[:], a[:])
+		return key, &t.root4
+	}
+	return addr.As16(), &t.root6
+}
+
+func maskKey(key [16]byte, n int) [16]byte {
+	for i := range key {
+		switch {
+		case n >= 8:
+			n -= 8
+		case n > 0:
+			key[i] &= ^byte(0xff >> n)
+			n = 0
+		default:
+			key[i] = 0
+		}
+	}
+	return key
+}
+
+func keyBit(key *[16]byte, i int) int {
+	return int(key[i/8]>>(7-i%8)) & 1
+}
+
+// commonBits returns the length of the common prefix of a and b, up to
+// max bits.
+func commonBits(a, b *[16]byte, max int) int {
+	n := 0
+	for i := 0; i < 16 && n < max; i++ {
+		if x := a[i] ^ b[i]; x != 0 {
+			n += bits.LeadingZeros8(x)
+			break
+		}
+		n += 8
+	}
+	if n > max {
+		n = max
+	}
+	return n
+}
+
+func (n *prefixNode[T]) prefix(is4 bool) netip.Prefix {
+	if is4 {
+		return netip.PrefixFrom(netip.AddrFrom4([4]byte(n.key[:4])), n.bits)
+	}
+	return netip.PrefixFrom(netip.AddrFrom16(n.key), n.bits)
+}
+
+// Insert sets the value of prefix p, whose host bits are ignored, and
+// reports whether p was added rather than replaced.
+func (t *PrefixTable[T]) Insert(p netip.Prefix, value T) bool {
+	key, n, link, ok := t.prefixKey(p)
+	if !ok {
+		return false
+	}
+	for {
+		cur := *link
+		if cur == nil {
+			*link = &prefixNode[T]{key: key, bits: n, value: value, set: true}
+			t.n++
+			return true
+		}
+		common := commonBits(&key, &cur.key, min(n, cur.bits))
+		switch {
+		case common == cur.bits && common == n:
+			added := !cur.set
+			cur.value, cur.set = value, true
+			if added {
+				t.n++
+			}
+			return added
+		case common == cur.bits:
+			// p is below cur.
+			link = &cur.child[keyBit(&key, common)]
+			continue
+		case common == n:
+			// p is above cur.
+			nn := &prefixNode[T]{key: key, bits: n, value: value, set: true}
+			nn.child[keyBit(&cur.key, common)] = cur
+			*link = nn
+		default:
+			// p and cur diverge below a new joining node.
+			fork := &prefixNode[T]{key: maskKey(key, common), bits: common}
+			fork.child[keyBit(&key, common)] = &prefixNode[T]{key: key, bits: n, value: value, set: true}
+			fork.child[keyBit(&cur.key, common)] = cur
+			*link = fork
+		}
+		t.n++
+		return true
+	}
+}
+
+// Get returns the value of exactly prefix p.
+func (t *PrefixTable[T]) Get(p netip.Prefix) (T, bool) {
+	var zero T
+	if t == nil {
+		return zero, false
+	}
+	key, n, link, ok := t.prefixKey(p)
+	if !ok {
+		return zero, false
+	}
+	for cur := *link; cur != nil && cur.bits <= n; cur = cur.child[keyBit(&key, cur.bits)] {
+		if commonBits(&key, &cur.key, cur.bits) < cur.bits {
+			break
+		}
+		if cur.bits == n {
+			if cur.set {
+				return cur.value, true
+			}
+			break
+		}
+	}
+	return zero, false
+}
+
+// Delete removes prefix p and reports whether it was present.
+func (t *PrefixTable[T]) Delete(p netip.Prefix) bool {
+	key, n, link, ok := t.prefixKey(p)
+	if !ok {
+		return false
+	}
+	var parent **prefixNode[T]
+	for {
+		cur := *link
+		if cur == nil || cur.bits > n || commonBits(&key, &cur.key, cur.bits) < cur.bits {
+			return false
+		}
+		if cur.bits < n {
+			parent, link = link, &cur.child[keyBit(&key, cur.bits)]
+			continue
+		}
+		if !cur.set {
+			return false
+		}
+		t.n--
+		var zero T
+		cur.value, cur.set = zero, false
+		t.compact(link)
+		if parent != nil {
+			t.compact(parent)
+		}
+		return true
+	}
+}
+
+// compact removes the node at link if it holds no value and joins fewer
+// than two subtrees.
+func (t *PrefixTable[T]) compact(link **prefixNode[T]) {
+	cur := *link
+	if cur.set {
+		return
+	}
+	switch {
+	case cur.child[0] != nil && cur.child[1] != nil:
+	case cur.child[0] != nil:
+		*link = cur.child[0]
+	default:
+		*link = cur.child[1]
+	}
+}
+
+// Lookup returns the longest prefix in the table that contains addr, and
+// its value.
+func (t *PrefixTable[T]) Lookup(addr netip.Addr) (netip.Prefix, T, bool) {
+	var zero T
+	if t == nil || !addr.IsValid() {
+		return netip.Prefix{}, zero, false
+	}
+	key, link := t.addrKey(addr)
+	max := 128
+	if link == &t.root4 {
+		max = 32
+	}
+	var best *prefixNode[T]
+	for cur := *link; cur != nil; {
+		if commonBits(&key, &cur.key, cur.bits) < cur.bits {
+			break
+		}
+		if cur.set {
+			best = cur
+		}
+		if cur.bits == max {
+			break
+		}
+		cur = cur.child[keyBit(&key, cur.bits)]
+	}
+	if best == nil {
+		return netip.Prefix{}, zero, false
+	}
+	return best.prefix(max == 32), best.value, true
+}
+
+// Walk calls fn for each prefix in the table, IPv4 before IPv6 and each
+// prefix before the more specific prefixes it contains, until fn returns
+// false.
+func (t *PrefixTable[T]) Walk(fn func(p netip.Prefix, value T) bool) {
+	if t == nil {
+		return
+	}
+	if walkNode(t.root4, true, fn) {
+		walkNode(t.root6, false, fn)
+	}
+}
+
+func walkNode[T any](n *prefixNode[T], is4 bool, fn func(netip.Prefix, T) bool) bool {
+	if n == nil {
+		return true
+	}
+	if n.set && !fn(n.prefix(is4), n.value) {
+		return false
+	}
+	return walkNode(n.child[0], is4, fn) && walkNode(n.child[1], is4, fn)
+}
+
+// Len returns the number of prefixes in the table.
+func (t *PrefixTable[T]) Len() int {
+	if t == nil {
+		return 0
+	}
+	return t.n
+}
+
+// AtomicPrefixTable holds a PrefixTable that can be replaced while other
+// goroutines look up addresses in it. The zero value holds an empty table.
+type AtomicPrefixTable[T any] struct {
+	p atomic.Pointer[PrefixTable[T]]
+}
+
+// Load returns the current table, which must not be changed. It is nil
+// until the first Store.
+func (a *AtomicPrefixTable[T]) Load() *PrefixTable[T] {
+	return a.p.Load()
+}
+
+// Store replaces the table with t, which must not be changed afterwards.
+func (a *AtomicPrefixTable[T]) Store(t *PrefixTable[T]) {
+	a.p.Store(t)
+}
+
+// Lookup returns the longest prefix in the current table that contains
+// addr, and its value.
+func (a *AtomicPrefixTable[T]) Lookup(addr netip.Addr) (netip.Prefix, T, bool) {
+	return a.Load().Lookup(addr)
+}
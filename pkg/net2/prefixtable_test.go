@@ -0,0 +1,322 @@
+package net2
+
+import (
+	"math/rand"
+	"net/netip"
+	"testing"
+)
+
+func mustPrefixes(ss ...string) []netip.Prefix {
+	var ps []netip.Prefix
+	for _, s := range ss {
+		ps = append(ps, netip.MustParsePrefix(s))
+	}
+	return ps
+}
+
+func TestPrefixTableInsertGet(t *testing.T) {
+	tab := NewPrefixTable[int]()
+	tests := []struct {
+		prefix string
+		added  bool
+	}{
+		{"10.0.0.0/8", true},
+		{"10.1.0.0/16", true},
+		{"10.1.2.0/24", true},
+		{"10.2.0.0/16", true},
+		{"0.0.0.0/0", true},
+		{"192.0.2.1/32", true},
+		{"2001:db8::/32", true},
+		{"2001:db8:1::/48", true},
+		{"::/0", true},
+		// Host bits are ignored, so this replaces 10.1.0.0/16.
+		{"10.1.255.255/16", false},
+		// IPv4-mapped prefixes are IPv4.
+		{"::ffff:10.2.0.0/112", false},
+		{"::ffff:172.16.0.0/108", true},
+	}
+	for i, tt := range tests {
+		if got := tab.Insert(netip.MustParsePrefix(tt.prefix), i); got != tt.added {
+			t.Errorf("Insert(%s) = %v, want %v", tt.prefix, got, tt.added)
+		}
+	}
+	if got, want := tab.Len(), 10; got != want {
+		t.Errorf("Len() = %d, want %d", got, want)
+	}
+	if tab.Insert(netip.Prefix{}, 0) {
+		t.Error("Insert(invalid prefix) = true")
+	}
+	if tab.Insert(netip.MustParsePrefix("::ffff:0.0.0.0/95"), 0) {
+		t.Error("Insert(::ffff:0.0.0.0/95) = true")
+	}
+
+	gets := []struct {
+		prefix string
+		value  int
+		ok     bool
+	}{
+		{"10.0.0.0/8", 0, true},
+		{"10.1.0.0/16", 9, true},
+		{"10.2.0.0/16", 10, true},
+		{"172.16.0.0/12", 11, true},
+		{"::ffff:10.1.2.0/120", 2, true},
+		{"0.0.0.0/0", 4, true},
+		{"::/0", 8, true},
+		{"2001:db8::/32", 6, true},
+		{"10.1.0.0/15", 0, false},
+		{"10.1.2.0/25", 0, false},
+		{"10.3.0.0/16", 0, false},
+		{"2001:db8::/33", 0, false},
+		// 10.0.0.0/14 is the node joining 10.1/16 and 10.2/16, not a
+		// prefix of the table.
+		{"10.0.0.0/14", 0, false},
+	}
+	for _, tt := range gets {
+		value, ok := tab.Get(netip.MustParsePrefix(tt.prefix))
+		if value != tt.value || ok != tt.ok {
+			t.Errorf("Get(%s) = %d, %v; want %d, %v", tt.prefix, value, ok, tt.value, tt.ok)
+		}
+	}
+}
+
+func TestPrefixTableLookup(t *testing.T) {
+	tab := NewPrefixTable[string]()
+	for _, p := range mustPrefixes(
+		"10.0.0.0/8", "10.1.0.0/16", "10.1.2.0/24", "10.1.2.3/32",
+		"192.168.0.0/16", "2001:db8::/32", "2001:db8:1::/48",
+	) {
+		tab.Insert(p, p.String())
+	}
+	tests := []struct {
+		addr string
+		want string
+	}{
+		{"10.9.9.9", "10.0.0.0/8"},
+		{"10.1.9.9", "10.1.0.0/16"},
+		{"10.1.2.4", "10.1.2.0/24"},
+		{"10.1.2.3", "10.1.2.3/32"},
+		{"::ffff:10.1.2.3", "10.1.2.3/32"},
+		{"192.168.255.255", "192.168.0.0/16"},
+		{"2001:db8:1::1", "2001:db8:1::/48"},
+		{"2001:db8:2::1", "2001:db8::/32"},
+		{"11.0.0.0", ""},
+		{"192.169.0.0", ""},
+		{"2001:db9::", ""},
+		// IPv4 and IPv6 prefixes are separate.
+		{"::a01:203", ""},
+	}
+	for _, tt := range tests {
+		p, value, ok := tab.Lookup(netip.MustParseAddr(tt.addr))
+		if tt.want == "" {
+			if ok {
+				t.Errorf("Lookup(%s) = %s, want no match", tt.addr, p)
+			}
+			continue
+		}
+		if !ok || p.String() != tt.want || value != tt.want {
+			t.Errorf("Lookup(%s) = %s, %q, %v; want %s", tt.addr, p, value, ok, tt.want)
+		}
+	}
+	if _, _, ok := tab.Lookup(netip.Addr{}); ok {
+		t.Error("Lookup(invalid address) matched")
+	}
+
+	var empty *PrefixTable[string]
+	if _, _, ok := empty.Lookup(netip.MustParseAddr("10.0.0.1")); ok || empty.Len() != 0 {
+		t.Error("nil table is not empty")
+	}
+}
+
+func TestPrefixTableDelete(t *testing.T) {
+	tests := []struct {
+		name    string
+		insert  []netip.Prefix
+		delete  []netip.Prefix
+		deleted []bool
+		walk    []string
+	}{
+		{
+			name:    "leaf",
+			insert:  mustPrefixes("10.0.0.0/8", "10.1.0.0/16"),
+			delete:  mustPrefixes("10.1.0.0/16"),
+			deleted: []bool{true},
+			walk:    []string{"10.0.0.0/8"},
+		},
+		{
+			name:    "inner",
+			insert:  mustPrefixes("10.0.0.0/8", "10.1.0.0/16", "10.2.0.0/16"),
+			delete:  mustPrefixes("10.0.0.0/8"),
+			deleted: []bool{true},
+			walk:    []string{"10.1.0.0/16", "10.2.0.0/16"},
+		},
+		{
+			// Deleting one side of a fork removes the fork.
+			name:    "fork child",
+			insert:  mustPrefixes("10.1.0.0/16", "10.2.0.0/16", "10.2.3.0/24"),
+			delete:  mustPrefixes("10.1.0.0/16", "10.2.0.0/16"),
+			deleted: []bool{true, true},
+			walk:    []string{"10.2.3.0/24"},
+		},
+		{
+			// A fork holds no prefix of its own.
+			name:    "fork",
+			insert:  mustPrefixes("10.1.0.0/16", "10.2.0.0/16"),
+			delete:  mustPrefixes("10.0.0.0/14", "10.0.0.0/8"),
+			deleted: []bool{false, false},
+			walk:    []string{"10.1.0.0/16", "10.2.0.0/16"},
+		},
+		{
+			name:    "ipv4-mapped",
+			insert:  mustPrefixes("10.0.0.0/8", "2001:db8::/32"),
+			delete:  mustPrefixes("::ffff:10.0.0.0/104", "::ffff:10.0.0.0/104"),
+			deleted: []bool{true, false},
+			walk:    []string{"2001:db8::/32"},
+		},
+		{
+			name:    "all",
+			insert:  mustPrefixes("10.0.0.0/8", "10.1.0.0/16", "10.2.0.0/16", "::/0"),
+			delete:  mustPrefixes("10.1.0.0/16", "10.0.0.0/8", "::/0", "10.2.0.0/16"),
+			deleted: []bool{true, true, true, true},
+		},
+	}
+	for _, tt := range tests {
+		tab := NewPrefixTable[int]()
+		for _, p := range tt.insert {
+			tab.Insert(p, p.Bits())
+		}
+		for i, p := range tt.delete {
+			if got := tab.Delete(p); got != tt.deleted[i] {
+				t.Errorf("%s: Delete(%s) = %v, want %v", tt.name, p, got, tt.deleted[i])
+			}
+		}
+		var walk []string
+		tab.Walk(func(p netip.Prefix, value int) bool {
+			walk = append(walk, p.String())
+			if value != p.Bits() {
+				t.Errorf("%s: %s has value %d", tt.name, p, value)
+			}
+			return true
+		})
+		if !equalStrings(walk, tt.walk) {
+			t.Errorf("%s: Walk = %v, want %v", tt.name, walk, tt.walk)
+		}
+		if tab.Len() != len(tt.walk) {
+			t.Errorf("%s: Len() = %d, want %d", tt.name, tab.Len(), len(tt.walk))
+		}
+		if tt.walk == nil && (tab.root4 != nil || tab.root6 != nil) {
+			t.Errorf("%s: empty table has nodes", tt.name)
+		}
+	}
+}
+
+func TestPrefixTableWalk(t *testing.T) {
+	tab := NewPrefixTable[int]()
+	for _, p := range mustPrefixes(
+		"2001:db8::/32", "192.168.0.0/16", "10.1.0.0/16", "10.0.0.0/8",
+		"::/0", "10.1.2.0/24", "172.16.0.0/12",
+	) {
+		tab.Insert(p, 0)
+	}
+	want := []string{
+		"10.0.0.0/8", "10.1.0.0/16", "10.1.2.0/24", "172.16.0.0/12",
+		"192.168.0.0/16", "::/0", "2001:db8::/32",
+	}
+	var got []string
+	tab.Walk(func(p netip.Prefix, _ int) bool {
+		got = append(got, p.String())
+		return true
+	})
+	if !equalStrings(got, want) {
+		t.Errorf("Walk = %v, want %v", got, want)
+	}
+
+	got = nil
+	tab.Walk(func(p netip.Prefix, _ int) bool {
+		got = append(got, p.String())
+		return len(got) < 3
+	})
+	if !equalStrings(got, want[:3]) {
+		t.Errorf("Walk stopping after 3 = %v, want %v", got, want[:3])
+	}
+}
+
+// TestPrefixTableRandom checks lookups in a table of random prefixes,
+// with some deleted, against a linear search.
+func TestPrefixTableRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tab := NewPrefixTable[netip.Prefix]()
+	want := make(map[netip.Prefix]bool)
+	for i := 0; i < 2000; i++ {
+		p := randomPrefix(rng, 4+rng.Intn(29)).Masked()
+		tab.Insert(p, p)
+		want[p] = true
+	}
+	for p := range want {
+		if rng.Intn(3) == 0 {
+			if !tab.Delete(p) {
+				t.Fatalf("Delete(%s) = false", p)
+			}
+			delete(want, p)
+		}
+	}
+	if tab.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", tab.Len(), len(want))
+	}
+	for i := 0; i < 10000; i++ {
+		addr := randomPrefix(rng, 32).Addr()
+		var best netip.Prefix
+		for p := range want {
+			if p.Contains(addr) && (!best.IsValid() || p.Bits() > best.Bits()) {
+				best = p
+			}
+		}
+		p, value, ok := tab.Lookup(addr)
+		if ok != best.IsValid() || p != best || value != best {
+			t.Fatalf("Lookup(%s) = %s, %s, %v; want %s", addr, p, value, ok, best)
+		}
+	}
+}
+
+func randomPrefix(rng *rand.Rand, bits int) netip.Prefix {
+	// Addresses are drawn from 10.0.0.0/12 so that prefixes nest.
+	n := 10<<24 | rng.Uint32()&(1<<20-1)
+	addr := netip.AddrFrom4([4]byte{byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)})
+	return netip.PrefixFrom(addr, bits)
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// BenchmarkLookup looks up random addresses in a table of about a million
+// prefixes, the size of a full IPv4 and IPv6 routing table.
+func BenchmarkLookup(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	tab := NewPrefixTable[uint32]()
+	for tab.Len() < 1_000_000 {
+		var a [16]byte
+		rng.Read(a[:])
+		if rng.Intn(5) == 0 {
+			tab.Insert(netip.PrefixFrom(netip.AddrFrom16(a), 16+rng.Intn(49)).Masked(), 0)
+		} else {
+			tab.Insert(netip.PrefixFrom(netip.AddrFrom4([4]byte(a[:4])), 8+rng.Intn(17)).Masked(), 0)
+		}
+	}
+	addrs := make([]netip.Addr, 1<<16)
+	for i := range addrs {
+		addrs[i] = netip.AddrFrom4([4]byte{byte(rng.Intn(256)), byte(rng.Intn(256)), byte(rng.Intn(256)), byte(rng.Intn(256))})
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tab.Lookup(addrs[i&(len(addrs)-1)])
+	}
+}
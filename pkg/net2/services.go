@@ -0,0 +1,162 @@
+package net2
+
+//go:generate go run gen_services.go -out services_table.go
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Service is a service name assigned to a port of a transport protocol.
+type Service struct {
+	Protocol uint8
+	Port     uint16
+	Name     string
+}
+
+// ServiceProtocols maps the transport protocol names used in service
+// files to IP protocol numbers.
+var ServiceProtocols = map[string]uint8{
+	"tcp":  6,
+	"udp":  17,
+	"dccp": 33,
+	"sctp": 132,
+}
+
+// ReadServices reads services in the format of /etc/services: a name, a
+// PORT/PROTOCOL pair and optional aliases on each line, with comments
+// starting at #. Lines for protocols missing from ServiceProtocols are
+// skipped.
+func ReadServices(r io.Reader) ([]Service, error) {
+	var services []Service
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		text, _, _ := strings.Cut(s.Text(), "#")
+		words := strings.Fields(text)
+		if len(words) == 0 {
+			continue
+		}
+		if len(words) < 2 {
+			return nil, fmt.Errorf("services: line %d: want NAME PORT/PROTOCOL", line)
+		}
+		port, proto, ok := strings.Cut(words[1], "/")
+		if !ok {
+			return nil, fmt.Errorf("services: line %d: want NAME PORT/PROTOCOL", line)
+		}
+		n, err := strconv.ParseUint(port, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("services: line %d: invalid port %q", line, port)
+		}
+		p, ok := ServiceProtocols[strings.ToLower(proto)]
+		if !ok {
+			continue
+		}
+		services = append(services, Service{p, uint16(n), words[0]})
+	}
+	return services, s.Err()
+}
+
+// serviceTable indexes services by protocol and port, and ports by
+// service name.
+type serviceTable struct {
+	names map[uint32]string
+	ports map[string]uint16
+}
+
+func serviceKey(proto uint8, port uint16) uint32 {
+	return uint32(proto)<<16 | uint32(port)
+}
+
+// add adds services to the table, replacing the names of ports that
+// already have one.
+func (t *serviceTable) add(services []Service) {
+	for _, s := range services {
+		t.names[serviceKey(s.Protocol, s.Port)] = s.Name
+		name := strings.ToLower(s.Name)
+		if _, ok := t.ports[name]; !ok || s.Protocol == 6 {
+			t.ports[name] = s.Port
+		}
+	}
+}
+
+var (
+	// services is replaced, not changed, by AddServices, so lookups
+	// need no lock.
+	services   atomic.Pointer[serviceTable]
+	servicesMu sync.Mutex
+)
+
+func init() {
+	t := &serviceTable{
+		names: make(map[uint32]string, len(ianaServices)),
+		ports: make(map[string]uint16, len(ianaServices)),
+	}
+	t.add(ianaServices)
+	// The names in TCPUDPPortMap predate the registry and are kept, so
+	// that output doesn't change.
+	for port, names := range TCPUDPPortMap {
+		for proto, name := range names {
+			t.add([]Service{{ServiceProtocols[strings.ToLower(proto)], uint16(port), name}})
+		}
+	}
+	services.Store(t)
+}
+
+// AddServices adds services to the registry used by ServiceName,
+// replacing the names of ports that already have one.
+func AddServices(s []Service) {
+	servicesMu.Lock()
+	defer servicesMu.Unlock()
+
+	old := services.Load()
+	t := &serviceTable{
+		names: make(map[uint32]string, len(old.names)+len(s)),
+		ports: make(map[string]uint16, len(old.ports)+len(s)),
+	}
+	for k, v := range old.names {
+		t.names[k] = v
+	}
+	for k, v := range old.ports {
+		t.ports[k] = v
+	}
+	t.add(s)
+	services.Store(t)
+}
+
+// LoadServices adds the services in the file at path, in the format of
+// /etc/services, to the registry used by ServiceName.
+func LoadServices(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	s, err := ReadServices(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	AddServices(s)
+	return nil
+}
+
+// ServiceName returns the name of the service on port of the IP protocol
+// proto, e.g. ServiceName(6, 22) is "ssh", or "" if it has none. Names
+// come from the IANA service name registry, overridden by TCPUDPPortMap
+// and then by files added with LoadServices.
+func ServiceName(proto uint8, port uint16) string {
+	return services.Load().names[serviceKey(proto, port)]
+}
+
+// ServicePort returns the port of a service name, ignoring case. Names
+// assigned to different ports for different protocols return the TCP
+// port.
+func ServicePort(name string) (uint16, bool) {
+	port, ok := services.Load().ports[strings.ToLower(name)]
+	return port, ok
+}
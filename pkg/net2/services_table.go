@@ -0,0 +1,11462 @@
+// Code generated by gen_services.go from service-names-port-numbers.csv; DO NOT EDIT.
+
+package net2
+
+// ianaServices are the service names of the IANA registry.
+var ianaServices = []Service{
+	{6, 1, "tcpmux"},
+	{6, 2, "compressnet"},
+	{6, 3, "compressnet"},
+	{6, 5, "rje"},
+	{6, 7, "echo"},
+	{6, 9, "discard"},
+	{6, 11, "systat"},
+	{6, 13, "daytime"},
+	{6, 17, "qotd"},
+	{6, 18, "msp"},
+	{6, 19, "chargen"},
+	{6, 20, "ftp-data"},
+	{6, 21, "ftp"},
+	{6, 22, "ssh"},
+	{6, 23, "telnet"},
+	{6, 25, "smtp"},
+	{6, 27, "nsw-fe"},
+	{6, 29, "msg-icp"},
+	{6, 31, "msg-auth"},
+	{6, 33, "dsp"},
+	{6, 37, "time"},
+	{6, 38, "rap"},
+	{6, 39, "rlp"},
+	{6, 41, "graphics"},
+	{6, 42, "name"},
+	{6, 43, "nicname"},
+	{6, 44, "mpm-flags"},
+	{6, 45, "mpm"},
+	{6, 46, "mpm-snd"},
+	{6, 48, "auditd"},
+	{6, 49, "tacacs"},
+	{6, 50, "re-mail-ck"},
+	{6, 52, "xns-time"},
+	{6, 53, "domain"},
+	{6, 54, "xns-ch"},
+	{6, 55, "isi-gl"},
+	{6, 56, "xns-auth"},
+	{6, 58, "xns-mail"},
+	{6, 62, "acas"},
+	{6, 63, "whoispp"},
+	{6, 64, "covia"},
+	{6, 65, "tacacs-ds"},
+	{6, 66, "sql-net"},
+	{6, 67, "bootps"},
+	{6, 68, "bootpc"},
+	{6, 69, "tftp"},
+	{6, 70, "gopher"},
+	{6, 71, "netrjs-1"},
+	{6, 72, "netrjs-2"},
+	{6, 73, "netrjs-3"},
+	{6, 74, "netrjs-4"},
+	{6, 76, "deos"},
+	{6, 78, "vettcp"},
+	{6, 79, "finger"},
+	{6, 80, "http"},
+	{6, 82, "xfer"},
+	{6, 83, "mit-ml-dev"},
+	{6, 84, "ctf"},
+	{6, 85, "mit-ml-dev"},
+	{6, 86, "mfcobol"},
+	{6, 88, "kerberos"},
+	{6, 89, "su-mit-tg"},
+	{6, 90, "dnsix"},
+	{6, 91, "mit-dov"},
+	{6, 92, "npp"},
+	{6, 93, "dcp"},
+	{6, 94, "objcall"},
+	{6, 95, "supdup"},
+	{6, 96, "dixie"},
+	{6, 97, "swift-rvf"},
+	{6, 98, "tacnews"},
+	{6, 99, "metagram"},
+	{6, 101, "hostname"},
+	{6, 102, "iso-tsap"},
+	{6, 103, "gppitnp"},
+	{6, 104, "acr-nema"},
+	{6, 105, "cso"},
+	{6, 106, "3com-tsmux"},
+	{6, 107, "rtelnet"},
+	{6, 108, "snagas"},
+	{6, 109, "pop2"},
+	{6, 110, "pop3"},
+	{6, 111, "sunrpc"},
+	{6, 112, "mcidas"},
+	{6, 113, "ident"},
+	{6, 115, "sftp"},
+	{6, 116, "ansanotify"},
+	{6, 117, "uucp-path"},
+	{6, 118, "sqlserv"},
+	{6, 119, "nntp"},
+	{6, 120, "cfdptkt"},
+	{6, 121, "erpc"},
+	{6, 122, "smakynet"},
+	{6, 123, "ntp"},
+	{6, 124, "ansatrader"},
+	{6, 125, "locus-map"},
+	{6, 126, "nxedit"},
+	{6, 127, "locus-con"},
+	{6, 128, "gss-xlicen"},
+	{6, 129, "pwdgen"},
+	{6, 130, "cisco-fna"},
+	{6, 131, "cisco-tna"},
+	{6, 132, "cisco-sys"},
+	{6, 133, "statsrv"},
+	{6, 134, "ingres-net"},
+	{6, 135, "epmap"},
+	{6, 136, "profile"},
+	{6, 137, "netbios-ns"},
+	{6, 138, "netbios-dgm"},
+	{6, 139, "netbios-ssn"},
+	{6, 140, "emfis-data"},
+	{6, 141, "emfis-cntl"},
+	{6, 142, "bl-idm"},
+	{6, 143, "imap"},
+	{6, 144, "uma"},
+	{6, 145, "uaac"},
+	{6, 146, "iso-tp0"},
+	{6, 147, "iso-ip"},
+	{6, 148, "jargon"},
+	{6, 149, "aed-512"},
+	{6, 150, "sql-net"},
+	{6, 151, "hems"},
+	{6, 152, "bftp"},
+	{6, 153, "sgmp"},
+	{6, 154, "netsc-prod"},
+	{6, 155, "netsc-dev"},
+	{6, 156, "sqlsrv"},
+	{6, 157, "knet-cmp"},
+	{6, 158, "pcmail-srv"},
+	{6, 159, "nss-routing"},
+	{6, 160, "sgmp-traps"},
+	{6, 161, "snmp"},
+	{6, 162, "snmptrap"},
+	{6, 163, "cmip-man"},
+	{6, 164, "cmip-agent"},
+	{6, 165, "xns-courier"},
+	{6, 166, "s-net"},
+	{6, 167, "namp"},
+	{6, 168, "rsvd"},
+	{6, 169, "send"},
+	{6, 170, "print-srv"},
+	{6, 171, "multiplex"},
+	{6, 172, "cl-1"},
+	{6, 173, "xyplex-mux"},
+	{6, 174, "mailq"},
+	{6, 175, "vmnet"},
+	{6, 176, "genrad-mux"},
+	{6, 177, "xdmcp"},
+	{6, 178, "nextstep"},
+	{6, 179, "bgp"},
+	{6, 180, "ris"},
+	{6, 181, "unify"},
+	{6, 182, "audit"},
+	{6, 183, "ocbinder"},
+	{6, 184, "ocserver"},
+	{6, 185, "remote-kis"},
+	{6, 186, "kis"},
+	{6, 187, "aci"},
+	{6, 188, "mumps"},
+	{6, 189, "qft"},
+	{6, 190, "gacp"},
+	{6, 191, "prospero"},
+	{6, 192, "osu-nms"},
+	{6, 193, "srmp"},
+	{6, 194, "irc"},
+	{6, 195, "dn6-nlm-aud"},
+	{6, 196, "dn6-smm-red"},
+	{6, 197, "dls"},
+	{6, 198, "dls-mon"},
+	{6, 199, "smux"},
+	{6, 200, "src"},
+	{6, 201, "at-rtmp"},
+	{6, 202, "at-nbp"},
+	{6, 203, "at-3"},
+	{6, 204, "at-echo"},
+	{6, 205, "at-5"},
+	{6, 206, "at-zis"},
+	{6, 207, "at-7"},
+	{6, 208, "at-8"},
+	{6, 209, "qmtp"},
+	{6, 210, "z39-50"},
+	{6, 211, "914c-g"},
+	{6, 212, "anet"},
+	{6, 213, "ipx"},
+	{6, 214, "vmpwscs"},
+	{6, 215, "softpc"},
+	{6, 216, "CAIlic"},
+	{6, 217, "dbase"},
+	{6, 218, "mpp"},
+	{6, 219, "uarps"},
+	{6, 220, "imap3"},
+	{6, 221, "fln-spx"},
+	{6, 222, "rsh-spx"},
+	{6, 223, "cdc"},
+	{6, 224, "masqdialer"},
+	{6, 242, "direct"},
+	{6, 243, "sur-meas"},
+	{6, 244, "inbusiness"},
+	{6, 245, "link"},
+	{6, 246, "dsp3270"},
+	{6, 247, "subntbcst-tftp"},
+	{6, 248, "bhfhs"},
+	{6, 256, "rap"},
+	{6, 257, "set"},
+	{6, 259, "esro-gen"},
+	{6, 260, "openport"},
+	{6, 261, "nsiiops"},
+	{6, 262, "arcisdms"},
+	{6, 263, "hdap"},
+	{6, 264, "bgmp"},
+	{6, 265, "x-bone-ctl"},
+	{6, 266, "sst"},
+	{6, 267, "td-service"},
+	{6, 268, "td-replica"},
+	{6, 269, "manet"},
+	{6, 271, "pt-tls"},
+	{6, 280, "http-mgmt"},
+	{6, 281, "personal-link"},
+	{6, 282, "cableport-ax"},
+	{6, 283, "rescap"},
+	{6, 284, "corerjd"},
+	{6, 286, "fxp"},
+	{6, 287, "k-block"},
+	{6, 308, "novastorbakcup"},
+	{6, 309, "entrusttime"},
+	{6, 310, "bhmds"},
+	{6, 311, "asip-webadmin"},
+	{6, 312, "vslmp"},
+	{6, 313, "magenta-logic"},
+	{6, 314, "opalis-robot"},
+	{6, 315, "dpsi"},
+	{6, 316, "decauth"},
+	{6, 317, "zannet"},
+	{6, 318, "pkix-timestamp"},
+	{6, 319, "ptp-event"},
+	{6, 320, "ptp-general"},
+	{6, 321, "pip"},
+	{6, 322, "rtsps"},
+	{6, 323, "rpki-rtr"},
+	{6, 324, "rpki-rtr-tls"},
+	{6, 333, "texar"},
+	{6, 344, "pdap"},
+	{6, 345, "pawserv"},
+	{6, 346, "zserv"},
+	{6, 347, "fatserv"},
+	{6, 348, "csi-sgwp"},
+	{6, 349, "mftp"},
+	{6, 350, "matip-type-a"},
+	{6, 351, "matip-type-b"},
+	{6, 352, "dtag-ste-sb"},
+	{6, 353, "ndsauth"},
+	{6, 354, "bh611"},
+	{6, 355, "datex-asn"},
+	{6, 356, "cloanto-net-1"},
+	{6, 357, "bhevent"},
+	{6, 358, "shrinkwrap"},
+	{6, 359, "nsrmp"},
+	{6, 360, "scoi2odialog"},
+	{6, 361, "semantix"},
+	{6, 362, "srssend"},
+	{6, 363, "rsvp-tunnel"},
+	{6, 364, "aurora-cmgr"},
+	{6, 365, "dtk"},
+	{6, 366, "odmr"},
+	{6, 367, "mortgageware"},
+	{6, 368, "qbikgdp"},
+	{6, 369, "rpc2portmap"},
+	{6, 370, "codaauth2"},
+	{6, 371, "clearcase"},
+	{6, 372, "ulistproc"},
+	{6, 373, "legent-1"},
+	{6, 374, "legent-2"},
+	{6, 375, "hassle"},
+	{6, 376, "nip"},
+	{6, 377, "tnETOS"},
+	{6, 378, "dsETOS"},
+	{6, 379, "is99c"},
+	{6, 380, "is99s"},
+	{6, 381, "hp-collector"},
+	{6, 382, "hp-managed-node"},
+	{6, 383, "hp-alarm-mgr"},
+	{6, 384, "arns"},
+	{6, 385, "ibm-app"},
+	{6, 386, "asa"},
+	{6, 387, "aurp"},
+	{6, 388, "unidata-ldm"},
+	{6, 389, "ldap"},
+	{6, 390, "uis"},
+	{6, 391, "synotics-relay"},
+	{6, 392, "synotics-broker"},
+	{6, 393, "meta5"},
+	{6, 394, "embl-ndt"},
+	{6, 395, "netcp"},
+	{6, 396, "netware-ip"},
+	{6, 397, "mptn"},
+	{6, 398, "kryptolan"},
+	{6, 399, "iso-tsap-c2"},
+	{6, 400, "osb-sd"},
+	{6, 401, "ups"},
+	{6, 402, "genie"},
+	{6, 403, "decap"},
+	{6, 404, "nced"},
+	{6, 405, "ncld"},
+	{6, 406, "imsp"},
+	{6, 407, "timbuktu"},
+	{6, 408, "prm-sm"},
+	{6, 409, "prm-nm"},
+	{6, 410, "decladebug"},
+	{6, 411, "rmt"},
+	{6, 412, "synoptics-trap"},
+	{6, 413, "smsp"},
+	{6, 414, "infoseek"},
+	{6, 415, "bnet"},
+	{6, 416, "silverplatter"},
+	{6, 417, "onmux"},
+	{6, 418, "hyper-g"},
+	{6, 419, "ariel1"},
+	{6, 420, "smpte"},
+	{6, 421, "ariel2"},
+	{6, 422, "ariel3"},
+	{6, 423, "opc-job-start"},
+	{6, 424, "opc-job-track"},
+	{6, 425, "icad-el"},
+	{6, 426, "smartsdp"},
+	{6, 427, "svrloc"},
+	{6, 428, "ocs-cmu"},
+	{6, 429, "ocs-amu"},
+	{6, 430, "utmpsd"},
+	{6, 431, "utmpcd"},
+	{6, 432, "iasd"},
+	{6, 433, "nnsp"},
+	{6, 434, "mobileip-agent"},
+	{6, 435, "mobilip-mn"},
+	{6, 436, "dna-cml"},
+	{6, 437, "comscm"},
+	{6, 438, "dsfgw"},
+	{6, 439, "dasp"},
+	{6, 440, "sgcp"},
+	{6, 441, "decvms-sysmgt"},
+	{6, 442, "cvc-hostd"},
+	{6, 443, "https"},
+	{6, 444, "snpp"},
+	{6, 445, "microsoft-ds"},
+	{6, 446, "ddm-rdb"},
+	{6, 447, "ddm-dfm"},
+	{6, 448, "ddm-ssl"},
+	{6, 449, "as-servermap"},
+	{6, 450, "tserver"},
+	{6, 451, "sfs-smp-net"},
+	{6, 452, "sfs-config"},
+	{6, 453, "creativeserver"},
+	{6, 454, "contentserver"},
+	{6, 455, "creativepartnr"},
+	{6, 456, "macon-tcp"},
+	{6, 457, "scohelp"},
+	{6, 458, "appleqtc"},
+	{6, 459, "ampr-rcmd"},
+	{6, 460, "skronk"},
+	{6, 461, "datasurfsrv"},
+	{6, 462, "datasurfsrvsec"},
+	{6, 463, "alpes"},
+	{6, 464, "kpasswd"},
+	{6, 465, "urd"},
+	{6, 466, "digital-vrc"},
+	{6, 467, "mylex-mapd"},
+	{6, 468, "photuris"},
+	{6, 469, "rcp"},
+	{6, 470, "scx-proxy"},
+	{6, 471, "mondex"},
+	{6, 472, "ljk-login"},
+	{6, 473, "hybrid-pop"},
+	{6, 474, "tn-tl-w1"},
+	{6, 475, "tcpnethaspsrv"},
+	{6, 476, "tn-tl-fd1"},
+	{6, 477, "ss7ns"},
+	{6, 478, "spsc"},
+	{6, 479, "iafserver"},
+	{6, 480, "iafdbase"},
+	{6, 481, "ph"},
+	{6, 482, "bgs-nsi"},
+	{6, 483, "ulpnet"},
+	{6, 484, "integra-sme"},
+	{6, 485, "powerburst"},
+	{6, 486, "avian"},
+	{6, 487, "saft"},
+	{6, 488, "gss-http"},
+	{6, 489, "nest-protocol"},
+	{6, 490, "micom-pfs"},
+	{6, 491, "go-login"},
+	{6, 492, "ticf-1"},
+	{6, 493, "ticf-2"},
+	{6, 494, "pov-ray"},
+	{6, 495, "intecourier"},
+	{6, 496, "pim-rp-disc"},
+	{6, 497, "retrospect"},
+	{6, 498, "siam"},
+	{6, 499, "iso-ill"},
+	{6, 500, "isakmp"},
+	{6, 501, "stmf"},
+	{6, 502, "mbap"},
+	{6, 503, "intrinsa"},
+	{6, 504, "citadel"},
+	{6, 505, "mailbox-lm"},
+	{6, 506, "ohimsrv"},
+	{6, 507, "crs"},
+	{6, 508, "xvttp"},
+	{6, 509, "snare"},
+	{6, 510, "fcp"},
+	{6, 511, "passgo"},
+	{6, 512, "exec"},
+	{6, 513, "login"},
+	{6, 514, "shell"},
+	{6, 515, "printer"},
+	{6, 516, "videotex"},
+	{6, 517, "talk"},
+	{6, 518, "ntalk"},
+	{6, 519, "utime"},
+	{6, 520, "efs"},
+	{6, 521, "ripng"},
+	{6, 522, "ulp"},
+	{6, 523, "ibm-db2"},
+	{6, 524, "ncp"},
+	{6, 525, "timed"},
+	{6, 526, "tempo"},
+	{6, 527, "stx"},
+	{6, 528, "custix"},
+	{6, 529, "irc-serv"},
+	{6, 530, "courier"},
+	{6, 531, "conference"},
+	{6, 532, "netnews"},
+	{6, 533, "netwall"},
+	{6, 534, "windream"},
+	{6, 535, "iiop"},
+	{6, 536, "opalis-rdv"},
+	{6, 537, "nmsp"},
+	{6, 538, "gdomap"},
+	{6, 539, "apertus-ldp"},
+	{6, 540, "uucp"},
+	{6, 541, "uucp-rlogin"},
+	{6, 542, "commerce"},
+	{6, 543, "klogin"},
+	{6, 544, "kshell"},
+	{6, 545, "appleqtcsrvr"},
+	{6, 546, "dhcpv6-client"},
+	{6, 547, "dhcpv6-server"},
+	{6, 548, "afpovertcp"},
+	{6, 549, "idfp"},
+	{6, 550, "new-rwho"},
+	{6, 551, "cybercash"},
+	{6, 552, "devshr-nts"},
+	{6, 553, "pirp"},
+	{6, 554, "rtsp"},
+	{6, 555, "dsf"},
+	{6, 556, "remotefs"},
+	{6, 557, "openvms-sysipc"},
+	{6, 558, "sdnskmp"},
+	{6, 559, "teedtap"},
+	{6, 560, "rmonitor"},
+	{6, 561, "monitor"},
+	{6, 562, "chshell"},
+	{6, 563, "nntps"},
+	{6, 564, "9pfs"},
+	{6, 565, "whoami"},
+	{6, 566, "streettalk"},
+	{6, 567, "banyan-rpc"},
+	{6, 568, "ms-shuttle"},
+	{6, 569, "ms-rome"},
+	{6, 570, "meter"},
+	{6, 571, "meter"},
+	{6, 572, "sonar"},
+	{6, 573, "banyan-vip"},
+	{6, 574, "ftp-agent"},
+	{6, 575, "vemmi"},
+	{6, 576, "ipcd"},
+	{6, 577, "vnas"},
+	{6, 578, "ipdd"},
+	{6, 579, "decbsrv"},
+	{6, 580, "sntp-heartbeat"},
+	{6, 581, "bdp"},
+	{6, 582, "scc-security"},
+	{6, 583, "philips-vc"},
+	{6, 584, "keyserver"},
+	{6, 586, "password-chg"},
+	{6, 587, "submission"},
+	{6, 588, "cal"},
+	{6, 589, "eyelink"},
+	{6, 590, "tns-cml"},
+	{6, 591, "http-alt"},
+	{6, 592, "eudora-set"},
+	{6, 593, "http-rpc-epmap"},
+	{6, 594, "tpip"},
+	{6, 595, "cab-protocol"},
+	{6, 596, "smsd"},
+	{6, 597, "ptcnameservice"},
+	{6, 598, "sco-websrvrmg3"},
+	{6, 599, "acp"},
+	{6, 600, "ipcserver"},
+	{6, 601, "syslog-conn"},
+	{6, 602, "xmlrpc-beep"},
+	{6, 603, "idxp"},
+	{6, 604, "tunnel"},
+	{6, 605, "soap-beep"},
+	{6, 606, "urm"},
+	{6, 607, "nqs"},
+	{6, 608, "sift-uft"},
+	{6, 609, "npmp-trap"},
+	{6, 610, "npmp-local"},
+	{6, 611, "npmp-gui"},
+	{6, 612, "hmmp-ind"},
+	{6, 613, "hmmp-op"},
+	{6, 614, "sshell"},
+	{6, 615, "sco-inetmgr"},
+	{6, 616, "sco-sysmgr"},
+	{6, 617, "sco-dtmgr"},
+	{6, 618, "dei-icda"},
+	{6, 619, "compaq-evm"},
+	{6, 620, "sco-websrvrmgr"},
+	{6, 621, "escp-ip"},
+	{6, 622, "collaborator"},
+	{6, 623, "oob-ws-http"},
+	{6, 624, "cryptoadmin"},
+	{6, 625, "dec-dlm"},
+	{6, 626, "asia"},
+	{6, 627, "passgo-tivoli"},
+	{6, 628, "qmqp"},
+	{6, 629, "3com-amp3"},
+	{6, 630, "rda"},
+	{6, 631, "ipp"},
+	{6, 632, "bmpp"},
+	{6, 633, "servstat"},
+	{6, 634, "ginad"},
+	{6, 635, "rlzdbase"},
+	{6, 636, "ldaps"},
+	{6, 637, "lanserver"},
+	{6, 638, "mcns-sec"},
+	{6, 639, "msdp"},
+	{6, 640, "entrust-sps"},
+	{6, 641, "repcmd"},
+	{6, 642, "esro-emsdp"},
+	{6, 643, "sanity"},
+	{6, 644, "dwr"},
+	{6, 645, "pssc"},
+	{6, 646, "ldp"},
+	{6, 647, "dhcp-failover"},
+	{6, 648, "rrp"},
+	{6, 649, "cadview-3d"},
+	{6, 650, "obex"},
+	{6, 651, "ieee-mms"},
+	{6, 652, "hello-port"},
+	{6, 653, "repscmd"},
+	{6, 654, "aodv"},
+	{6, 655, "tinc"},
+	{6, 656, "spmp"},
+	{6, 657, "rmc"},
+	{6, 658, "tenfold"},
+	{6, 660, "mac-srvr-admin"},
+	{6, 661, "hap"},
+	{6, 662, "pftp"},
+	{6, 663, "purenoise"},
+	{6, 664, "oob-ws-https"},
+	{6, 665, "sun-dr"},
+	{6, 666, "mdqs"},
+	{6, 667, "disclose"},
+	{6, 668, "mecomm"},
+	{6, 669, "meregister"},
+	{6, 670, "vacdsm-sws"},
+	{6, 671, "vacdsm-app"},
+	{6, 672, "vpps-qua"},
+	{6, 673, "cimplex"},
+	{6, 674, "acap"},
+	{6, 675, "dctp"},
+	{6, 676, "vpps-via"},
+	{6, 677, "vpp"},
+	{6, 678, "ggf-ncp"},
+	{6, 679, "mrm"},
+	{6, 680, "entrust-aaas"},
+	{6, 681, "entrust-aams"},
+	{6, 682, "xfr"},
+	{6, 683, "corba-iiop"},
+	{6, 684, "corba-iiop-ssl"},
+	{6, 685, "mdc-portmapper"},
+	{6, 686, "hcp-wismar"},
+	{6, 687, "asipregistry"},
+	{6, 688, "realm-rusd"},
+	{6, 689, "nmap"},
+	{6, 690, "vatp"},
+	{6, 691, "msexch-routing"},
+	{6, 692, "hyperwave-isp"},
+	{6, 693, "connendp"},
+	{6, 694, "ha-cluster"},
+	{6, 695, "ieee-mms-ssl"},
+	{6, 696, "rushd"},
+	{6, 697, "uuidgen"},
+	{6, 698, "olsr"},
+	{6, 699, "accessnetwork"},
+	{6, 700, "epp"},
+	{6, 701, "lmp"},
+	{6, 702, "iris-beep"},
+	{6, 704, "elcsd"},
+	{6, 705, "agentx"},
+	{6, 706, "silc"},
+	{6, 707, "borland-dsj"},
+	{6, 709, "entrust-kmsh"},
+	{6, 710, "entrust-ash"},
+	{6, 711, "cisco-tdp"},
+	{6, 712, "tbrpf"},
+	{6, 713, "iris-xpc"},
+	{6, 714, "iris-xpcs"},
+	{6, 715, "iris-lwz"},
+	{6, 729, "netviewdm1"},
+	{6, 730, "netviewdm2"},
+	{6, 731, "netviewdm3"},
+	{6, 741, "netgw"},
+	{6, 742, "netrcs"},
+	{6, 744, "flexlm"},
+	{6, 747, "fujitsu-dev"},
+	{6, 748, "ris-cm"},
+	{6, 749, "kerberos-adm"},
+	{6, 750, "rfile"},
+	{6, 751, "pump"},
+	{6, 752, "qrh"},
+	{6, 753, "rrh"},
+	{6, 754, "tell"},
+	{6, 758, "nlogin"},
+	{6, 759, "con"},
+	{6, 760, "ns"},
+	{6, 761, "rxe"},
+	{6, 762, "quotad"},
+	{6, 763, "cycleserv"},
+	{6, 764, "omserv"},
+	{6, 765, "webster"},
+	{6, 767, "phonebook"},
+	{6, 769, "vid"},
+	{6, 770, "cadlock"},
+	{6, 771, "rtip"},
+	{6, 772, "cycleserv2"},
+	{6, 773, "submit"},
+	{6, 774, "rpasswd"},
+	{6, 775, "entomb"},
+	{6, 776, "wpages"},
+	{6, 777, "multiling-http"},
+	{6, 780, "wpgs"},
+	{6, 800, "mdbs-daemon"},
+	{6, 801, "device"},
+	{6, 802, "mbap-s"},
+	{6, 810, "fcp-udp"},
+	{6, 828, "itm-mcell-s"},
+	{6, 829, "pkix-3-ca-ra"},
+	{6, 830, "netconf-ssh"},
+	{6, 831, "netconf-beep"},
+	{6, 832, "netconfsoaphttp"},
+	{6, 833, "netconfsoapbeep"},
+	{6, 847, "dhcp-failover2"},
+	{6, 848, "gdoi"},
+	{6, 853, "domain-s"},
+	{6, 854, "dlep"},
+	{6, 860, "iscsi"},
+	{6, 861, "owamp-control"},
+	{6, 862, "twamp-control"},
+	{6, 873, "rsync"},
+	{6, 886, "iclcnet-locate"},
+	{6, 887, "iclcnet-svinfo"},
+	{6, 888, "accessbuilder"},
+	{6, 900, "omginitialrefs"},
+	{6, 901, "smpnameres"},
+	{6, 902, "ideafarm-door"},
+	{6, 903, "ideafarm-panic"},
+	{6, 910, "kink"},
+	{6, 911, "xact-backup"},
+	{6, 912, "apex-mesh"},
+	{6, 913, "apex-edge"},
+	{6, 953, "rndc"},
+	{6, 989, "ftps-data"},
+	{6, 990, "ftps"},
+	{6, 991, "nas"},
+	{6, 992, "telnets"},
+	{6, 993, "imaps"},
+	{6, 995, "pop3s"},
+	{6, 996, "vsinet"},
+	{6, 997, "maitrd"},
+	{6, 998, "busboy"},
+	{6, 999, "garcon"},
+	{6, 1000, "cadlock2"},
+	{6, 1001, "webpush"},
+	{6, 1010, "surf"},
+	{6, 1021, "exp1"},
+	{6, 1022, "exp2"},
+	{6, 1025, "blackjack"},
+	{6, 1026, "cap"},
+	{6, 1029, "solid-mux"},
+	{6, 1033, "netinfo-local"},
+	{6, 1034, "activesync"},
+	{6, 1035, "mxxrlogin"},
+	{6, 1036, "nsstp"},
+	{6, 1037, "ams"},
+	{6, 1038, "mtqp"},
+	{6, 1039, "sbl"},
+	{6, 1040, "netarx"},
+	{6, 1041, "danf-ak2"},
+	{6, 1042, "afrog"},
+	{6, 1043, "boinc-client"},
+	{6, 1044, "dcutility"},
+	{6, 1045, "fpitp"},
+	{6, 1046, "wfremotertm"},
+	{6, 1047, "neod1"},
+	{6, 1048, "neod2"},
+	{6, 1049, "td-postman"},
+	{6, 1050, "cma"},
+	{6, 1051, "optima-vnet"},
+	{6, 1052, "ddt"},
+	{6, 1053, "remote-as"},
+	{6, 1054, "brvread"},
+	{6, 1055, "ansyslmd"},
+	{6, 1056, "vfo"},
+	{6, 1057, "startron"},
+	{6, 1058, "nim"},
+	{6, 1059, "nimreg"},
+	{6, 1060, "polestar"},
+	{6, 1061, "kiosk"},
+	{6, 1062, "veracity"},
+	{6, 1063, "kyoceranetdev"},
+	{6, 1064, "jstel"},
+	{6, 1065, "syscomlan"},
+	{6, 1066, "fpo-fns"},
+	{6, 1067, "instl-boots"},
+	{6, 1068, "instl-bootc"},
+	{6, 1069, "cognex-insight"},
+	{6, 1070, "gmrupdateserv"},
+	{6, 1071, "bsquare-voip"},
+	{6, 1072, "cardax"},
+	{6, 1073, "bridgecontrol"},
+	{6, 1074, "warmspotMgmt"},
+	{6, 1075, "rdrmshc"},
+	{6, 1076, "dab-sti-c"},
+	{6, 1077, "imgames"},
+	{6, 1078, "avocent-proxy"},
+	{6, 1079, "asprovatalk"},
+	{6, 1080, "socks"},
+	{6, 1081, "pvuniwien"},
+	{6, 1082, "amt-esd-prot"},
+	{6, 1083, "ansoft-lm-1"},
+	{6, 1084, "ansoft-lm-2"},
+	{6, 1085, "webobjects"},
+	{6, 1086, "cplscrambler-lg"},
+	{6, 1087, "cplscrambler-in"},
+	{6, 1088, "cplscrambler-al"},
+	{6, 1089, "ff-annunc"},
+	{6, 1090, "ff-fms"},
+	{6, 1091, "ff-sm"},
+	{6, 1092, "obrpd"},
+	{6, 1093, "proofd"},
+	{6, 1094, "rootd"},
+	{6, 1095, "nicelink"},
+	{6, 1096, "cnrprotocol"},
+	{6, 1097, "sunclustermgr"},
+	{6, 1098, "rmiactivation"},
+	{6, 1099, "rmiregistry"},
+	{6, 1100, "mctp"},
+	{6, 1101, "pt2-discover"},
+	{6, 1102, "adobeserver-1"},
+	{6, 1103, "adobeserver-2"},
+	{6, 1104, "xrl"},
+	{6, 1105, "ftranhc"},
+	{6, 1106, "isoipsigport-1"},
+	{6, 1107, "isoipsigport-2"},
+	{6, 1108, "ratio-adp"},
+	{6, 1110, "webadmstart"},
+	{6, 1111, "lmsocialserver"},
+	{6, 1112, "icp"},
+	{6, 1113, "ltp-deepspace"},
+	{6, 1114, "mini-sql"},
+	{6, 1115, "ardus-trns"},
+	{6, 1116, "ardus-cntl"},
+	{6, 1117, "ardus-mtrns"},
+	{6, 1118, "sacred"},
+	{6, 1119, "bnetgame"},
+	{6, 1120, "bnetfile"},
+	{6, 1121, "rmpp"},
+	{6, 1122, "availant-mgr"},
+	{6, 1123, "murray"},
+	{6, 1124, "hpvmmcontrol"},
+	{6, 1125, "hpvmmagent"},
+	{6, 1126, "hpvmmdata"},
+	{6, 1127, "kwdb-commn"},
+	{6, 1128, "saphostctrl"},
+	{6, 1129, "saphostctrls"},
+	{6, 1130, "casp"},
+	{6, 1131, "caspssl"},
+	{6, 1132, "kvm-via-ip"},
+	{6, 1133, "dfn"},
+	{6, 1134, "aplx"},
+	{6, 1135, "omnivision"},
+	{6, 1136, "hhb-gateway"},
+	{6, 1137, "trim"},
+	{6, 1138, "encrypted-admin"},
+	{6, 1139, "evm"},
+	{6, 1140, "autonoc"},
+	{6, 1141, "mxomss"},
+	{6, 1142, "edtools"},
+	{6, 1143, "imyx"},
+	{6, 1144, "fuscript"},
+	{6, 1145, "x9-icue"},
+	{6, 1146, "audit-transfer"},
+	{6, 1147, "capioverlan"},
+	{6, 1148, "elfiq-repl"},
+	{6, 1149, "bvtsonar"},
+	{6, 1150, "blaze"},
+	{6, 1151, "unizensus"},
+	{6, 1152, "winpoplanmess"},
+	{6, 1153, "c1222-acse"},
+	{6, 1154, "resacommunity"},
+	{6, 1155, "nfa"},
+	{6, 1156, "iascontrol-oms"},
+	{6, 1157, "iascontrol"},
+	{6, 1158, "dbcontrol-oms"},
+	{6, 1159, "oracle-oms"},
+	{6, 1160, "olsv"},
+	{6, 1161, "health-polling"},
+	{6, 1162, "health-trap"},
+	{6, 1163, "sddp"},
+	{6, 1164, "qsm-proxy"},
+	{6, 1165, "qsm-gui"},
+	{6, 1166, "qsm-remote"},
+	{6, 1167, "cisco-ipsla"},
+	{6, 1168, "vchat"},
+	{6, 1169, "tripwire"},
+	{6, 1170, "atc-lm"},
+	{6, 1171, "atc-appserver"},
+	{6, 1172, "dnap"},
+	{6, 1173, "d-cinema-rrp"},
+	{6, 1174, "fnet-remote-ui"},
+	{6, 1175, "dossier"},
+	{6, 1176, "indigo-server"},
+	{6, 1177, "dkmessenger"},
+	{6, 1178, "sgi-storman"},
+	{6, 1179, "b2n"},
+	{6, 1180, "mc-client"},
+	{6, 1181, "3comnetman"},
+	{6, 1182, "accelenet"},
+	{6, 1183, "llsurfup-http"},
+	{6, 1184, "llsurfup-https"},
+	{6, 1185, "catchpole"},
+	{6, 1186, "mysql-cluster"},
+	{6, 1187, "alias"},
+	{6, 1188, "hp-webadmin"},
+	{6, 1189, "unet"},
+	{6, 1190, "commlinx-avl"},
+	{6, 1191, "gpfs"},
+	{6, 1192, "caids-sensor"},
+	{6, 1193, "fiveacross"},
+	{6, 1194, "openvpn"},
+	{6, 1195, "rsf-1"},
+	{6, 1196, "netmagic"},
+	{6, 1197, "carrius-rshell"},
+	{6, 1198, "cajo-discovery"},
+	{6, 1199, "dmidi"},
+	{6, 1200, "scol"},
+	{6, 1201, "nucleus-sand"},
+	{6, 1202, "caiccipc"},
+	{6, 1203, "ssslic-mgr"},
+	{6, 1204, "ssslog-mgr"},
+	{6, 1205, "accord-mgc"},
+	{6, 1206, "anthony-data"},
+	{6, 1207, "metasage"},
+	{6, 1208, "seagull-ais"},
+	{6, 1209, "ipcd3"},
+	{6, 1210, "eoss"},
+	{6, 1211, "groove-dpp"},
+	{6, 1212, "lupa"},
+	{6, 1213, "mpc-lifenet"},
+	{6, 1214, "kazaa"},
+	{6, 1215, "scanstat-1"},
+	{6, 1216, "etebac5"},
+	{6, 1217, "hpss-ndapi"},
+	{6, 1218, "aeroflight-ads"},
+	{6, 1219, "aeroflight-ret"},
+	{6, 1220, "qt-serveradmin"},
+	{6, 1221, "sweetware-apps"},
+	{6, 1222, "nerv"},
+	{6, 1223, "tgp"},
+	{6, 1224, "vpnz"},
+	{6, 1225, "slinkysearch"},
+	{6, 1226, "stgxfws"},
+	{6, 1227, "dns2go"},
+	{6, 1228, "florence"},
+	{6, 1229, "zented"},
+	{6, 1230, "periscope"},
+	{6, 1231, "menandmice-lpm"},
+	{6, 1232, "first-defense"},
+	{6, 1233, "univ-appserver"},
+	{6, 1234, "search-agent"},
+	{6, 1235, "mosaicsyssvc1"},
+	{6, 1236, "bvcontrol"},
+	{6, 1237, "tsdos390"},
+	{6, 1238, "hacl-qs"},
+	{6, 1239, "nmsd"},
+	{6, 1240, "instantia"},
+	{6, 1241, "nessus"},
+	{6, 1242, "nmasoverip"},
+	{6, 1243, "serialgateway"},
+	{6, 1244, "isbconference1"},
+	{6, 1245, "isbconference2"},
+	{6, 1246, "payrouter"},
+	{6, 1247, "visionpyramid"},
+	{6, 1248, "hermes"},
+	{6, 1249, "mesavistaco"},
+	{6, 1250, "swldy-sias"},
+	{6, 1251, "servergraph"},
+	{6, 1252, "bspne-pcc"},
+	{6, 1253, "q55-pcc"},
+	{6, 1254, "de-noc"},
+	{6, 1255, "de-cache-query"},
+	{6, 1256, "de-server"},
+	{6, 1257, "shockwave2"},
+	{6, 1258, "opennl"},
+	{6, 1259, "opennl-voice"},
+	{6, 1260, "ibm-ssd"},
+	{6, 1261, "mpshrsv"},
+	{6, 1262, "qnts-orb"},
+	{6, 1263, "dka"},
+	{6, 1264, "prat"},
+	{6, 1265, "dssiapi"},
+	{6, 1266, "dellpwrappks"},
+	{6, 1267, "epc"},
+	{6, 1268, "propel-msgsys"},
+	{6, 1269, "watilapp"},
+	{6, 1270, "opsmgr"},
+	{6, 1271, "excw"},
+	{6, 1272, "cspmlockmgr"},
+	{6, 1273, "emc-gateway"},
+	{6, 1274, "t1distproc"},
+	{6, 1275, "ivcollector"},
+	{6, 1277, "miva-mqs"},
+	{6, 1278, "dellwebadmin-1"},
+	{6, 1279, "dellwebadmin-2"},
+	{6, 1280, "pictrography"},
+	{6, 1281, "healthd"},
+	{6, 1282, "emperion"},
+	{6, 1283, "productinfo"},
+	{6, 1284, "iee-qfx"},
+	{6, 1285, "neoiface"},
+	{6, 1286, "netuitive"},
+	{6, 1287, "routematch"},
+	{6, 1288, "navbuddy"},
+	{6, 1289, "jwalkserver"},
+	{6, 1290, "winjaserver"},
+	{6, 1291, "seagulllms"},
+	{6, 1292, "dsdn"},
+	{6, 1293, "pkt-krb-ipsec"},
+	{6, 1294, "cmmdriver"},
+	{6, 1295, "ehtp"},
+	{6, 1296, "dproxy"},
+	{6, 1297, "sdproxy"},
+	{6, 1298, "lpcp"},
+	{6, 1299, "hp-sci"},
+	{6, 1300, "h323hostcallsc"},
+	{6, 1303, "sftsrv"},
+	{6, 1304, "boomerang"},
+	{6, 1305, "pe-mike"},
+	{6, 1306, "re-conn-proto"},
+	{6, 1307, "pacmand"},
+	{6, 1308, "odsi"},
+	{6, 1309, "jtag-server"},
+	{6, 1310, "husky"},
+	{6, 1311, "rxmon"},
+	{6, 1312, "sti-envision"},
+	{6, 1313, "bmc-patroldb"},
+	{6, 1314, "pdps"},
+	{6, 1315, "els"},
+	{6, 1316, "exbit-escp"},
+	{6, 1317, "vrts-ipcserver"},
+	{6, 1318, "krb5gatekeeper"},
+	{6, 1319, "amx-icsp"},
+	{6, 1320, "amx-axbnet"},
+	{6, 1321, "pip"},
+	{6, 1322, "novation"},
+	{6, 1323, "brcd"},
+	{6, 1324, "delta-mcp"},
+	{6, 1325, "dx-instrument"},
+	{6, 1326, "wimsic"},
+	{6, 1327, "ultrex"},
+	{6, 1328, "ewall"},
+	{6, 1329, "netdb-export"},
+	{6, 1330, "streetperfect"},
+	{6, 1331, "intersan"},
+	{6, 1332, "pcia-rxp-b"},
+	{6, 1333, "passwrd-policy"},
+	{6, 1334, "writesrv"},
+	{6, 1335, "digital-notary"},
+	{6, 1336, "ischat"},
+	{6, 1337, "menandmice-dns"},
+	{6, 1338, "wmc-log-svc"},
+	{6, 1339, "kjtsiteserver"},
+	{6, 1340, "naap"},
+	{6, 1341, "qubes"},
+	{6, 1342, "esbroker"},
+	{6, 1343, "re101"},
+	{6, 1344, "icap"},
+	{6, 1345, "vpjp"},
+	{6, 1346, "alta-ana-lm"},
+	{6, 1347, "bbn-mmc"},
+	{6, 1348, "bbn-mmx"},
+	{6, 1349, "sbook"},
+	{6, 1350, "editbench"},
+	{6, 1351, "equationbuilder"},
+	{6, 1352, "lotusnote"},
+	{6, 1353, "relief"},
+	{6, 1354, "XSIP-network"},
+	{6, 1355, "intuitive-edge"},
+	{6, 1356, "cuillamartin"},
+	{6, 1357, "pegboard"},
+	{6, 1358, "connlcli"},
+	{6, 1359, "ftsrv"},
+	{6, 1360, "mimer"},
+	{6, 1361, "linx"},
+	{6, 1362, "timeflies"},
+	{6, 1363, "ndm-requester"},
+	{6, 1364, "ndm-server"},
+	{6, 1365, "adapt-sna"},
+	{6, 1366, "netware-csp"},
+	{6, 1367, "dcs"},
+	{6, 1368, "screencast"},
+	{6, 1369, "gv-us"},
+	{6, 1370, "us-gv"},
+	{6, 1371, "fc-cli"},
+	{6, 1372, "fc-ser"},
+	{6, 1373, "chromagrafx"},
+	{6, 1374, "molly"},
+	{6, 1375, "bytex"},
+	{6, 1376, "ibm-pps"},
+	{6, 1377, "cichlid"},
+	{6, 1378, "elan"},
+	{6, 1379, "dbreporter"},
+	{6, 1380, "telesis-licman"},
+	{6, 1381, "apple-licman"},
+	{6, 1382, "udt-os"},
+	{6, 1383, "gwha"},
+	{6, 1384, "os-licman"},
+	{6, 1385, "atex-elmd"},
+	{6, 1386, "checksum"},
+	{6, 1387, "cadsi-lm"},
+	{6, 1388, "objective-dbc"},
+	{6, 1389, "iclpv-dm"},
+	{6, 1390, "iclpv-sc"},
+	{6, 1391, "iclpv-sas"},
+	{6, 1392, "iclpv-pm"},
+	{6, 1393, "iclpv-nls"},
+	{6, 1394, "iclpv-nlc"},
+	{6, 1395, "iclpv-wsm"},
+	{6, 1396, "dvl-activemail"},
+	{6, 1397, "audio-activmail"},
+	{6, 1398, "video-activmail"},
+	{6, 1399, "cadkey-licman"},
+	{6, 1400, "cadkey-tablet"},
+	{6, 1401, "goldleaf-licman"},
+	{6, 1402, "prm-sm-np"},
+	{6, 1403, "prm-nm-np"},
+	{6, 1404, "igi-lm"},
+	{6, 1405, "ibm-res"},
+	{6, 1406, "netlabs-lm"},
+	{6, 1407, "tibet-server"},
+	{6, 1408, "sophia-lm"},
+	{6, 1409, "here-lm"},
+	{6, 1410, "hiq"},
+	{6, 1411, "af"},
+	{6, 1412, "innosys"},
+	{6, 1413, "innosys-acl"},
+	{6, 1414, "ibm-mqseries"},
+	{6, 1415, "dbstar"},
+	{6, 1416, "novell-lu6-2"},
+	{6, 1417, "timbuktu-srv1"},
+	{6, 1418, "timbuktu-srv2"},
+	{6, 1419, "timbuktu-srv3"},
+	{6, 1420, "timbuktu-srv4"},
+	{6, 1421, "gandalf-lm"},
+	{6, 1422, "autodesk-lm"},
+	{6, 1423, "essbase"},
+	{6, 1424, "hybrid"},
+	{6, 1425, "zion-lm"},
+	{6, 1426, "sais"},
+	{6, 1427, "mloadd"},
+	{6, 1428, "informatik-lm"},
+	{6, 1429, "nms"},
+	{6, 1430, "tpdu"},
+	{6, 1431, "rgtp"},
+	{6, 1432, "blueberry-lm"},
+	{6, 1433, "ms-sql-s"},
+	{6, 1434, "ms-sql-m"},
+	{6, 1435, "ibm-cics"},
+	{6, 1436, "saism"},
+	{6, 1437, "tabula"},
+	{6, 1438, "eicon-server"},
+	{6, 1439, "eicon-x25"},
+	{6, 1440, "eicon-slp"},
+	{6, 1441, "cadis-1"},
+	{6, 1442, "cadis-2"},
+	{6, 1443, "ies-lm"},
+	{6, 1444, "marcam-lm"},
+	{6, 1445, "proxima-lm"},
+	{6, 1446, "ora-lm"},
+	{6, 1447, "apri-lm"},
+	{6, 1448, "oc-lm"},
+	{6, 1449, "peport"},
+	{6, 1450, "dwf"},
+	{6, 1451, "infoman"},
+	{6, 1452, "gtegsc-lm"},
+	{6, 1453, "genie-lm"},
+	{6, 1454, "interhdl-elmd"},
+	{6, 1455, "esl-lm"},
+	{6, 1456, "dca"},
+	{6, 1457, "valisys-lm"},
+	{6, 1458, "nrcabq-lm"},
+	{6, 1459, "proshare1"},
+	{6, 1460, "proshare2"},
+	{6, 1461, "ibm-wrless-lan"},
+	{6, 1462, "world-lm"},
+	{6, 1463, "nucleus"},
+	{6, 1464, "msl-lmd"},
+	{6, 1465, "pipes"},
+	{6, 1466, "oceansoft-lm"},
+	{6, 1467, "csdmbase"},
+	{6, 1468, "csdm"},
+	{6, 1469, "aal-lm"},
+	{6, 1470, "uaiact"},
+	{6, 1471, "csdmbase"},
+	{6, 1472, "csdm"},
+	{6, 1473, "openmath"},
+	{6, 1474, "telefinder"},
+	{6, 1475, "taligent-lm"},
+	{6, 1476, "clvm-cfg"},
+	{6, 1477, "ms-sna-server"},
+	{6, 1478, "ms-sna-base"},
+	{6, 1479, "dberegister"},
+	{6, 1480, "pacerforum"},
+	{6, 1481, "airs"},
+	{6, 1482, "miteksys-lm"},
+	{6, 1483, "afs"},
+	{6, 1484, "confluent"},
+	{6, 1485, "lansource"},
+	{6, 1486, "nms-topo-serv"},
+	{6, 1487, "localinfosrvr"},
+	{6, 1488, "docstor"},
+	{6, 1489, "dmdocbroker"},
+	{6, 1490, "insitu-conf"},
+	{6, 1492, "stone-design-1"},
+	{6, 1493, "netmap-lm"},
+	{6, 1494, "ica"},
+	{6, 1495, "cvc"},
+	{6, 1496, "liberty-lm"},
+	{6, 1497, "rfx-lm"},
+	{6, 1498, "sybase-sqlany"},
+	{6, 1499, "fhc"},
+	{6, 1500, "vlsi-lm"},
+	{6, 1501, "saiscm"},
+	{6, 1502, "shivadiscovery"},
+	{6, 1503, "imtc-mcs"},
+	{6, 1504, "evb-elm"},
+	{6, 1505, "funkproxy"},
+	{6, 1506, "utcd"},
+	{6, 1507, "symplex"},
+	{6, 1508, "diagmond"},
+	{6, 1509, "robcad-lm"},
+	{6, 1510, "mvx-lm"},
+	{6, 1511, "3l-l1"},
+	{6, 1512, "wins"},
+	{6, 1513, "fujitsu-dtc"},
+	{6, 1514, "fujitsu-dtcns"},
+	{6, 1515, "ifor-protocol"},
+	{6, 1516, "vpad"},
+	{6, 1517, "vpac"},
+	{6, 1518, "vpvd"},
+	{6, 1519, "vpvc"},
+	{6, 1520, "atm-zip-office"},
+	{6, 1521, "ncube-lm"},
+	{6, 1522, "ricardo-lm"},
+	{6, 1523, "cichild-lm"},
+	{6, 1524, "ingreslock"},
+	{6, 1525, "orasrv"},
+	{6, 1526, "pdap-np"},
+	{6, 1527, "tlisrv"},
+	{6, 1528, "norp"},
+	{6, 1529, "coauthor"},
+	{6, 1530, "rap-service"},
+	{6, 1531, "rap-listen"},
+	{6, 1532, "miroconnect"},
+	{6, 1533, "virtual-places"},
+	{6, 1534, "micromuse-lm"},
+	{6, 1535, "ampr-info"},
+	{6, 1536, "ampr-inter"},
+	{6, 1537, "sdsc-lm"},
+	{6, 1538, "3ds-lm"},
+	{6, 1539, "intellistor-lm"},
+	{6, 1540, "rds"},
+	{6, 1541, "rds2"},
+	{6, 1542, "gridgen-elmd"},
+	{6, 1543, "simba-cs"},
+	{6, 1544, "aspeclmd"},
+	{6, 1545, "vistium-share"},
+	{6, 1546, "abbaccuray"},
+	{6, 1547, "laplink"},
+	{6, 1548, "axon-lm"},
+	{6, 1549, "shivahose"},
+	{6, 1550, "3m-image-lm"},
+	{6, 1551, "hecmtl-db"},
+	{6, 1552, "pciarray"},
+	{6, 1553, "sna-cs"},
+	{6, 1554, "caci-lm"},
+	{6, 1555, "livelan"},
+	{6, 1556, "veritas-pbx"},
+	{6, 1557, "arbortext-lm"},
+	{6, 1558, "xingmpeg"},
+	{6, 1559, "web2host"},
+	{6, 1560, "asci-val"},
+	{6, 1561, "facilityview"},
+	{6, 1562, "pconnectmgr"},
+	{6, 1563, "cadabra-lm"},
+	{6, 1564, "pay-per-view"},
+	{6, 1565, "winddlb"},
+	{6, 1566, "corelvideo"},
+	{6, 1567, "jlicelmd"},
+	{6, 1568, "tsspmap"},
+	{6, 1569, "ets"},
+	{6, 1570, "orbixd"},
+	{6, 1571, "rdb-dbs-disp"},
+	{6, 1572, "chip-lm"},
+	{6, 1573, "itscomm-ns"},
+	{6, 1574, "mvel-lm"},
+	{6, 1575, "oraclenames"},
+	{6, 1576, "moldflow-lm"},
+	{6, 1577, "hypercube-lm"},
+	{6, 1578, "jacobus-lm"},
+	{6, 1579, "ioc-sea-lm"},
+	{6, 1580, "tn-tl-r1"},
+	{6, 1581, "mil-2045-47001"},
+	{6, 1582, "msims"},
+	{6, 1583, "simbaexpress"},
+	{6, 1584, "tn-tl-fd2"},
+	{6, 1585, "intv"},
+	{6, 1586, "ibm-abtact"},
+	{6, 1587, "pra-elmd"},
+	{6, 1588, "triquest-lm"},
+	{6, 1589, "vqp"},
+	{6, 1590, "gemini-lm"},
+	{6, 1591, "ncpm-pm"},
+	{6, 1592, "commonspace"},
+	{6, 1593, "mainsoft-lm"},
+	{6, 1594, "sixtrak"},
+	{6, 1595, "radio"},
+	{6, 1596, "radio-sm"},
+	{6, 1597, "orbplus-iiop"},
+	{6, 1598, "picknfs"},
+	{6, 1599, "simbaservices"},
+	{6, 1600, "issd"},
+	{6, 1601, "aas"},
+	{6, 1602, "inspect"},
+	{6, 1603, "picodbc"},
+	{6, 1604, "icabrowser"},
+	{6, 1605, "slp"},
+	{6, 1606, "slm-api"},
+	{6, 1607, "stt"},
+	{6, 1608, "smart-lm"},
+	{6, 1609, "isysg-lm"},
+	{6, 1610, "taurus-wh"},
+	{6, 1611, "ill"},
+	{6, 1612, "netbill-trans"},
+	{6, 1613, "netbill-keyrep"},
+	{6, 1614, "netbill-cred"},
+	{6, 1615, "netbill-auth"},
+	{6, 1616, "netbill-prod"},
+	{6, 1617, "nimrod-agent"},
+	{6, 1618, "skytelnet"},
+	{6, 1619, "xs-openstorage"},
+	{6, 1620, "faxportwinport"},
+	{6, 1621, "softdataphone"},
+	{6, 1622, "ontime"},
+	{6, 1623, "jaleosnd"},
+	{6, 1624, "udp-sr-port"},
+	{6, 1625, "svs-omagent"},
+	{6, 1626, "shockwave"},
+	{6, 1627, "t128-gateway"},
+	{6, 1628, "lontalk-norm"},
+	{6, 1629, "lontalk-urgnt"},
+	{6, 1630, "oraclenet8cman"},
+	{6, 1631, "visitview"},
+	{6, 1632, "pammratc"},
+	{6, 1633, "pammrpc"},
+	{6, 1634, "loaprobe"},
+	{6, 1635, "edb-server1"},
+	{6, 1636, "isdc"},
+	{6, 1637, "islc"},
+	{6, 1638, "ismc"},
+	{6, 1639, "cert-initiator"},
+	{6, 1640, "cert-responder"},
+	{6, 1641, "invision"},
+	{6, 1642, "isis-am"},
+	{6, 1643, "isis-ambc"},
+	{6, 1644, "saiseh"},
+	{6, 1645, "sightline"},
+	{6, 1646, "sa-msg-port"},
+	{6, 1647, "rsap"},
+	{6, 1648, "concurrent-lm"},
+	{6, 1649, "kermit"},
+	{6, 1650, "nkd"},
+	{6, 1651, "shiva-confsrvr"},
+	{6, 1652, "xnmp"},
+	{6, 1653, "alphatech-lm"},
+	{6, 1654, "stargatealerts"},
+	{6, 1655, "dec-mbadmin"},
+	{6, 1656, "dec-mbadmin-h"},
+	{6, 1657, "fujitsu-mmpdc"},
+	{6, 1658, "sixnetudr"},
+	{6, 1659, "sg-lm"},
+	{6, 1660, "skip-mc-gikreq"},
+	{6, 1661, "netview-aix-1"},
+	{6, 1662, "netview-aix-2"},
+	{6, 1663, "netview-aix-3"},
+	{6, 1664, "netview-aix-4"},
+	{6, 1665, "netview-aix-5"},
+	{6, 1666, "netview-aix-6"},
+	{6, 1667, "netview-aix-7"},
+	{6, 1668, "netview-aix-8"},
+	{6, 1669, "netview-aix-9"},
+	{6, 1670, "netview-aix-10"},
+	{6, 1671, "netview-aix-11"},
+	{6, 1672, "netview-aix-12"},
+	{6, 1673, "proshare-mc-1"},
+	{6, 1674, "proshare-mc-2"},
+	{6, 1675, "pdp"},
+	{6, 1676, "netcomm1"},
+	{6, 1677, "groupwise"},
+	{6, 1678, "prolink"},
+	{6, 1679, "darcorp-lm"},
+	{6, 1680, "microcom-sbp"},
+	{6, 1681, "sd-elmd"},
+	{6, 1682, "lanyon-lantern"},
+	{6, 1683, "ncpm-hip"},
+	{6, 1684, "snaresecure"},
+	{6, 1685, "n2nremote"},
+	{6, 1686, "cvmon"},
+	{6, 1687, "nsjtp-ctrl"},
+	{6, 1688, "nsjtp-data"},
+	{6, 1689, "firefox"},
+	{6, 1690, "ng-umds"},
+	{6, 1691, "empire-empuma"},
+	{6, 1692, "sstsys-lm"},
+	{6, 1693, "rrirtr"},
+	{6, 1694, "rrimwm"},
+	{6, 1695, "rrilwm"},
+	{6, 1696, "rrifmm"},
+	{6, 1697, "rrisat"},
+	{6, 1698, "rsvp-encap-1"},
+	{6, 1699, "rsvp-encap-2"},
+	{6, 1700, "mps-raft"},
+	{6, 1701, "l2f"},
+	{6, 1702, "deskshare"},
+	{6, 1703, "hb-engine"},
+	{6, 1704, "bcs-broker"},
+	{6, 1705, "slingshot"},
+	{6, 1706, "jetform"},
+	{6, 1707, "vdmplay"},
+	{6, 1708, "gat-lmd"},
+	{6, 1709, "centra"},
+	{6, 1710, "impera"},
+	{6, 1711, "pptconference"},
+	{6, 1712, "registrar"},
+	{6, 1713, "conferencetalk"},
+	{6, 1714, "sesi-lm"},
+	{6, 1715, "houdini-lm"},
+	{6, 1716, "xmsg"},
+	{6, 1717, "fj-hdnet"},
+	{6, 1718, "h323gatedisc"},
+	{6, 1719, "h323gatestat"},
+	{6, 1720, "h323hostcall"},
+	{6, 1721, "caicci"},
+	{6, 1722, "hks-lm"},
+	{6, 1723, "pptp"},
+	{6, 1724, "csbphonemaster"},
+	{6, 1725, "iden-ralp"},
+	{6, 1726, "iberiagames"},
+	{6, 1727, "winddx"},
+	{6, 1728, "telindus"},
+	{6, 1729, "citynl"},
+	{6, 1730, "roketz"},
+	{6, 1731, "msiccp"},
+	{6, 1732, "proxim"},
+	{6, 1733, "siipat"},
+	{6, 1734, "cambertx-lm"},
+	{6, 1735, "privatechat"},
+	{6, 1736, "street-stream"},
+	{6, 1737, "ultimad"},
+	{6, 1738, "gamegen1"},
+	{6, 1739, "webaccess"},
+	{6, 1740, "encore"},
+	{6, 1741, "cisco-net-mgmt"},
+	{6, 1742, "3Com-nsd"},
+	{6, 1743, "cinegrfx-lm"},
+	{6, 1744, "ncpm-ft"},
+	{6, 1745, "remote-winsock"},
+	{6, 1746, "ftrapid-1"},
+	{6, 1747, "ftrapid-2"},
+	{6, 1748, "oracle-em1"},
+	{6, 1749, "aspen-services"},
+	{6, 1750, "sslp"},
+	{6, 1751, "swiftnet"},
+	{6, 1752, "lofr-lm"},
+	{6, 1753, "predatar-comms"},
+	{6, 1754, "oracle-em2"},
+	{6, 1755, "ms-streaming"},
+	{6, 1756, "capfast-lmd"},
+	{6, 1757, "cnhrp"},
+	{6, 1758, "tftp-mcast"},
+	{6, 1759, "spss-lm"},
+	{6, 1760, "www-ldap-gw"},
+	{6, 1761, "cft-0"},
+	{6, 1762, "cft-1"},
+	{6, 1763, "cft-2"},
+	{6, 1764, "cft-3"},
+	{6, 1765, "cft-4"},
+	{6, 1766, "cft-5"},
+	{6, 1767, "cft-6"},
+	{6, 1768, "cft-7"},
+	{6, 1769, "bmc-net-adm"},
+	{6, 1770, "bmc-net-svc"},
+	{6, 1771, "vaultbase"},
+	{6, 1772, "essweb-gw"},
+	{6, 1773, "kmscontrol"},
+	{6, 1774, "global-dtserv"},
+	{6, 1775, "vdab"},
+	{6, 1776, "femis"},
+	{6, 1777, "powerguardian"},
+	{6, 1778, "prodigy-intrnet"},
+	{6, 1779, "pharmasoft"},
+	{6, 1780, "dpkeyserv"},
+	{6, 1781, "answersoft-lm"},
+	{6, 1782, "hp-hcip"},
+	{6, 1784, "finle-lm"},
+	{6, 1785, "windlm"},
+	{6, 1786, "funk-logger"},
+	{6, 1787, "funk-license"},
+	{6, 1788, "psmond"},
+	{6, 1789, "hello"},
+	{6, 1790, "nmsp"},
+	{6, 1791, "ea1"},
+	{6, 1792, "ibm-dt-2"},
+	{6, 1793, "rsc-robot"},
+	{6, 1794, "cera-bcm"},
+	{6, 1795, "dpi-proxy"},
+	{6, 1796, "vocaltec-admin"},
+	{6, 1797, "uma"},
+	{6, 1798, "etp"},
+	{6, 1799, "netrisk"},
+	{6, 1800, "ansys-lm"},
+	{6, 1801, "msmq"},
+	{6, 1802, "concomp1"},
+	{6, 1803, "hp-hcip-gwy"},
+	{6, 1804, "enl"},
+	{6, 1805, "enl-name"},
+	{6, 1806, "musiconline"},
+	{6, 1807, "fhsp"},
+	{6, 1808, "oracle-vp2"},
+	{6, 1809, "oracle-vp1"},
+	{6, 1810, "jerand-lm"},
+	{6, 1811, "scientia-sdb"},
+	{6, 1812, "radius"},
+	{6, 1813, "radius-acct"},
+	{6, 1814, "tdp-suite"},
+	{6, 1815, "mmpft"},
+	{6, 1816, "harp"},
+	{6, 1817, "rkb-oscs"},
+	{6, 1818, "etftp"},
+	{6, 1819, "plato-lm"},
+	{6, 1820, "mcagent"},
+	{6, 1821, "donnyworld"},
+	{6, 1822, "es-elmd"},
+	{6, 1823, "unisys-lm"},
+	{6, 1824, "metrics-pas"},
+	{6, 1825, "direcpc-video"},
+	{6, 1826, "ardt"},
+	{6, 1827, "asi"},
+	{6, 1828, "itm-mcell-u"},
+	{6, 1829, "optika-emedia"},
+	{6, 1830, "net8-cman"},
+	{6, 1831, "myrtle"},
+	{6, 1832, "tht-treasure"},
+	{6, 1833, "udpradio"},
+	{6, 1834, "ardusuni"},
+	{6, 1835, "ardusmul"},
+	{6, 1836, "ste-smsc"},
+	{6, 1837, "csoft1"},
+	{6, 1838, "talnet"},
+	{6, 1839, "netopia-vo1"},
+	{6, 1840, "netopia-vo2"},
+	{6, 1841, "netopia-vo3"},
+	{6, 1842, "netopia-vo4"},
+	{6, 1843, "netopia-vo5"},
+	{6, 1844, "direcpc-dll"},
+	{6, 1845, "altalink"},
+	{6, 1846, "tunstall-pnc"},
+	{6, 1847, "slp-notify"},
+	{6, 1848, "fjdocdist"},
+	{6, 1849, "alpha-sms"},
+	{6, 1850, "gsi"},
+	{6, 1851, "ctcd"},
+	{6, 1852, "virtual-time"},
+	{6, 1853, "vids-avtp"},
+	{6, 1854, "buddy-draw"},
+	{6, 1855, "fiorano-rtrsvc"},
+	{6, 1856, "fiorano-msgsvc"},
+	{6, 1857, "datacaptor"},
+	{6, 1858, "privateark"},
+	{6, 1859, "gammafetchsvr"},
+	{6, 1860, "sunscalar-svc"},
+	{6, 1861, "lecroy-vicp"},
+	{6, 1862, "mysql-cm-agent"},
+	{6, 1863, "msnp"},
+	{6, 1864, "paradym-31port"},
+	{6, 1865, "entp"},
+	{6, 1866, "swrmi"},
+	{6, 1867, "udrive"},
+	{6, 1868, "viziblebrowser"},
+	{6, 1869, "transact"},
+	{6, 1870, "sunscalar-dns"},
+	{6, 1871, "canocentral0"},
+	{6, 1872, "canocentral1"},
+	{6, 1873, "fjmpjps"},
+	{6, 1874, "fjswapsnp"},
+	{6, 1875, "westell-stats"},
+	{6, 1876, "ewcappsrv"},
+	{6, 1877, "hp-webqosdb"},
+	{6, 1878, "drmsmc"},
+	{6, 1879, "nettgain-nms"},
+	{6, 1880, "vsat-control"},
+	{6, 1881, "ibm-mqseries2"},
+	{6, 1882, "ecsqdmn"},
+	{6, 1883, "mqtt"},
+	{6, 1884, "idmaps"},
+	{6, 1885, "vrtstrapserver"},
+	{6, 1886, "leoip"},
+	{6, 1887, "filex-lport"},
+	{6, 1888, "ncconfig"},
+	{6, 1889, "unify-adapter"},
+	{6, 1890, "wilkenlistener"},
+	{6, 1891, "childkey-notif"},
+	{6, 1892, "childkey-ctrl"},
+	{6, 1893, "elad"},
+	{6, 1894, "o2server-port"},
+	{6, 1896, "b-novative-ls"},
+	{6, 1897, "metaagent"},
+	{6, 1898, "cymtec-port"},
+	{6, 1899, "mc2studios"},
+	{6, 1900, "ssdp"},
+	{6, 1901, "fjicl-tep-a"},
+	{6, 1902, "fjicl-tep-b"},
+	{6, 1903, "linkname"},
+	{6, 1904, "fjicl-tep-c"},
+	{6, 1905, "sugp"},
+	{6, 1906, "tpmd"},
+	{6, 1907, "intrastar"},
+	{6, 1908, "dawn"},
+	{6, 1909, "global-wlink"},
+	{6, 1910, "ultrabac"},
+	{6, 1911, "mtp"},
+	{6, 1912, "rhp-iibp"},
+	{6, 1913, "armadp"},
+	{6, 1914, "elm-momentum"},
+	{6, 1915, "facelink"},
+	{6, 1916, "persona"},
+	{6, 1917, "noagent"},
+	{6, 1918, "can-nds"},
+	{6, 1919, "can-dch"},
+	{6, 1920, "can-ferret"},
+	{6, 1921, "noadmin"},
+	{6, 1922, "tapestry"},
+	{6, 1923, "spice"},
+	{6, 1924, "xiip"},
+	{6, 1925, "discovery-port"},
+	{6, 1926, "egs"},
+	{6, 1927, "videte-cipc"},
+	{6, 1928, "emsd-port"},
+	{6, 1929, "bandwiz-system"},
+	{6, 1930, "driveappserver"},
+	{6, 1931, "amdsched"},
+	{6, 1932, "ctt-broker"},
+	{6, 1933, "xmapi"},
+	{6, 1934, "xaapi"},
+	{6, 1935, "macromedia-fcs"},
+	{6, 1936, "jetcmeserver"},
+	{6, 1937, "jwserver"},
+	{6, 1938, "jwclient"},
+	{6, 1939, "jvserver"},
+	{6, 1940, "jvclient"},
+	{6, 1941, "dic-aida"},
+	{6, 1942, "res"},
+	{6, 1943, "beeyond-media"},
+	{6, 1944, "close-combat"},
+	{6, 1945, "dialogic-elmd"},
+	{6, 1946, "tekpls"},
+	{6, 1947, "sentinelsrm"},
+	{6, 1948, "eye2eye"},
+	{6, 1949, "ismaeasdaqlive"},
+	{6, 1950, "ismaeasdaqtest"},
+	{6, 1951, "bcs-lmserver"},
+	{6, 1952, "mpnjsc"},
+	{6, 1953, "rapidbase"},
+	{6, 1954, "abr-api"},
+	{6, 1955, "abr-secure"},
+	{6, 1956, "vrtl-vmf-ds"},
+	{6, 1957, "unix-status"},
+	{6, 1958, "dxadmind"},
+	{6, 1959, "simp-all"},
+	{6, 1960, "nasmanager"},
+	{6, 1961, "bts-appserver"},
+	{6, 1962, "biap-mp"},
+	{6, 1963, "webmachine"},
+	{6, 1964, "solid-e-engine"},
+	{6, 1965, "tivoli-npm"},
+	{6, 1966, "slush"},
+	{6, 1967, "sns-quote"},
+	{6, 1968, "lipsinc"},
+	{6, 1969, "lipsinc1"},
+	{6, 1970, "netop-rc"},
+	{6, 1971, "netop-school"},
+	{6, 1972, "intersys-cache"},
+	{6, 1973, "dlsrap"},
+	{6, 1974, "drp"},
+	{6, 1975, "tcoflashagent"},
+	{6, 1976, "tcoregagent"},
+	{6, 1977, "tcoaddressbook"},
+	{6, 1978, "unisql"},
+	{6, 1979, "unisql-java"},
+	{6, 1980, "pearldoc-xact"},
+	{6, 1981, "p2pq"},
+	{6, 1982, "estamp"},
+	{6, 1983, "lhtp"},
+	{6, 1984, "bb"},
+	{6, 1985, "hsrp"},
+	{6, 1986, "licensedaemon"},
+	{6, 1987, "tr-rsrb-p1"},
+	{6, 1988, "tr-rsrb-p2"},
+	{6, 1989, "tr-rsrb-p3"},
+	{6, 1990, "stun-p1"},
+	{6, 1991, "stun-p2"},
+	{6, 1992, "stun-p3"},
+	{6, 1993, "snmp-tcp-port"},
+	{6, 1994, "stun-port"},
+	{6, 1995, "perf-port"},
+	{6, 1996, "tr-rsrb-port"},
+	{6, 1997, "gdp-port"},
+	{6, 1998, "x25-svc-port"},
+	{6, 1999, "tcp-id-port"},
+	{6, 2000, "cisco-sccp"},
+	{6, 2001, "dc"},
+	{6, 2002, "globe"},
+	{6, 2003, "brutus"},
+	{6, 2004, "mailbox"},
+	{6, 2005, "berknet"},
+	{6, 2006, "invokator"},
+	{6, 2007, "dectalk"},
+	{6, 2008, "conf"},
+	{6, 2009, "news"},
+	{6, 2010, "search"},
+	{6, 2011, "raid-cc"},
+	{6, 2012, "ttyinfo"},
+	{6, 2013, "raid-am"},
+	{6, 2014, "troff"},
+	{6, 2015, "cypress"},
+	{6, 2016, "bootserver"},
+	{6, 2017, "cypress-stat"},
+	{6, 2018, "terminaldb"},
+	{6, 2019, "whosockami"},
+	{6, 2020, "xinupageserver"},
+	{6, 2021, "servexec"},
+	{6, 2022, "down"},
+	{6, 2023, "xinuexpansion3"},
+	{6, 2024, "xinuexpansion4"},
+	{6, 2025, "ellpack"},
+	{6, 2026, "scrabble"},
+	{6, 2027, "shadowserver"},
+	{6, 2028, "submitserver"},
+	{6, 2029, "hsrpv6"},
+	{6, 2030, "device2"},
+	{6, 2031, "mobrien-chat"},
+	{6, 2032, "blackboard"},
+	{6, 2033, "glogger"},
+	{6, 2034, "scoremgr"},
+	{6, 2035, "imsldoc"},
+	{6, 2036, "e-dpnet"},
+	{6, 2037, "applus"},
+	{6, 2038, "objectmanager"},
+	{6, 2039, "prizma"},
+	{6, 2040, "lam"},
+	{6, 2041, "interbase"},
+	{6, 2042, "isis"},
+	{6, 2043, "isis-bcast"},
+	{6, 2044, "rimsl"},
+	{6, 2045, "cdfunc"},
+	{6, 2046, "sdfunc"},
+	{6, 2047, "dls"},
+	{6, 2048, "dls-monitor"},
+	{6, 2049, "shilp"},
+	{6, 2050, "av-emb-config"},
+	{6, 2051, "epnsdp"},
+	{6, 2052, "clearvisn"},
+	{6, 2053, "lot105-ds-upd"},
+	{6, 2054, "weblogin"},
+	{6, 2055, "iop"},
+	{6, 2056, "omnisky"},
+	{6, 2057, "rich-cp"},
+	{6, 2058, "newwavesearch"},
+	{6, 2059, "bmc-messaging"},
+	{6, 2060, "teleniumdaemon"},
+	{6, 2061, "netmount"},
+	{6, 2062, "icg-swp"},
+	{6, 2063, "icg-bridge"},
+	{6, 2064, "icg-iprelay"},
+	{6, 2065, "dlsrpn"},
+	{6, 2066, "aura"},
+	{6, 2067, "dlswpn"},
+	{6, 2068, "avauthsrvprtcl"},
+	{6, 2069, "event-port"},
+	{6, 2070, "ah-esp-encap"},
+	{6, 2071, "acp-port"},
+	{6, 2072, "msync"},
+	{6, 2073, "gxs-data-port"},
+	{6, 2074, "vrtl-vmf-sa"},
+	{6, 2075, "newlixengine"},
+	{6, 2076, "newlixconfig"},
+	{6, 2077, "tsrmagt"},
+	{6, 2078, "tpcsrvr"},
+	{6, 2079, "idware-router"},
+	{6, 2080, "autodesk-nlm"},
+	{6, 2081, "kme-trap-port"},
+	{6, 2082, "infowave"},
+	{6, 2083, "radsec"},
+	{6, 2084, "sunclustergeo"},
+	{6, 2085, "ada-cip"},
+	{6, 2086, "gnunet"},
+	{6, 2087, "eli"},
+	{6, 2088, "ip-blf"},
+	{6, 2089, "sep"},
+	{6, 2090, "lrp"},
+	{6, 2091, "prp"},
+	{6, 2092, "descent3"},
+	{6, 2093, "nbx-cc"},
+	{6, 2094, "nbx-au"},
+	{6, 2095, "nbx-ser"},
+	{6, 2096, "nbx-dir"},
+	{6, 2097, "jetformpreview"},
+	{6, 2098, "dialog-port"},
+	{6, 2099, "h2250-annex-g"},
+	{6, 2100, "amiganetfs"},
+	{6, 2101, "rtcm-sc104"},
+	{6, 2102, "zephyr-srv"},
+	{6, 2103, "zephyr-clt"},
+	{6, 2104, "zephyr-hm"},
+	{6, 2105, "minipay"},
+	{6, 2106, "mzap"},
+	{6, 2107, "bintec-admin"},
+	{6, 2108, "comcam"},
+	{6, 2109, "ergolight"},
+	{6, 2110, "umsp"},
+	{6, 2111, "dsatp"},
+	{6, 2112, "idonix-metanet"},
+	{6, 2113, "hsl-storm"},
+	{6, 2114, "ariascribe"},
+	{6, 2115, "kdm"},
+	{6, 2116, "ccowcmr"},
+	{6, 2117, "mentaclient"},
+	{6, 2118, "mentaserver"},
+	{6, 2119, "gsigatekeeper"},
+	{6, 2120, "qencp"},
+	{6, 2121, "scientia-ssdb"},
+	{6, 2122, "caupc-remote"},
+	{6, 2123, "gtp-control"},
+	{6, 2124, "elatelink"},
+	{6, 2125, "lockstep"},
+	{6, 2126, "pktcable-cops"},
+	{6, 2127, "index-pc-wb"},
+	{6, 2128, "net-steward"},
+	{6, 2129, "cs-live"},
+	{6, 2130, "xds"},
+	{6, 2131, "avantageb2b"},
+	{6, 2132, "solera-epmap"},
+	{6, 2133, "zymed-zpp"},
+	{6, 2134, "avenue"},
+	{6, 2135, "gris"},
+	{6, 2136, "appworxsrv"},
+	{6, 2137, "connect"},
+	{6, 2138, "unbind-cluster"},
+	{6, 2139, "ias-auth"},
+	{6, 2140, "ias-reg"},
+	{6, 2141, "ias-admind"},
+	{6, 2142, "tdmoip"},
+	{6, 2143, "lv-jc"},
+	{6, 2144, "lv-ffx"},
+	{6, 2145, "lv-pici"},
+	{6, 2146, "lv-not"},
+	{6, 2147, "lv-auth"},
+	{6, 2148, "veritas-ucl"},
+	{6, 2149, "acptsys"},
+	{6, 2150, "dynamic3d"},
+	{6, 2151, "docent"},
+	{6, 2152, "gtp-user"},
+	{6, 2153, "ctlptc"},
+	{6, 2154, "stdptc"},
+	{6, 2155, "brdptc"},
+	{6, 2156, "trp"},
+	{6, 2157, "xnds"},
+	{6, 2158, "touchnetplus"},
+	{6, 2159, "gdbremote"},
+	{6, 2160, "apc-2160"},
+	{6, 2161, "apc-2161"},
+	{6, 2162, "navisphere"},
+	{6, 2163, "navisphere-sec"},
+	{6, 2164, "ddns-v3"},
+	{6, 2165, "x-bone-api"},
+	{6, 2166, "iwserver"},
+	{6, 2167, "raw-serial"},
+	{6, 2168, "easy-soft-mux"},
+	{6, 2169, "brain"},
+	{6, 2170, "eyetv"},
+	{6, 2171, "msfw-storage"},
+	{6, 2172, "msfw-s-storage"},
+	{6, 2173, "msfw-replica"},
+	{6, 2174, "msfw-array"},
+	{6, 2175, "airsync"},
+	{6, 2176, "rapi"},
+	{6, 2177, "qwave"},
+	{6, 2178, "bitspeer"},
+	{6, 2179, "vmrdp"},
+	{6, 2180, "mc-gt-srv"},
+	{6, 2181, "eforward"},
+	{6, 2182, "cgn-stat"},
+	{6, 2183, "cgn-config"},
+	{6, 2184, "nvd"},
+	{6, 2185, "onbase-dds"},
+	{6, 2186, "gtaua"},
+	{6, 2187, "ssmc"},
+	{6, 2188, "radware-rpm"},
+	{6, 2189, "radware-rpm-s"},
+	{6, 2190, "tivoconnect"},
+	{6, 2191, "tvbus"},
+	{6, 2192, "asdis"},
+	{6, 2193, "drwcs"},
+	{6, 2197, "mnp-exchange"},
+	{6, 2198, "onehome-remote"},
+	{6, 2199, "onehome-help"},
+	{6, 2201, "ats"},
+	{6, 2202, "imtc-map"},
+	{6, 2203, "b2-runtime"},
+	{6, 2204, "b2-license"},
+	{6, 2205, "jps"},
+	{6, 2206, "hpocbus"},
+	{6, 2207, "hpssd"},
+	{6, 2208, "hpiod"},
+	{6, 2209, "rimf-ps"},
+	{6, 2210, "noaaport"},
+	{6, 2211, "emwin"},
+	{6, 2212, "leecoposserver"},
+	{6, 2213, "kali"},
+	{6, 2214, "rpi"},
+	{6, 2215, "ipcore"},
+	{6, 2216, "vtu-comms"},
+	{6, 2217, "gotodevice"},
+	{6, 2218, "bounzza"},
+	{6, 2219, "netiq-ncap"},
+	{6, 2220, "netiq"},
+	{6, 2221, "ethernet-ip-s"},
+	{6, 2222, "EtherNet-IP-1"},
+	{6, 2223, "rockwell-csp2"},
+	{6, 2224, "efi-mg"},
+	{6, 2225, "rcip-itu"},
+	{6, 2226, "di-drm"},
+	{6, 2227, "di-msg"},
+	{6, 2228, "ehome-ms"},
+	{6, 2229, "datalens"},
+	{6, 2230, "queueadm"},
+	{6, 2231, "wimaxasncp"},
+	{6, 2232, "ivs-video"},
+	{6, 2233, "infocrypt"},
+	{6, 2234, "directplay"},
+	{6, 2235, "sercomm-wlink"},
+	{6, 2236, "nani"},
+	{6, 2237, "optech-port1-lm"},
+	{6, 2238, "aviva-sna"},
+	{6, 2239, "imagequery"},
+	{6, 2240, "recipe"},
+	{6, 2241, "ivsd"},
+	{6, 2242, "foliocorp"},
+	{6, 2243, "magicom"},
+	{6, 2244, "nmsserver"},
+	{6, 2245, "hao"},
+	{6, 2246, "pc-mta-addrmap"},
+	{6, 2247, "antidotemgrsvr"},
+	{6, 2248, "ums"},
+	{6, 2249, "rfmp"},
+	{6, 2250, "remote-collab"},
+	{6, 2251, "dif-port"},
+	{6, 2252, "njenet-ssl"},
+	{6, 2253, "dtv-chan-req"},
+	{6, 2254, "seispoc"},
+	{6, 2255, "vrtp"},
+	{6, 2256, "pcc-mfp"},
+	{6, 2257, "simple-tx-rx"},
+	{6, 2258, "rcts"},
+	{6, 2259, "bid-serv"},
+	{6, 2260, "apc-2260"},
+	{6, 2261, "comotionmaster"},
+	{6, 2262, "comotionback"},
+	{6, 2263, "ecwcfg"},
+	{6, 2264, "apx500api-1"},
+	{6, 2265, "apx500api-2"},
+	{6, 2266, "mfserver"},
+	{6, 2267, "ontobroker"},
+	{6, 2268, "amt"},
+	{6, 2269, "mikey"},
+	{6, 2270, "starschool"},
+	{6, 2271, "mmcals"},
+	{6, 2272, "mmcal"},
+	{6, 2273, "mysql-im"},
+	{6, 2274, "pcttunnell"},
+	{6, 2275, "ibridge-data"},
+	{6, 2276, "ibridge-mgmt"},
+	{6, 2277, "bluectrlproxy"},
+	{6, 2278, "s3db"},
+	{6, 2279, "xmquery"},
+	{6, 2280, "lnvpoller"},
+	{6, 2281, "lnvconsole"},
+	{6, 2282, "lnvalarm"},
+	{6, 2283, "lnvstatus"},
+	{6, 2284, "lnvmaps"},
+	{6, 2285, "lnvmailmon"},
+	{6, 2286, "nas-metering"},
+	{6, 2287, "dna"},
+	{6, 2288, "netml"},
+	{6, 2289, "dict-lookup"},
+	{6, 2290, "sonus-logging"},
+	{6, 2291, "eapsp"},
+	{6, 2292, "mib-streaming"},
+	{6, 2293, "npdbgmngr"},
+	{6, 2294, "konshus-lm"},
+	{6, 2295, "advant-lm"},
+	{6, 2296, "theta-lm"},
+	{6, 2297, "d2k-datamover1"},
+	{6, 2298, "d2k-datamover2"},
+	{6, 2299, "pc-telecommute"},
+	{6, 2300, "cvmmon"},
+	{6, 2301, "cpq-wbem"},
+	{6, 2302, "binderysupport"},
+	{6, 2303, "proxy-gateway"},
+	{6, 2304, "attachmate-uts"},
+	{6, 2305, "mt-scaleserver"},
+	{6, 2306, "tappi-boxnet"},
+	{6, 2307, "pehelp"},
+	{6, 2308, "sdhelp"},
+	{6, 2309, "sdserver"},
+	{6, 2310, "sdclient"},
+	{6, 2311, "messageservice"},
+	{6, 2312, "wanscaler"},
+	{6, 2313, "iapp"},
+	{6, 2314, "cr-websystems"},
+	{6, 2315, "precise-sft"},
+	{6, 2316, "sent-lm"},
+	{6, 2317, "attachmate-g32"},
+	{6, 2318, "cadencecontrol"},
+	{6, 2319, "infolibria"},
+	{6, 2320, "siebel-ns"},
+	{6, 2321, "rdlap"},
+	{6, 2322, "ofsd"},
+	{6, 2323, "3d-nfsd"},
+	{6, 2324, "cosmocall"},
+	{6, 2325, "ansysli"},
+	{6, 2326, "idcp"},
+	{6, 2327, "xingcsm"},
+	{6, 2328, "netrix-sftm"},
+	{6, 2329, "nvd"},
+	{6, 2330, "tscchat"},
+	{6, 2331, "agentview"},
+	{6, 2332, "rcc-host"},
+	{6, 2333, "snapp"},
+	{6, 2334, "ace-client"},
+	{6, 2335, "ace-proxy"},
+	{6, 2336, "appleugcontrol"},
+	{6, 2337, "ideesrv"},
+	{6, 2338, "norton-lambert"},
+	{6, 2339, "3com-webview"},
+	{6, 2340, "wrs-registry"},
+	{6, 2341, "xiostatus"},
+	{6, 2342, "manage-exec"},
+	{6, 2343, "nati-logos"},
+	{6, 2344, "fcmsys"},
+	{6, 2345, "dbm"},
+	{6, 2346, "redstorm-join"},
+	{6, 2347, "redstorm-find"},
+	{6, 2348, "redstorm-info"},
+	{6, 2349, "redstorm-diag"},
+	{6, 2350, "psbserver"},
+	{6, 2351, "psrserver"},
+	{6, 2352, "pslserver"},
+	{6, 2353, "pspserver"},
+	{6, 2354, "psprserver"},
+	{6, 2355, "psdbserver"},
+	{6, 2356, "gxtelmd"},
+	{6, 2357, "unihub-server"},
+	{6, 2358, "futrix"},
+	{6, 2359, "flukeserver"},
+	{6, 2360, "nexstorindltd"},
+	{6, 2361, "tl1"},
+	{6, 2362, "digiman"},
+	{6, 2363, "mediacntrlnfsd"},
+	{6, 2364, "oi-2000"},
+	{6, 2365, "dbref"},
+	{6, 2366, "qip-login"},
+	{6, 2367, "service-ctrl"},
+	{6, 2368, "opentable"},
+	{6, 2369, "bif-p2p"},
+	{6, 2370, "l3-hbmon"},
+	{6, 2371, "rda"},
+	{6, 2372, "lanmessenger"},
+	{6, 2373, "remographlm"},
+	{6, 2374, "hydra"},
+	{6, 2375, "docker"},
+	{6, 2376, "docker-s"},
+	{6, 2377, "swarm"},
+	{6, 2379, "etcd-client"},
+	{6, 2380, "etcd-server"},
+	{6, 2381, "compaq-https"},
+	{6, 2382, "ms-olap3"},
+	{6, 2383, "ms-olap4"},
+	{6, 2384, "sd-request"},
+	{6, 2385, "sd-data"},
+	{6, 2386, "virtualtape"},
+	{6, 2387, "vsamredirector"},
+	{6, 2388, "mynahautostart"},
+	{6, 2389, "ovsessionmgr"},
+	{6, 2390, "rsmtp"},
+	{6, 2391, "3com-net-mgmt"},
+	{6, 2392, "tacticalauth"},
+	{6, 2393, "ms-olap1"},
+	{6, 2394, "ms-olap2"},
+	{6, 2395, "lan900-remote"},
+	{6, 2396, "wusage"},
+	{6, 2397, "ncl"},
+	{6, 2398, "orbiter"},
+	{6, 2399, "fmpro-fdal"},
+	{6, 2400, "opequus-server"},
+	{6, 2401, "cvspserver"},
+	{6, 2402, "taskmaster2000"},
+	{6, 2403, "taskmaster2000"},
+	{6, 2404, "iec-104"},
+	{6, 2405, "trc-netpoll"},
+	{6, 2406, "jediserver"},
+	{6, 2407, "orion"},
+	{6, 2408, "railgun-webaccl"},
+	{6, 2409, "sns-protocol"},
+	{6, 2410, "vrts-registry"},
+	{6, 2411, "netwave-ap-mgmt"},
+	{6, 2412, "cdn"},
+	{6, 2413, "orion-rmi-reg"},
+	{6, 2414, "beeyond"},
+	{6, 2415, "codima-rtp"},
+	{6, 2416, "rmtserver"},
+	{6, 2417, "composit-server"},
+	{6, 2418, "cas"},
+	{6, 2419, "attachmate-s2s"},
+	{6, 2420, "dslremote-mgmt"},
+	{6, 2421, "g-talk"},
+	{6, 2422, "crmsbits"},
+	{6, 2423, "rnrp"},
+	{6, 2424, "kofax-svr"},
+	{6, 2425, "fjitsuappmgr"},
+	{6, 2426, "vcmp"},
+	{6, 2427, "mgcp-gateway"},
+	{6, 2428, "ott"},
+	{6, 2429, "ft-role"},
+	{6, 2430, "venus"},
+	{6, 2431, "venus-se"},
+	{6, 2432, "codasrv"},
+	{6, 2433, "codasrv-se"},
+	{6, 2434, "pxc-epmap"},
+	{6, 2435, "optilogic"},
+	{6, 2436, "topx"},
+	{6, 2437, "unicontrol"},
+	{6, 2438, "msp"},
+	{6, 2439, "sybasedbsynch"},
+	{6, 2440, "spearway"},
+	{6, 2441, "pvsw-inet"},
+	{6, 2442, "netangel"},
+	{6, 2443, "powerclientcsf"},
+	{6, 2444, "btpp2sectrans"},
+	{6, 2445, "dtn1"},
+	{6, 2446, "bues-service"},
+	{6, 2447, "ovwdb"},
+	{6, 2448, "hpppssvr"},
+	{6, 2449, "ratl"},
+	{6, 2450, "netadmin"},
+	{6, 2451, "netchat"},
+	{6, 2452, "snifferclient"},
+	{6, 2453, "madge-ltd"},
+	{6, 2454, "indx-dds"},
+	{6, 2455, "wago-io-system"},
+	{6, 2456, "altav-remmgt"},
+	{6, 2457, "rapido-ip"},
+	{6, 2458, "griffin"},
+	{6, 2459, "xrpl"},
+	{6, 2460, "ms-theater"},
+	{6, 2461, "qadmifoper"},
+	{6, 2462, "qadmifevent"},
+	{6, 2463, "lsi-raid-mgmt"},
+	{6, 2464, "direcpc-si"},
+	{6, 2465, "lbm"},
+	{6, 2466, "lbf"},
+	{6, 2467, "high-criteria"},
+	{6, 2468, "qip-msgd"},
+	{6, 2469, "mti-tcs-comm"},
+	{6, 2470, "taskman-port"},
+	{6, 2471, "seaodbc"},
+	{6, 2472, "c3"},
+	{6, 2473, "aker-cdp"},
+	{6, 2474, "vitalanalysis"},
+	{6, 2475, "ace-server"},
+	{6, 2476, "ace-svr-prop"},
+	{6, 2477, "ssm-cvs"},
+	{6, 2478, "ssm-cssps"},
+	{6, 2479, "ssm-els"},
+	{6, 2480, "powerexchange"},
+	{6, 2481, "giop"},
+	{6, 2482, "giop-ssl"},
+	{6, 2483, "ttc"},
+	{6, 2484, "ttc-ssl"},
+	{6, 2485, "netobjects1"},
+	{6, 2486, "netobjects2"},
+	{6, 2487, "pns"},
+	{6, 2488, "moy-corp"},
+	{6, 2489, "tsilb"},
+	{6, 2490, "qip-qdhcp"},
+	{6, 2491, "conclave-cpp"},
+	{6, 2492, "groove"},
+	{6, 2493, "talarian-mqs"},
+	{6, 2494, "bmc-ar"},
+	{6, 2495, "fast-rem-serv"},
+	{6, 2496, "dirgis"},
+	{6, 2497, "quaddb"},
+	{6, 2498, "odn-castraq"},
+	{6, 2499, "unicontrol"},
+	{6, 2500, "rtsserv"},
+	{6, 2501, "rtsclient"},
+	{6, 2502, "kentrox-prot"},
+	{6, 2503, "nms-dpnss"},
+	{6, 2504, "wlbs"},
+	{6, 2505, "ppcontrol"},
+	{6, 2506, "jbroker"},
+	{6, 2507, "spock"},
+	{6, 2508, "jdatastore"},
+	{6, 2509, "fjmpss"},
+	{6, 2510, "fjappmgrbulk"},
+	{6, 2511, "metastorm"},
+	{6, 2512, "citrixima"},
+	{6, 2513, "citrixadmin"},
+	{6, 2514, "facsys-ntp"},
+	{6, 2515, "facsys-router"},
+	{6, 2516, "maincontrol"},
+	{6, 2517, "call-sig-trans"},
+	{6, 2518, "willy"},
+	{6, 2519, "globmsgsvc"},
+	{6, 2520, "pvsw"},
+	{6, 2521, "adaptecmgr"},
+	{6, 2522, "windb"},
+	{6, 2523, "qke-llc-v3"},
+	{6, 2524, "optiwave-lm"},
+	{6, 2525, "ms-v-worlds"},
+	{6, 2526, "ema-sent-lm"},
+	{6, 2527, "iqserver"},
+	{6, 2528, "ncr-ccl"},
+	{6, 2529, "utsftp"},
+	{6, 2530, "vrcommerce"},
+	{6, 2531, "ito-e-gui"},
+	{6, 2532, "ovtopmd"},
+	{6, 2533, "snifferserver"},
+	{6, 2534, "combox-web-acc"},
+	{6, 2535, "madcap"},
+	{6, 2536, "btpp2audctr1"},
+	{6, 2537, "upgrade"},
+	{6, 2538, "vnwk-prapi"},
+	{6, 2539, "vsiadmin"},
+	{6, 2540, "lonworks"},
+	{6, 2541, "lonworks2"},
+	{6, 2542, "udrawgraph"},
+	{6, 2543, "reftek"},
+	{6, 2544, "novell-zen"},
+	{6, 2545, "sis-emt"},
+	{6, 2546, "vytalvaultbrtp"},
+	{6, 2547, "vytalvaultvsmp"},
+	{6, 2548, "vytalvaultpipe"},
+	{6, 2549, "ipass"},
+	{6, 2550, "ads"},
+	{6, 2551, "isg-uda-server"},
+	{6, 2552, "call-logging"},
+	{6, 2553, "efidiningport"},
+	{6, 2554, "vcnet-link-v10"},
+	{6, 2555, "compaq-wcp"},
+	{6, 2556, "nicetec-nmsvc"},
+	{6, 2557, "nicetec-mgmt"},
+	{6, 2558, "pclemultimedia"},
+	{6, 2559, "lstp"},
+	{6, 2560, "labrat"},
+	{6, 2561, "mosaixcc"},
+	{6, 2562, "delibo"},
+	{6, 2563, "cti-redwood"},
+	{6, 2564, "hp-3000-telnet"},
+	{6, 2565, "coord-svr"},
+	{6, 2566, "pcs-pcw"},
+	{6, 2567, "clp"},
+	{6, 2568, "spamtrap"},
+	{6, 2569, "sonuscallsig"},
+	{6, 2570, "hs-port"},
+	{6, 2571, "cecsvc"},
+	{6, 2572, "ibp"},
+	{6, 2573, "trustestablish"},
+	{6, 2574, "blockade-bpsp"},
+	{6, 2575, "hl7"},
+	{6, 2576, "tclprodebugger"},
+	{6, 2577, "scipticslsrvr"},
+	{6, 2578, "rvs-isdn-dcp"},
+	{6, 2579, "mpfoncl"},
+	{6, 2580, "tributary"},
+	{6, 2581, "argis-te"},
+	{6, 2582, "argis-ds"},
+	{6, 2583, "mon"},
+	{6, 2584, "cyaserv"},
+	{6, 2585, "netx-server"},
+	{6, 2586, "netx-agent"},
+	{6, 2587, "masc"},
+	{6, 2588, "privilege"},
+	{6, 2589, "quartus-tcl"},
+	{6, 2590, "idotdist"},
+	{6, 2591, "maytagshuffle"},
+	{6, 2592, "netrek"},
+	{6, 2593, "mns-mail"},
+	{6, 2594, "dts"},
+	{6, 2595, "worldfusion1"},
+	{6, 2596, "worldfusion2"},
+	{6, 2597, "homesteadglory"},
+	{6, 2598, "citriximaclient"},
+	{6, 2599, "snapd"},
+	{6, 2600, "hpstgmgr"},
+	{6, 2601, "discp-client"},
+	{6, 2602, "discp-server"},
+	{6, 2603, "servicemeter"},
+	{6, 2604, "nsc-ccs"},
+	{6, 2605, "nsc-posa"},
+	{6, 2606, "netmon"},
+	{6, 2607, "connection"},
+	{6, 2608, "wag-service"},
+	{6, 2609, "system-monitor"},
+	{6, 2610, "versa-tek"},
+	{6, 2611, "lionhead"},
+	{6, 2612, "qpasa-agent"},
+	{6, 2613, "smntubootstrap"},
+	{6, 2614, "neveroffline"},
+	{6, 2615, "firepower"},
+	{6, 2616, "appswitch-emp"},
+	{6, 2617, "cmadmin"},
+	{6, 2618, "priority-e-com"},
+	{6, 2619, "bruce"},
+	{6, 2620, "lpsrecommender"},
+	{6, 2621, "miles-apart"},
+	{6, 2622, "metricadbc"},
+	{6, 2623, "lmdp"},
+	{6, 2624, "aria"},
+	{6, 2625, "blwnkl-port"},
+	{6, 2626, "gbjd816"},
+	{6, 2627, "moshebeeri"},
+	{6, 2628, "dict"},
+	{6, 2629, "sitaraserver"},
+	{6, 2630, "sitaramgmt"},
+	{6, 2631, "sitaradir"},
+	{6, 2632, "irdg-post"},
+	{6, 2633, "interintelli"},
+	{6, 2634, "pk-electronics"},
+	{6, 2635, "backburner"},
+	{6, 2636, "solve"},
+	{6, 2637, "imdocsvc"},
+	{6, 2638, "sybaseanywhere"},
+	{6, 2639, "aminet"},
+	{6, 2640, "ami-control"},
+	{6, 2641, "hdl-srv"},
+	{6, 2642, "tragic"},
+	{6, 2643, "gte-samp"},
+	{6, 2644, "travsoft-ipx-t"},
+	{6, 2645, "novell-ipx-cmd"},
+	{6, 2646, "and-lm"},
+	{6, 2647, "syncserver"},
+	{6, 2648, "upsnotifyprot"},
+	{6, 2649, "vpsipport"},
+	{6, 2650, "eristwoguns"},
+	{6, 2651, "ebinsite"},
+	{6, 2652, "interpathpanel"},
+	{6, 2653, "sonus"},
+	{6, 2654, "corel-vncadmin"},
+	{6, 2655, "unglue"},
+	{6, 2656, "kana"},
+	{6, 2657, "sns-dispatcher"},
+	{6, 2658, "sns-admin"},
+	{6, 2659, "sns-query"},
+	{6, 2660, "gcmonitor"},
+	{6, 2661, "olhost"},
+	{6, 2662, "bintec-capi"},
+	{6, 2663, "bintec-tapi"},
+	{6, 2664, "patrol-mq-gm"},
+	{6, 2665, "patrol-mq-nm"},
+	{6, 2666, "extensis"},
+	{6, 2667, "alarm-clock-s"},
+	{6, 2668, "alarm-clock-c"},
+	{6, 2669, "toad"},
+	{6, 2670, "tve-announce"},
+	{6, 2671, "newlixreg"},
+	{6, 2672, "nhserver"},
+	{6, 2673, "firstcall42"},
+	{6, 2674, "ewnn"},
+	{6, 2675, "ttc-etap"},
+	{6, 2676, "simslink"},
+	{6, 2677, "gadgetgate1way"},
+	{6, 2678, "gadgetgate2way"},
+	{6, 2679, "syncserverssl"},
+	{6, 2680, "pxc-sapxom"},
+	{6, 2681, "mpnjsomb"},
+	{6, 2683, "ncdloadbalance"},
+	{6, 2684, "mpnjsosv"},
+	{6, 2685, "mpnjsocl"},
+	{6, 2686, "mpnjsomg"},
+	{6, 2687, "pq-lic-mgmt"},
+	{6, 2688, "md-cg-http"},
+	{6, 2689, "fastlynx"},
+	{6, 2690, "hp-nnm-data"},
+	{6, 2691, "itinternet"},
+	{6, 2692, "admins-lms"},
+	{6, 2694, "pwrsevent"},
+	{6, 2695, "vspread"},
+	{6, 2696, "unifyadmin"},
+	{6, 2697, "oce-snmp-trap"},
+	{6, 2698, "mck-ivpip"},
+	{6, 2699, "csoft-plusclnt"},
+	{6, 2700, "tqdata"},
+	{6, 2701, "sms-rcinfo"},
+	{6, 2702, "sms-xfer"},
+	{6, 2703, "sms-chat"},
+	{6, 2704, "sms-remctrl"},
+	{6, 2705, "sds-admin"},
+	{6, 2706, "ncdmirroring"},
+	{6, 2707, "emcsymapiport"},
+	{6, 2708, "banyan-net"},
+	{6, 2709, "supermon"},
+	{6, 2710, "sso-service"},
+	{6, 2711, "sso-control"},
+	{6, 2712, "aocp"},
+	{6, 2713, "raventbs"},
+	{6, 2714, "raventdm"},
+	{6, 2715, "hpstgmgr2"},
+	{6, 2716, "inova-ip-disco"},
+	{6, 2717, "pn-requester"},
+	{6, 2718, "pn-requester2"},
+	{6, 2719, "scan-change"},
+	{6, 2720, "wkars"},
+	{6, 2721, "smart-diagnose"},
+	{6, 2722, "proactivesrvr"},
+	{6, 2723, "watchdog-nt"},
+	{6, 2724, "qotps"},
+	{6, 2725, "msolap-ptp2"},
+	{6, 2726, "tams"},
+	{6, 2727, "mgcp-callagent"},
+	{6, 2728, "sqdr"},
+	{6, 2729, "tcim-control"},
+	{6, 2730, "nec-raidplus"},
+	{6, 2731, "fyre-messanger"},
+	{6, 2732, "g5m"},
+	{6, 2733, "signet-ctf"},
+	{6, 2734, "ccs-software"},
+	{6, 2735, "netiq-mc"},
+	{6, 2736, "radwiz-nms-srv"},
+	{6, 2737, "srp-feedback"},
+	{6, 2738, "ndl-tcp-ois-gw"},
+	{6, 2739, "tn-timing"},
+	{6, 2740, "alarm"},
+	{6, 2741, "tsb"},
+	{6, 2742, "tsb2"},
+	{6, 2743, "murx"},
+	{6, 2744, "honyaku"},
+	{6, 2745, "urbisnet"},
+	{6, 2746, "cpudpencap"},
+	{6, 2747, "fjippol-swrly"},
+	{6, 2748, "fjippol-polsvr"},
+	{6, 2749, "fjippol-cnsl"},
+	{6, 2750, "fjippol-port1"},
+	{6, 2751, "fjippol-port2"},
+	{6, 2752, "rsisysaccess"},
+	{6, 2753, "de-spot"},
+	{6, 2754, "apollo-cc"},
+	{6, 2755, "expresspay"},
+	{6, 2756, "simplement-tie"},
+	{6, 2757, "cnrp"},
+	{6, 2758, "apollo-status"},
+	{6, 2759, "apollo-gms"},
+	{6, 2760, "sabams"},
+	{6, 2761, "dicom-iscl"},
+	{6, 2762, "dicom-tls"},
+	{6, 2763, "desktop-dna"},
+	{6, 2764, "data-insurance"},
+	{6, 2765, "qip-audup"},
+	{6, 2766, "compaq-scp"},
+	{6, 2767, "uadtc"},
+	{6, 2768, "uacs"},
+	{6, 2769, "exce"},
+	{6, 2770, "veronica"},
+	{6, 2771, "vergencecm"},
+	{6, 2772, "auris"},
+	{6, 2773, "rbakcup1"},
+	{6, 2774, "rbakcup2"},
+	{6, 2775, "smpp"},
+	{6, 2776, "ridgeway1"},
+	{6, 2777, "ridgeway2"},
+	{6, 2778, "gwen-sonya"},
+	{6, 2779, "lbc-sync"},
+	{6, 2780, "lbc-control"},
+	{6, 2781, "whosells"},
+	{6, 2782, "everydayrc"},
+	{6, 2783, "aises"},
+	{6, 2784, "www-dev"},
+	{6, 2785, "aic-np"},
+	{6, 2786, "aic-oncrpc"},
+	{6, 2787, "piccolo"},
+	{6, 2788, "fryeserv"},
+	{6, 2789, "media-agent"},
+	{6, 2790, "plgproxy"},
+	{6, 2791, "mtport-regist"},
+	{6, 2792, "f5-globalsite"},
+	{6, 2793, "initlsmsad"},
+	{6, 2795, "livestats"},
+	{6, 2796, "ac-tech"},
+	{6, 2797, "esp-encap"},
+	{6, 2798, "tmesis-upshot"},
+	{6, 2799, "icon-discover"},
+	{6, 2800, "acc-raid"},
+	{6, 2801, "igcp"},
+	{6, 2802, "veritas-tcp1"},
+	{6, 2803, "btprjctrl"},
+	{6, 2804, "dvr-esm"},
+	{6, 2805, "wta-wsp-s"},
+	{6, 2806, "cspuni"},
+	{6, 2807, "cspmulti"},
+	{6, 2808, "j-lan-p"},
+	{6, 2809, "corbaloc"},
+	{6, 2810, "netsteward"},
+	{6, 2811, "gsiftp"},
+	{6, 2812, "atmtcp"},
+	{6, 2813, "llm-pass"},
+	{6, 2814, "llm-csv"},
+	{6, 2815, "lbc-measure"},
+	{6, 2816, "lbc-watchdog"},
+	{6, 2817, "nmsigport"},
+	{6, 2818, "rmlnk"},
+	{6, 2819, "fc-faultnotify"},
+	{6, 2820, "univision"},
+	{6, 2821, "vrts-at-port"},
+	{6, 2822, "ka0wuc"},
+	{6, 2823, "cqg-netlan"},
+	{6, 2824, "cqg-netlan-1"},
+	{6, 2826, "slc-systemlog"},
+	{6, 2827, "slc-ctrlrloops"},
+	{6, 2828, "itm-lm"},
+	{6, 2829, "silkp1"},
+	{6, 2830, "silkp2"},
+	{6, 2831, "silkp3"},
+	{6, 2832, "silkp4"},
+	{6, 2833, "glishd"},
+	{6, 2834, "evtp"},
+	{6, 2835, "evtp-data"},
+	{6, 2836, "catalyst"},
+	{6, 2837, "repliweb"},
+	{6, 2838, "starbot"},
+	{6, 2839, "nmsigport"},
+	{6, 2840, "l3-exprt"},
+	{6, 2841, "l3-ranger"},
+	{6, 2842, "l3-hawk"},
+	{6, 2843, "pdnet"},
+	{6, 2844, "bpcp-poll"},
+	{6, 2845, "bpcp-trap"},
+	{6, 2846, "aimpp-hello"},
+	{6, 2847, "aimpp-port-req"},
+	{6, 2848, "amt-blc-port"},
+	{6, 2849, "fxp"},
+	{6, 2850, "metaconsole"},
+	{6, 2851, "webemshttp"},
+	{6, 2852, "bears-01"},
+	{6, 2853, "ispipes"},
+	{6, 2854, "infomover"},
+	{6, 2855, "msrp"},
+	{6, 2856, "cesdinv"},
+	{6, 2857, "simctlp"},
+	{6, 2858, "ecnp"},
+	{6, 2859, "activememory"},
+	{6, 2860, "dialpad-voice1"},
+	{6, 2861, "dialpad-voice2"},
+	{6, 2862, "ttg-protocol"},
+	{6, 2863, "sonardata"},
+	{6, 2864, "astronova-main"},
+	{6, 2865, "pit-vpn"},
+	{6, 2866, "iwlistener"},
+	{6, 2867, "esps-portal"},
+	{6, 2868, "npep-messaging"},
+	{6, 2869, "icslap"},
+	{6, 2870, "daishi"},
+	{6, 2871, "msi-selectplay"},
+	{6, 2872, "radix"},
+	{6, 2873, "psrt"},
+	{6, 2874, "dxmessagebase1"},
+	{6, 2875, "dxmessagebase2"},
+	{6, 2876, "sps-tunnel"},
+	{6, 2877, "bluelance"},
+	{6, 2878, "aap"},
+	{6, 2879, "ucentric-ds"},
+	{6, 2880, "synapse"},
+	{6, 2881, "ndsp"},
+	{6, 2882, "ndtp"},
+	{6, 2883, "ndnp"},
+	{6, 2884, "flashmsg"},
+	{6, 2885, "topflow"},
+	{6, 2886, "responselogic"},
+	{6, 2887, "aironetddp"},
+	{6, 2888, "spcsdlobby"},
+	{6, 2889, "rsom"},
+	{6, 2890, "cspclmulti"},
+	{6, 2891, "cinegrfx-elmd"},
+	{6, 2892, "snifferdata"},
+	{6, 2893, "vseconnector"},
+	{6, 2894, "abacus-remote"},
+	{6, 2895, "natuslink"},
+	{6, 2896, "ecovisiong6-1"},
+	{6, 2897, "citrix-rtmp"},
+	{6, 2898, "appliance-cfg"},
+	{6, 2899, "powergemplus"},
+	{6, 2900, "quicksuite"},
+	{6, 2901, "allstorcns"},
+	{6, 2902, "netaspi"},
+	{6, 2903, "suitcase"},
+	{6, 2904, "m2ua"},
+	{6, 2905, "m3ua"},
+	{6, 2906, "caller9"},
+	{6, 2907, "webmethods-b2b"},
+	{6, 2908, "mao"},
+	{6, 2909, "funk-dialout"},
+	{6, 2910, "tdaccess"},
+	{6, 2911, "blockade"},
+	{6, 2912, "epicon"},
+	{6, 2913, "boosterware"},
+	{6, 2914, "gamelobby"},
+	{6, 2915, "tksocket"},
+	{6, 2916, "elvin-server"},
+	{6, 2917, "elvin-client"},
+	{6, 2918, "kastenchasepad"},
+	{6, 2919, "roboer"},
+	{6, 2920, "roboeda"},
+	{6, 2921, "cesdcdman"},
+	{6, 2922, "cesdcdtrn"},
+	{6, 2923, "wta-wsp-wtp-s"},
+	{6, 2924, "precise-vip"},
+	{6, 2926, "mobile-file-dl"},
+	{6, 2927, "unimobilectrl"},
+	{6, 2928, "redstone-cpss"},
+	{6, 2929, "amx-webadmin"},
+	{6, 2930, "amx-weblinx"},
+	{6, 2931, "circle-x"},
+	{6, 2932, "incp"},
+	{6, 2933, "4-tieropmgw"},
+	{6, 2934, "4-tieropmcli"},
+	{6, 2935, "qtp"},
+	{6, 2936, "otpatch"},
+	{6, 2937, "pnaconsult-lm"},
+	{6, 2938, "sm-pas-1"},
+	{6, 2939, "sm-pas-2"},
+	{6, 2940, "sm-pas-3"},
+	{6, 2941, "sm-pas-4"},
+	{6, 2942, "sm-pas-5"},
+	{6, 2943, "ttnrepository"},
+	{6, 2944, "megaco-h248"},
+	{6, 2945, "h248-binary"},
+	{6, 2946, "fjsvmpor"},
+	{6, 2947, "gpsd"},
+	{6, 2948, "wap-push"},
+	{6, 2949, "wap-pushsecure"},
+	{6, 2950, "esip"},
+	{6, 2951, "ottp"},
+	{6, 2952, "mpfwsas"},
+	{6, 2953, "ovalarmsrv"},
+	{6, 2954, "ovalarmsrv-cmd"},
+	{6, 2955, "csnotify"},
+	{6, 2956, "ovrimosdbman"},
+	{6, 2957, "jmact5"},
+	{6, 2958, "jmact6"},
+	{6, 2959, "rmopagt"},
+	{6, 2960, "dfoxserver"},
+	{6, 2961, "boldsoft-lm"},
+	{6, 2962, "iph-policy-cli"},
+	{6, 2963, "iph-policy-adm"},
+	{6, 2964, "bullant-srap"},
+	{6, 2965, "bullant-rap"},
+	{6, 2966, "idp-infotrieve"},
+	{6, 2967, "ssc-agent"},
+	{6, 2968, "enpp"},
+	{6, 2969, "essp"},
+	{6, 2970, "index-net"},
+	{6, 2971, "netclip"},
+	{6, 2972, "pmsm-webrctl"},
+	{6, 2973, "svnetworks"},
+	{6, 2974, "signal"},
+	{6, 2975, "fjmpcm"},
+	{6, 2976, "cns-srv-port"},
+	{6, 2977, "ttc-etap-ns"},
+	{6, 2978, "ttc-etap-ds"},
+	{6, 2979, "h263-video"},
+	{6, 2980, "wimd"},
+	{6, 2981, "mylxamport"},
+	{6, 2982, "iwb-whiteboard"},
+	{6, 2983, "netplan"},
+	{6, 2984, "hpidsadmin"},
+	{6, 2985, "hpidsagent"},
+	{6, 2986, "stonefalls"},
+	{6, 2987, "identify"},
+	{6, 2988, "hippad"},
+	{6, 2989, "zarkov"},
+	{6, 2990, "boscap"},
+	{6, 2991, "wkstn-mon"},
+	{6, 2992, "avenyo"},
+	{6, 2993, "veritas-vis1"},
+	{6, 2994, "veritas-vis2"},
+	{6, 2995, "idrs"},
+	{6, 2996, "vsixml"},
+	{6, 2997, "rebol"},
+	{6, 2998, "realsecure"},
+	{6, 2999, "remoteware-un"},
+	{6, 3000, "hbci"},
+	{6, 3001, "origo-native"},
+	{6, 3002, "exlm-agent"},
+	{6, 3003, "cgms"},
+	{6, 3004, "csoftragent"},
+	{6, 3005, "geniuslm"},
+	{6, 3006, "ii-admin"},
+	{6, 3007, "lotusmtap"},
+	{6, 3008, "midnight-tech"},
+	{6, 3009, "pxc-ntfy"},
+	{6, 3010, "gw"},
+	{6, 3011, "trusted-web"},
+	{6, 3012, "twsdss"},
+	{6, 3013, "gilatskysurfer"},
+	{6, 3014, "broker-service"},
+	{6, 3015, "nati-dstp"},
+	{6, 3016, "notify-srvr"},
+	{6, 3017, "event-listener"},
+	{6, 3018, "srvc-registry"},
+	{6, 3019, "resource-mgr"},
+	{6, 3020, "cifs"},
+	{6, 3021, "agriserver"},
+	{6, 3022, "csregagent"},
+	{6, 3023, "magicnotes"},
+	{6, 3024, "nds-sso"},
+	{6, 3025, "arepa-raft"},
+	{6, 3026, "agri-gateway"},
+	{6, 3027, "LiebDevMgmt-C"},
+	{6, 3028, "LiebDevMgmt-DM"},
+	{6, 3029, "LiebDevMgmt-A"},
+	{6, 3030, "arepa-cas"},
+	{6, 3031, "eppc"},
+	{6, 3032, "redwood-chat"},
+	{6, 3033, "pdb"},
+	{6, 3034, "osmosis-aeea"},
+	{6, 3035, "fjsv-gssagt"},
+	{6, 3036, "hagel-dump"},
+	{6, 3037, "hp-san-mgmt"},
+	{6, 3038, "santak-ups"},
+	{6, 3039, "cogitate"},
+	{6, 3040, "tomato-springs"},
+	{6, 3041, "di-traceware"},
+	{6, 3042, "journee"},
+	{6, 3043, "brp"},
+	{6, 3044, "epp"},
+	{6, 3045, "responsenet"},
+	{6, 3046, "di-ase"},
+	{6, 3047, "hlserver"},
+	{6, 3048, "pctrader"},
+	{6, 3049, "nsws"},
+	{6, 3050, "gds-db"},
+	{6, 3051, "galaxy-server"},
+	{6, 3052, "apc-3052"},
+	{6, 3053, "dsom-server"},
+	{6, 3054, "amt-cnf-prot"},
+	{6, 3055, "policyserver"},
+	{6, 3056, "cdl-server"},
+	{6, 3057, "goahead-fldup"},
+	{6, 3058, "videobeans"},
+	{6, 3059, "qsoft"},
+	{6, 3060, "interserver"},
+	{6, 3061, "cautcpd"},
+	{6, 3062, "ncacn-ip-tcp"},
+	{6, 3063, "ncadg-ip-udp"},
+	{6, 3064, "rprt"},
+	{6, 3065, "slinterbase"},
+	{6, 3066, "netattachsdmp"},
+	{6, 3067, "fjhpjp"},
+	{6, 3068, "ls3bcast"},
+	{6, 3069, "ls3"},
+	{6, 3070, "mgxswitch"},
+	{6, 3071, "xplat-replicate"},
+	{6, 3072, "csd-monitor"},
+	{6, 3073, "vcrp"},
+	{6, 3074, "xbox"},
+	{6, 3075, "orbix-locator"},
+	{6, 3076, "orbix-config"},
+	{6, 3077, "orbix-loc-ssl"},
+	{6, 3078, "orbix-cfg-ssl"},
+	{6, 3079, "lv-frontpanel"},
+	{6, 3080, "stm-pproc"},
+	{6, 3081, "tl1-lv"},
+	{6, 3082, "tl1-raw"},
+	{6, 3083, "tl1-telnet"},
+	{6, 3084, "itm-mccs"},
+	{6, 3085, "pcihreq"},
+	{6, 3086, "jdl-dbkitchen"},
+	{6, 3087, "asoki-sma"},
+	{6, 3088, "xdtp"},
+	{6, 3089, "ptk-alink"},
+	{6, 3090, "stss"},
+	{6, 3091, "1ci-smcs"},
+	{6, 3093, "rapidmq-center"},
+	{6, 3094, "rapidmq-reg"},
+	{6, 3095, "panasas"},
+	{6, 3096, "ndl-aps"},
+	{6, 3098, "umm-port"},
+	{6, 3099, "chmd"},
+	{6, 3100, "opcon-xps"},
+	{6, 3101, "hp-pxpib"},
+	{6, 3102, "slslavemon"},
+	{6, 3103, "autocuesmi"},
+	{6, 3104, "autocuelog"},
+	{6, 3105, "cardbox"},
+	{6, 3106, "cardbox-http"},
+	{6, 3107, "business"},
+	{6, 3108, "geolocate"},
+	{6, 3109, "personnel"},
+	{6, 3110, "sim-control"},
+	{6, 3111, "wsynch"},
+	{6, 3112, "ksysguard"},
+	{6, 3113, "cs-auth-svr"},
+	{6, 3114, "ccmad"},
+	{6, 3115, "mctet-master"},
+	{6, 3116, "mctet-gateway"},
+	{6, 3117, "mctet-jserv"},
+	{6, 3118, "pkagent"},
+	{6, 3119, "d2000kernel"},
+	{6, 3120, "d2000webserver"},
+	{6, 3121, "pcmk-remote"},
+	{6, 3122, "vtr-emulator"},
+	{6, 3123, "edix"},
+	{6, 3124, "beacon-port"},
+	{6, 3125, "a13-an"},
+	{6, 3127, "ctx-bridge"},
+	{6, 3128, "ndl-aas"},
+	{6, 3129, "netport-id"},
+	{6, 3130, "icpv2"},
+	{6, 3131, "netbookmark"},
+	{6, 3132, "ms-rule-engine"},
+	{6, 3133, "prism-deploy"},
+	{6, 3134, "ecp"},
+	{6, 3135, "peerbook-port"},
+	{6, 3136, "grubd"},
+	{6, 3137, "rtnt-1"},
+	{6, 3138, "rtnt-2"},
+	{6, 3139, "incognitorv"},
+	{6, 3140, "ariliamulti"},
+	{6, 3141, "vmodem"},
+	{6, 3142, "rdc-wh-eos"},
+	{6, 3143, "seaview"},
+	{6, 3144, "tarantella"},
+	{6, 3145, "csi-lfap"},
+	{6, 3146, "bears-02"},
+	{6, 3147, "rfio"},
+	{6, 3148, "nm-game-admin"},
+	{6, 3149, "nm-game-server"},
+	{6, 3150, "nm-asses-admin"},
+	{6, 3151, "nm-assessor"},
+	{6, 3152, "feitianrockey"},
+	{6, 3153, "s8-client-port"},
+	{6, 3154, "ccmrmi"},
+	{6, 3155, "jpegmpeg"},
+	{6, 3156, "indura"},
+	{6, 3157, "e3consultants"},
+	{6, 3158, "stvp"},
+	{6, 3159, "navegaweb-port"},
+	{6, 3160, "tip-app-server"},
+	{6, 3161, "doc1lm"},
+	{6, 3162, "sflm"},
+	{6, 3163, "res-sap"},
+	{6, 3164, "imprs"},
+	{6, 3165, "newgenpay"},
+	{6, 3166, "sossecollector"},
+	{6, 3167, "nowcontact"},
+	{6, 3168, "poweronnud"},
+	{6, 3169, "serverview-as"},
+	{6, 3170, "serverview-asn"},
+	{6, 3171, "serverview-gf"},
+	{6, 3172, "serverview-rm"},
+	{6, 3173, "serverview-icc"},
+	{6, 3174, "armi-server"},
+	{6, 3175, "t1-e1-over-ip"},
+	{6, 3176, "ars-master"},
+	{6, 3177, "phonex-port"},
+	{6, 3178, "radclientport"},
+	{6, 3179, "h2gf-w-2m"},
+	{6, 3180, "mc-brk-srv"},
+	{6, 3181, "bmcpatrolagent"},
+	{6, 3182, "bmcpatrolrnvu"},
+	{6, 3183, "cops-tls"},
+	{6, 3184, "apogeex-port"},
+	{6, 3185, "smpppd"},
+	{6, 3186, "iiw-port"},
+	{6, 3187, "odi-port"},
+	{6, 3188, "brcm-comm-port"},
+	{6, 3189, "pcle-infex"},
+	{6, 3190, "csvr-proxy"},
+	{6, 3191, "csvr-sslproxy"},
+	{6, 3192, "firemonrcc"},
+	{6, 3193, "spandataport"},
+	{6, 3194, "magbind"},
+	{6, 3195, "ncu-1"},
+	{6, 3196, "ncu-2"},
+	{6, 3197, "embrace-dp-s"},
+	{6, 3198, "embrace-dp-c"},
+	{6, 3199, "dmod-workspace"},
+	{6, 3200, "tick-port"},
+	{6, 3201, "cpq-tasksmart"},
+	{6, 3202, "intraintra"},
+	{6, 3203, "netwatcher-mon"},
+	{6, 3204, "netwatcher-db"},
+	{6, 3205, "isns"},
+	{6, 3206, "ironmail"},
+	{6, 3207, "vx-auth-port"},
+	{6, 3208, "pfu-prcallback"},
+	{6, 3209, "netwkpathengine"},
+	{6, 3210, "flamenco-proxy"},
+	{6, 3211, "avsecuremgmt"},
+	{6, 3212, "surveyinst"},
+	{6, 3213, "neon24x7"},
+	{6, 3214, "jmq-daemon-1"},
+	{6, 3215, "jmq-daemon-2"},
+	{6, 3216, "ferrari-foam"},
+	{6, 3217, "unite"},
+	{6, 3218, "smartpackets"},
+	{6, 3219, "wms-messenger"},
+	{6, 3220, "xnm-ssl"},
+	{6, 3221, "xnm-clear-text"},
+	{6, 3222, "glbp"},
+	{6, 3223, "digivote"},
+	{6, 3224, "aes-discovery"},
+	{6, 3225, "fcip-port"},
+	{6, 3226, "isi-irp"},
+	{6, 3227, "dwnmshttp"},
+	{6, 3228, "dwmsgserver"},
+	{6, 3229, "global-cd-port"},
+	{6, 3230, "sftdst-port"},
+	{6, 3231, "vidigo"},
+	{6, 3232, "mdtp"},
+	{6, 3233, "whisker"},
+	{6, 3234, "alchemy"},
+	{6, 3235, "mdap-port"},
+	{6, 3236, "apparenet-ts"},
+	{6, 3237, "apparenet-tps"},
+	{6, 3238, "apparenet-as"},
+	{6, 3239, "apparenet-ui"},
+	{6, 3240, "triomotion"},
+	{6, 3241, "sysorb"},
+	{6, 3242, "sdp-id-port"},
+	{6, 3243, "timelot"},
+	{6, 3244, "onesaf"},
+	{6, 3245, "vieo-fe"},
+	{6, 3246, "dvt-system"},
+	{6, 3247, "dvt-data"},
+	{6, 3248, "procos-lm"},
+	{6, 3249, "ssp"},
+	{6, 3250, "hicp"},
+	{6, 3251, "sysscanner"},
+	{6, 3252, "dhe"},
+	{6, 3253, "pda-data"},
+	{6, 3254, "pda-sys"},
+	{6, 3255, "semaphore"},
+	{6, 3256, "cpqrpm-agent"},
+	{6, 3257, "cpqrpm-server"},
+	{6, 3258, "ivecon-port"},
+	{6, 3259, "epncdp2"},
+	{6, 3260, "iscsi-target"},
+	{6, 3261, "winshadow"},
+	{6, 3262, "necp"},
+	{6, 3263, "ecolor-imager"},
+	{6, 3264, "ccmail"},
+	{6, 3265, "altav-tunnel"},
+	{6, 3266, "ns-cfg-server"},
+	{6, 3267, "ibm-dial-out"},
+	{6, 3268, "msft-gc"},
+	{6, 3269, "msft-gc-ssl"},
+	{6, 3270, "verismart"},
+	{6, 3271, "csoft-prev"},
+	{6, 3272, "user-manager"},
+	{6, 3273, "sxmp"},
+	{6, 3274, "ordinox-server"},
+	{6, 3275, "samd"},
+	{6, 3276, "maxim-asics"},
+	{6, 3277, "awg-proxy"},
+	{6, 3278, "lkcmserver"},
+	{6, 3279, "admind"},
+	{6, 3280, "vs-server"},
+	{6, 3281, "sysopt"},
+	{6, 3282, "datusorb"},
+	{6, 3283, "Apple Remote Desktop (Net Assistant)"},
+	{6, 3284, "4talk"},
+	{6, 3285, "plato"},
+	{6, 3286, "e-net"},
+	{6, 3287, "directvdata"},
+	{6, 3288, "cops"},
+	{6, 3289, "enpc"},
+	{6, 3290, "caps-lm"},
+	{6, 3291, "sah-lm"},
+	{6, 3292, "cart-o-rama"},
+	{6, 3293, "fg-fps"},
+	{6, 3294, "fg-gip"},
+	{6, 3295, "dyniplookup"},
+	{6, 3296, "rib-slm"},
+	{6, 3297, "cytel-lm"},
+	{6, 3298, "deskview"},
+	{6, 3299, "pdrncs"},
+	{6, 3300, "ceph"},
+	{6, 3301, "tarantool"},
+	{6, 3302, "mcs-fastmail"},
+	{6, 3303, "opsession-clnt"},
+	{6, 3304, "opsession-srvr"},
+	{6, 3305, "odette-ftp"},
+	{6, 3306, "mysql"},
+	{6, 3307, "opsession-prxy"},
+	{6, 3308, "tns-server"},
+	{6, 3309, "tns-adv"},
+	{6, 3310, "dyna-access"},
+	{6, 3311, "mcns-tel-ret"},
+	{6, 3312, "appman-server"},
+	{6, 3313, "uorb"},
+	{6, 3314, "uohost"},
+	{6, 3315, "cdid"},
+	{6, 3316, "aicc-cmi"},
+	{6, 3317, "vsaiport"},
+	{6, 3318, "ssrip"},
+	{6, 3319, "sdt-lmd"},
+	{6, 3320, "officelink2000"},
+	{6, 3321, "vnsstr"},
+	{6, 3326, "sftu"},
+	{6, 3327, "bbars"},
+	{6, 3328, "egptlm"},
+	{6, 3329, "hp-device-disc"},
+	{6, 3330, "mcs-calypsoicf"},
+	{6, 3331, "mcs-messaging"},
+	{6, 3332, "mcs-mailsvr"},
+	{6, 3333, "dec-notes"},
+	{6, 3334, "directv-web"},
+	{6, 3335, "directv-soft"},
+	{6, 3336, "directv-tick"},
+	{6, 3337, "directv-catlg"},
+	{6, 3338, "anet-b"},
+	{6, 3339, "anet-l"},
+	{6, 3340, "anet-m"},
+	{6, 3341, "anet-h"},
+	{6, 3342, "webtie"},
+	{6, 3343, "ms-cluster-net"},
+	{6, 3344, "bnt-manager"},
+	{6, 3345, "influence"},
+	{6, 3346, "trnsprntproxy"},
+	{6, 3347, "phoenix-rpc"},
+	{6, 3348, "pangolin-laser"},
+	{6, 3349, "chevinservices"},
+	{6, 3350, "findviatv"},
+	{6, 3351, "btrieve"},
+	{6, 3352, "ssql"},
+	{6, 3353, "fatpipe"},
+	{6, 3354, "suitjd"},
+	{6, 3355, "ordinox-dbase"},
+	{6, 3356, "upnotifyps"},
+	{6, 3357, "adtech-test"},
+	{6, 3358, "mpsysrmsvr"},
+	{6, 3359, "wg-netforce"},
+	{6, 3360, "kv-server"},
+	{6, 3361, "kv-agent"},
+	{6, 3362, "dj-ilm"},
+	{6, 3363, "nati-vi-server"},
+	{6, 3364, "creativeserver"},
+	{6, 3365, "contentserver"},
+	{6, 3366, "creativepartnr"},
+	{6, 3372, "tip2"},
+	{6, 3373, "lavenir-lm"},
+	{6, 3374, "cluster-disc"},
+	{6, 3375, "vsnm-agent"},
+	{6, 3376, "cdbroker"},
+	{6, 3377, "cogsys-lm"},
+	{6, 3378, "wsicopy"},
+	{6, 3379, "socorfs"},
+	{6, 3380, "sns-channels"},
+	{6, 3381, "geneous"},
+	{6, 3382, "fujitsu-neat"},
+	{6, 3383, "esp-lm"},
+	{6, 3384, "hp-clic"},
+	{6, 3385, "qnxnetman"},
+	{6, 3386, "gprs-data"},
+	{6, 3387, "backroomnet"},
+	{6, 3388, "cbserver"},
+	{6, 3389, "ms-wbt-server"},
+	{6, 3390, "dsc"},
+	{6, 3391, "savant"},
+	{6, 3392, "efi-lm"},
+	{6, 3393, "d2k-tapestry1"},
+	{6, 3394, "d2k-tapestry2"},
+	{6, 3395, "dyna-lm"},
+	{6, 3396, "printer-agent"},
+	{6, 3397, "cloanto-lm"},
+	{6, 3398, "mercantile"},
+	{6, 3399, "csms"},
+	{6, 3400, "csms2"},
+	{6, 3401, "filecast"},
+	{6, 3402, "fxaengine-net"},
+	{6, 3405, "nokia-ann-ch1"},
+	{6, 3406, "nokia-ann-ch2"},
+	{6, 3407, "ldap-admin"},
+	{6, 3408, "BESApi"},
+	{6, 3409, "networklens"},
+	{6, 3410, "networklenss"},
+	{6, 3411, "biolink-auth"},
+	{6, 3412, "xmlblaster"},
+	{6, 3413, "svnet"},
+	{6, 3414, "wip-port"},
+	{6, 3415, "bcinameservice"},
+	{6, 3416, "commandport"},
+	{6, 3417, "csvr"},
+	{6, 3418, "rnmap"},
+	{6, 3419, "softaudit"},
+	{6, 3420, "ifcp-port"},
+	{6, 3421, "bmap"},
+	{6, 3422, "rusb-sys-port"},
+	{6, 3423, "xtrm"},
+	{6, 3424, "xtrms"},
+	{6, 3425, "agps-port"},
+	{6, 3426, "arkivio"},
+	{6, 3427, "websphere-snmp"},
+	{6, 3428, "twcss"},
+	{6, 3429, "gcsp"},
+	{6, 3430, "ssdispatch"},
+	{6, 3431, "ndl-als"},
+	{6, 3432, "osdcp"},
+	{6, 3433, "opnet-smp"},
+	{6, 3434, "opencm"},
+	{6, 3435, "pacom"},
+	{6, 3436, "gc-config"},
+	{6, 3437, "autocueds"},
+	{6, 3438, "spiral-admin"},
+	{6, 3439, "hri-port"},
+	{6, 3440, "ans-console"},
+	{6, 3441, "connect-client"},
+	{6, 3442, "connect-server"},
+	{6, 3443, "ov-nnm-websrv"},
+	{6, 3444, "denali-server"},
+	{6, 3445, "monp"},
+	{6, 3446, "3comfaxrpc"},
+	{6, 3447, "directnet"},
+	{6, 3448, "dnc-port"},
+	{6, 3449, "hotu-chat"},
+	{6, 3450, "castorproxy"},
+	{6, 3451, "asam"},
+	{6, 3452, "sabp-signal"},
+	{6, 3453, "pscupd"},
+	{6, 3454, "mira"},
+	{6, 3455, "prsvp"},
+	{6, 3456, "vat"},
+	{6, 3457, "vat-control"},
+	{6, 3458, "d3winosfi"},
+	{6, 3459, "integral"},
+	{6, 3460, "edm-manager"},
+	{6, 3461, "edm-stager"},
+	{6, 3462, "edm-std-notify"},
+	{6, 3463, "edm-adm-notify"},
+	{6, 3464, "edm-mgr-sync"},
+	{6, 3465, "edm-mgr-cntrl"},
+	{6, 3466, "workflow"},
+	{6, 3467, "rcst"},
+	{6, 3468, "ttcmremotectrl"},
+	{6, 3469, "pluribus"},
+	{6, 3470, "jt400"},
+	{6, 3471, "jt400-ssl"},
+	{6, 3472, "jaugsremotec-1"},
+	{6, 3473, "jaugsremotec-2"},
+	{6, 3474, "ttntspauto"},
+	{6, 3475, "genisar-port"},
+	{6, 3476, "nppmp"},
+	{6, 3477, "ecomm"},
+	{6, 3478, "stun"},
+	{6, 3479, "twrpc"},
+	{6, 3480, "plethora"},
+	{6, 3481, "cleanerliverc"},
+	{6, 3482, "vulture"},
+	{6, 3483, "slim-devices"},
+	{6, 3484, "gbs-stp"},
+	{6, 3485, "celatalk"},
+	{6, 3486, "ifsf-hb-port"},
+	{6, 3487, "ltctcp"},
+	{6, 3488, "fs-rh-srv"},
+	{6, 3489, "dtp-dia"},
+	{6, 3490, "colubris"},
+	{6, 3491, "swr-port"},
+	{6, 3492, "tvdumtray-port"},
+	{6, 3493, "nut"},
+	{6, 3494, "ibm3494"},
+	{6, 3495, "seclayer-tcp"},
+	{6, 3496, "seclayer-tls"},
+	{6, 3497, "ipether232port"},
+	{6, 3498, "dashpas-port"},
+	{6, 3499, "sccip-media"},
+	{6, 3500, "rtmp-port"},
+	{6, 3501, "isoft-p2p"},
+	{6, 3502, "avinstalldisc"},
+	{6, 3503, "lsp-ping"},
+	{6, 3504, "ironstorm"},
+	{6, 3505, "ccmcomm"},
+	{6, 3506, "apc-3506"},
+	{6, 3507, "nesh-broker"},
+	{6, 3508, "interactionweb"},
+	{6, 3509, "vt-ssl"},
+	{6, 3510, "xss-port"},
+	{6, 3511, "webmail-2"},
+	{6, 3512, "aztec"},
+	{6, 3513, "arcpd"},
+	{6, 3514, "must-p2p"},
+	{6, 3515, "must-backplane"},
+	{6, 3516, "smartcard-port"},
+	{6, 3517, "802-11-iapp"},
+	{6, 3518, "artifact-msg"},
+	{6, 3519, "nvmsgd"},
+	{6, 3520, "galileolog"},
+	{6, 3521, "mc3ss"},
+	{6, 3522, "nssocketport"},
+	{6, 3523, "odeumservlink"},
+	{6, 3524, "ecmport"},
+	{6, 3525, "eisport"},
+	{6, 3526, "starquiz-port"},
+	{6, 3527, "beserver-msg-q"},
+	{6, 3528, "jboss-iiop"},
+	{6, 3529, "jboss-iiop-ssl"},
+	{6, 3530, "gf"},
+	{6, 3531, "joltid"},
+	{6, 3532, "raven-rmp"},
+	{6, 3533, "raven-rdp"},
+	{6, 3534, "urld-port"},
+	{6, 3535, "ms-la"},
+	{6, 3536, "snac"},
+	{6, 3537, "ni-visa-remote"},
+	{6, 3538, "ibm-diradm"},
+	{6, 3539, "ibm-diradm-ssl"},
+	{6, 3540, "pnrp-port"},
+	{6, 3541, "voispeed-port"},
+	{6, 3542, "hacl-monitor"},
+	{6, 3543, "qftest-lookup"},
+	{6, 3544, "teredo"},
+	{6, 3545, "camac"},
+	{6, 3547, "symantec-sim"},
+	{6, 3548, "interworld"},
+	{6, 3549, "tellumat-nms"},
+	{6, 3550, "ssmpp"},
+	{6, 3551, "apcupsd"},
+	{6, 3552, "taserver"},
+	{6, 3553, "rbr-discovery"},
+	{6, 3554, "questnotify"},
+	{6, 3555, "razor"},
+	{6, 3556, "sky-transport"},
+	{6, 3557, "personalos-001"},
+	{6, 3558, "mcp-port"},
+	{6, 3559, "cctv-port"},
+	{6, 3560, "iniserve-port"},
+	{6, 3561, "bmc-onekey"},
+	{6, 3562, "sdbproxy"},
+	{6, 3563, "watcomdebug"},
+	{6, 3564, "esimport"},
+	{6, 3565, "m2pa"},
+	{6, 3566, "quest-data-hub"},
+	{6, 3567, "dof-eps"},
+	{6, 3568, "dof-tunnel-sec"},
+	{6, 3569, "mbg-ctrl"},
+	{6, 3570, "mccwebsvr-port"},
+	{6, 3571, "megardsvr-port"},
+	{6, 3572, "megaregsvrport"},
+	{6, 3573, "tag-ups-1"},
+	{6, 3574, "dmaf-server"},
+	{6, 3575, "ccm-port"},
+	{6, 3576, "cmc-port"},
+	{6, 3577, "config-port"},
+	{6, 3578, "data-port"},
+	{6, 3579, "ttat3lb"},
+	{6, 3580, "nati-svrloc"},
+	{6, 3581, "kfxaclicensing"},
+	{6, 3582, "press"},
+	{6, 3583, "canex-watch"},
+	{6, 3584, "u-dbap"},
+	{6, 3585, "emprise-lls"},
+	{6, 3586, "emprise-lsc"},
+	{6, 3587, "p2pgroup"},
+	{6, 3588, "sentinel"},
+	{6, 3589, "isomair"},
+	{6, 3590, "wv-csp-sms"},
+	{6, 3591, "gtrack-server"},
+	{6, 3592, "gtrack-ne"},
+	{6, 3593, "bpmd"},
+	{6, 3594, "mediaspace"},
+	{6, 3595, "shareapp"},
+	{6, 3596, "iw-mmogame"},
+	{6, 3597, "a14"},
+	{6, 3598, "a15"},
+	{6, 3599, "quasar-server"},
+	{6, 3600, "trap-daemon"},
+	{6, 3601, "visinet-gui"},
+	{6, 3602, "infiniswitchcl"},
+	{6, 3603, "int-rcv-cntrl"},
+	{6, 3604, "bmc-jmx-port"},
+	{6, 3605, "comcam-io"},
+	{6, 3606, "splitlock"},
+	{6, 3607, "precise-i3"},
+	{6, 3608, "trendchip-dcp"},
+	{6, 3609, "cpdi-pidas-cm"},
+	{6, 3610, "echonet"},
+	{6, 3611, "six-degrees"},
+	{6, 3612, "dataprotector"},
+	{6, 3613, "alaris-disc"},
+	{6, 3614, "sigma-port"},
+	{6, 3615, "start-network"},
+	{6, 3616, "cd3o-protocol"},
+	{6, 3617, "sharp-server"},
+	{6, 3618, "aairnet-1"},
+	{6, 3619, "aairnet-2"},
+	{6, 3620, "ep-pcp"},
+	{6, 3621, "ep-nsp"},
+	{6, 3622, "ff-lr-port"},
+	{6, 3623, "haipe-discover"},
+	{6, 3624, "dist-upgrade"},
+	{6, 3625, "volley"},
+	{6, 3626, "bvcdaemon-port"},
+	{6, 3627, "jamserverport"},
+	{6, 3628, "ept-machine"},
+	{6, 3629, "escvpnet"},
+	{6, 3630, "cs-remote-db"},
+	{6, 3631, "cs-services"},
+	{6, 3632, "distcc"},
+	{6, 3633, "wacp"},
+	{6, 3634, "hlibmgr"},
+	{6, 3635, "sdo"},
+	{6, 3636, "servistaitsm"},
+	{6, 3637, "scservp"},
+	{6, 3638, "ehp-backup"},
+	{6, 3639, "xap-ha"},
+	{6, 3640, "netplay-port1"},
+	{6, 3641, "netplay-port2"},
+	{6, 3642, "juxml-port"},
+	{6, 3643, "audiojuggler"},
+	{6, 3644, "ssowatch"},
+	{6, 3645, "cyc"},
+	{6, 3646, "xss-srv-port"},
+	{6, 3647, "splitlock-gw"},
+	{6, 3648, "fjcp"},
+	{6, 3649, "nmmp"},
+	{6, 3650, "prismiq-plugin"},
+	{6, 3651, "xrpc-registry"},
+	{6, 3652, "vxcrnbuport"},
+	{6, 3653, "tsp"},
+	{6, 3654, "vaprtm"},
+	{6, 3655, "abatemgr"},
+	{6, 3656, "abatjss"},
+	{6, 3657, "immedianet-bcn"},
+	{6, 3658, "ps-ams"},
+	{6, 3659, "apple-sasl"},
+	{6, 3660, "can-nds-ssl"},
+	{6, 3661, "can-ferret-ssl"},
+	{6, 3662, "pserver"},
+	{6, 3663, "dtp"},
+	{6, 3664, "ups-engine"},
+	{6, 3665, "ent-engine"},
+	{6, 3666, "eserver-pap"},
+	{6, 3667, "infoexch"},
+	{6, 3668, "dell-rm-port"},
+	{6, 3669, "casanswmgmt"},
+	{6, 3670, "smile"},
+	{6, 3671, "efcp"},
+	{6, 3672, "lispworks-orb"},
+	{6, 3673, "mediavault-gui"},
+	{6, 3674, "wininstall-ipc"},
+	{6, 3675, "calltrax"},
+	{6, 3676, "va-pacbase"},
+	{6, 3677, "roverlog"},
+	{6, 3678, "ipr-dglt"},
+	{6, 3679, "Escale (Newton Dock)"},
+	{6, 3680, "npds-tracker"},
+	{6, 3681, "bts-x73"},
+	{6, 3682, "cas-mapi"},
+	{6, 3683, "bmc-ea"},
+	{6, 3684, "faxstfx-port"},
+	{6, 3685, "dsx-agent"},
+	{6, 3686, "tnmpv2"},
+	{6, 3687, "simple-push"},
+	{6, 3688, "simple-push-s"},
+	{6, 3689, "daap"},
+	{6, 3690, "svn"},
+	{6, 3691, "magaya-network"},
+	{6, 3692, "intelsync"},
+	{6, 3693, "easl"},
+	{6, 3695, "bmc-data-coll"},
+	{6, 3696, "telnetcpcd"},
+	{6, 3697, "nw-license"},
+	{6, 3698, "sagectlpanel"},
+	{6, 3699, "kpn-icw"},
+	{6, 3700, "lrs-paging"},
+	{6, 3701, "netcelera"},
+	{6, 3702, "ws-discovery"},
+	{6, 3703, "adobeserver-3"},
+	{6, 3704, "adobeserver-4"},
+	{6, 3705, "adobeserver-5"},
+	{6, 3706, "rt-event"},
+	{6, 3707, "rt-event-s"},
+	{6, 3708, "sun-as-iiops"},
+	{6, 3709, "ca-idms"},
+	{6, 3710, "portgate-auth"},
+	{6, 3711, "edb-server2"},
+	{6, 3712, "sentinel-ent"},
+	{6, 3713, "tftps"},
+	{6, 3714, "delos-dms"},
+	{6, 3715, "anoto-rendezv"},
+	{6, 3716, "wv-csp-sms-cir"},
+	{6, 3717, "wv-csp-udp-cir"},
+	{6, 3718, "opus-services"},
+	{6, 3719, "itelserverport"},
+	{6, 3720, "ufastro-instr"},
+	{6, 3721, "xsync"},
+	{6, 3722, "xserveraid"},
+	{6, 3723, "sychrond"},
+	{6, 3724, "blizwow"},
+	{6, 3725, "na-er-tip"},
+	{6, 3726, "array-manager"},
+	{6, 3727, "e-mdu"},
+	{6, 3728, "e-woa"},
+	{6, 3729, "fksp-audit"},
+	{6, 3730, "client-ctrl"},
+	{6, 3731, "smap"},
+	{6, 3732, "m-wnn"},
+	{6, 3733, "multip-msg"},
+	{6, 3734, "synel-data"},
+	{6, 3735, "pwdis"},
+	{6, 3736, "rs-rmi"},
+	{6, 3737, "xpanel"},
+	{6, 3738, "versatalk"},
+	{6, 3739, "launchbird-lm"},
+	{6, 3740, "heartbeat"},
+	{6, 3741, "wysdma"},
+	{6, 3742, "cst-port"},
+	{6, 3743, "ipcs-command"},
+	{6, 3744, "sasg"},
+	{6, 3745, "gw-call-port"},
+	{6, 3746, "linktest"},
+	{6, 3747, "linktest-s"},
+	{6, 3748, "webdata"},
+	{6, 3749, "cimtrak"},
+	{6, 3750, "cbos-ip-port"},
+	{6, 3751, "gprs-cube"},
+	{6, 3752, "vipremoteagent"},
+	{6, 3753, "nattyserver"},
+	{6, 3754, "timestenbroker"},
+	{6, 3755, "sas-remote-hlp"},
+	{6, 3756, "canon-capt"},
+	{6, 3757, "grf-port"},
+	{6, 3758, "apw-registry"},
+	{6, 3759, "exapt-lmgr"},
+	{6, 3760, "adtempusclient"},
+	{6, 3761, "gsakmp"},
+	{6, 3762, "gbs-smp"},
+	{6, 3763, "xo-wave"},
+	{6, 3764, "mni-prot-rout"},
+	{6, 3765, "rtraceroute"},
+	{6, 3766, "sitewatch-s"},
+	{6, 3767, "listmgr-port"},
+	{6, 3768, "rblcheckd"},
+	{6, 3769, "haipe-otnk"},
+	{6, 3770, "cindycollab"},
+	{6, 3771, "paging-port"},
+	{6, 3772, "ctp"},
+	{6, 3773, "ctdhercules"},
+	{6, 3774, "zicom"},
+	{6, 3775, "ispmmgr"},
+	{6, 3776, "dvcprov-port"},
+	{6, 3777, "jibe-eb"},
+	{6, 3778, "c-h-it-port"},
+	{6, 3779, "cognima"},
+	{6, 3780, "nnp"},
+	{6, 3781, "abcvoice-port"},
+	{6, 3782, "iso-tp0s"},
+	{6, 3783, "bim-pem"},
+	{6, 3784, "bfd-control"},
+	{6, 3785, "bfd-echo"},
+	{6, 3786, "upstriggervsw"},
+	{6, 3787, "fintrx"},
+	{6, 3788, "isrp-port"},
+	{6, 3789, "remotedeploy"},
+	{6, 3790, "quickbooksrds"},
+	{6, 3791, "tvnetworkvideo"},
+	{6, 3792, "sitewatch"},
+	{6, 3793, "dcsoftware"},
+	{6, 3794, "jaus"},
+	{6, 3795, "myblast"},
+	{6, 3796, "spw-dialer"},
+	{6, 3797, "idps"},
+	{6, 3798, "minilock"},
+	{6, 3799, "radius-dynauth"},
+	{6, 3800, "pwgpsi"},
+	{6, 3801, "ibm-mgr"},
+	{6, 3802, "vhd"},
+	{6, 3803, "soniqsync"},
+	{6, 3804, "iqnet-port"},
+	{6, 3805, "tcpdataserver"},
+	{6, 3806, "wsmlb"},
+	{6, 3807, "spugna"},
+	{6, 3808, "sun-as-iiops-ca"},
+	{6, 3809, "apocd"},
+	{6, 3810, "wlanauth"},
+	{6, 3811, "amp"},
+	{6, 3812, "neto-wol-server"},
+	{6, 3813, "rap-ip"},
+	{6, 3814, "neto-dcs"},
+	{6, 3815, "lansurveyorxml"},
+	{6, 3816, "sunlps-http"},
+	{6, 3817, "tapeware"},
+	{6, 3818, "crinis-hb"},
+	{6, 3819, "epl-slp"},
+	{6, 3820, "scp"},
+	{6, 3821, "pmcp"},
+	{6, 3822, "acp-discovery"},
+	{6, 3823, "acp-conduit"},
+	{6, 3824, "acp-policy"},
+	{6, 3825, "ffserver"},
+	{6, 3826, "warmux"},
+	{6, 3827, "netmpi"},
+	{6, 3828, "neteh"},
+	{6, 3829, "neteh-ext"},
+	{6, 3830, "cernsysmgmtagt"},
+	{6, 3831, "dvapps"},
+	{6, 3832, "xxnetserver"},
+	{6, 3833, "aipn-auth"},
+	{6, 3834, "spectardata"},
+	{6, 3835, "spectardb"},
+	{6, 3836, "markem-dcp"},
+	{6, 3837, "mkm-discovery"},
+	{6, 3838, "sos"},
+	{6, 3839, "amx-rms"},
+	{6, 3840, "flirtmitmir"},
+	{6, 3841, "shiprush-db-svr"},
+	{6, 3842, "nhci"},
+	{6, 3843, "quest-agent"},
+	{6, 3844, "rnm"},
+	{6, 3845, "v-one-spp"},
+	{6, 3846, "an-pcp"},
+	{6, 3847, "msfw-control"},
+	{6, 3848, "item"},
+	{6, 3849, "spw-dnspreload"},
+	{6, 3850, "qtms-bootstrap"},
+	{6, 3851, "spectraport"},
+	{6, 3852, "sse-app-config"},
+	{6, 3853, "sscan"},
+	{6, 3854, "stryker-com"},
+	{6, 3855, "opentrac"},
+	{6, 3856, "informer"},
+	{6, 3857, "trap-port"},
+	{6, 3858, "trap-port-mom"},
+	{6, 3859, "nav-port"},
+	{6, 3860, "sasp"},
+	{6, 3861, "winshadow-hd"},
+	{6, 3862, "giga-pocket"},
+	{6, 3863, "asap-tcp"},
+	{6, 3864, "asap-tcp-tls"},
+	{6, 3865, "xpl"},
+	{6, 3866, "dzdaemon"},
+	{6, 3867, "dzoglserver"},
+	{6, 3868, "diameter"},
+	{6, 3869, "ovsam-mgmt"},
+	{6, 3870, "ovsam-d-agent"},
+	{6, 3871, "avocent-adsap"},
+	{6, 3872, "oem-agent"},
+	{6, 3873, "fagordnc"},
+	{6, 3874, "sixxsconfig"},
+	{6, 3875, "pnbscada"},
+	{6, 3876, "dl-agent"},
+	{6, 3877, "xmpcr-interface"},
+	{6, 3878, "fotogcad"},
+	{6, 3879, "appss-lm"},
+	{6, 3880, "igrs"},
+	{6, 3881, "idac"},
+	{6, 3882, "msdts1"},
+	{6, 3883, "vrpn"},
+	{6, 3884, "softrack-meter"},
+	{6, 3885, "topflow-ssl"},
+	{6, 3886, "nei-management"},
+	{6, 3887, "ciphire-data"},
+	{6, 3888, "ciphire-serv"},
+	{6, 3889, "dandv-tester"},
+	{6, 3890, "ndsconnect"},
+	{6, 3891, "rtc-pm-port"},
+	{6, 3892, "pcc-image-port"},
+	{6, 3893, "cgi-starapi"},
+	{6, 3894, "syam-agent"},
+	{6, 3895, "syam-smc"},
+	{6, 3896, "sdo-tls"},
+	{6, 3897, "sdo-ssh"},
+	{6, 3898, "senip"},
+	{6, 3899, "itv-control"},
+	{6, 3900, "udt-os"},
+	{6, 3901, "nimsh"},
+	{6, 3902, "nimaux"},
+	{6, 3903, "charsetmgr"},
+	{6, 3904, "omnilink-port"},
+	{6, 3905, "mupdate"},
+	{6, 3906, "topovista-data"},
+	{6, 3907, "imoguia-port"},
+	{6, 3908, "hppronetman"},
+	{6, 3909, "surfcontrolcpa"},
+	{6, 3910, "prnrequest"},
+	{6, 3911, "prnstatus"},
+	{6, 3912, "gbmt-stars"},
+	{6, 3913, "listcrt-port"},
+	{6, 3914, "listcrt-port-2"},
+	{6, 3915, "agcat"},
+	{6, 3916, "wysdmc"},
+	{6, 3917, "aftmux"},
+	{6, 3918, "pktcablemmcops"},
+	{6, 3919, "hyperip"},
+	{6, 3920, "exasoftport1"},
+	{6, 3921, "herodotus-net"},
+	{6, 3922, "sor-update"},
+	{6, 3923, "symb-sb-port"},
+	{6, 3924, "mpl-gprs-port"},
+	{6, 3925, "zmp"},
+	{6, 3926, "winport"},
+	{6, 3927, "natdataservice"},
+	{6, 3928, "netboot-pxe"},
+	{6, 3929, "smauth-port"},
+	{6, 3930, "syam-webserver"},
+	{6, 3931, "msr-plugin-port"},
+	{6, 3932, "dyn-site"},
+	{6, 3933, "plbserve-port"},
+	{6, 3934, "sunfm-port"},
+	{6, 3935, "sdp-portmapper"},
+	{6, 3936, "mailprox"},
+	{6, 3937, "dvbservdsc"},
+	{6, 3938, "dbcontrol-agent"},
+	{6, 3939, "aamp"},
+	{6, 3940, "xecp-node"},
+	{6, 3941, "homeportal-web"},
+	{6, 3942, "srdp"},
+	{6, 3943, "tig"},
+	{6, 3944, "sops"},
+	{6, 3945, "emcads"},
+	{6, 3946, "backupedge"},
+	{6, 3947, "ccp"},
+	{6, 3948, "apdap"},
+	{6, 3949, "drip"},
+	{6, 3950, "namemunge"},
+	{6, 3951, "pwgippfax"},
+	{6, 3952, "i3-sessionmgr"},
+	{6, 3953, "xmlink-connect"},
+	{6, 3954, "adrep"},
+	{6, 3955, "p2pcommunity"},
+	{6, 3956, "gvcp"},
+	{6, 3957, "mqe-broker"},
+	{6, 3958, "mqe-agent"},
+	{6, 3959, "treehopper"},
+	{6, 3960, "bess"},
+	{6, 3961, "proaxess"},
+	{6, 3962, "sbi-agent"},
+	{6, 3963, "thrp"},
+	{6, 3964, "sasggprs"},
+	{6, 3965, "ati-ip-to-ncpe"},
+	{6, 3966, "bflckmgr"},
+	{6, 3967, "ppsms"},
+	{6, 3968, "ianywhere-dbns"},
+	{6, 3969, "landmarks"},
+	{6, 3970, "lanrevagent"},
+	{6, 3971, "lanrevserver"},
+	{6, 3972, "iconp"},
+	{6, 3973, "progistics"},
+	{6, 3974, "xk22"},
+	{6, 3975, "airshot"},
+	{6, 3976, "opswagent"},
+	{6, 3977, "opswmanager"},
+	{6, 3978, "secure-cfg-svr"},
+	{6, 3979, "smwan"},
+	{6, 3981, "starfish"},
+	{6, 3982, "eis"},
+	{6, 3983, "eisp"},
+	{6, 3984, "mapper-nodemgr"},
+	{6, 3985, "mapper-mapethd"},
+	{6, 3986, "mapper-ws-ethd"},
+	{6, 3987, "centerline"},
+	{6, 3988, "dcs-config"},
+	{6, 3989, "bv-queryengine"},
+	{6, 3990, "bv-is"},
+	{6, 3991, "bv-smcsrv"},
+	{6, 3992, "bv-ds"},
+	{6, 3993, "bv-agent"},
+	{6, 3995, "iss-mgmt-ssl"},
+	{6, 3996, "abcsoftware"},
+	{6, 3997, "agentsease-db"},
+	{6, 3998, "dnx"},
+	{6, 3999, "nvcnet"},
+	{6, 4000, "terabase"},
+	{6, 4001, "newoak"},
+	{6, 4002, "pxc-spvr-ft"},
+	{6, 4003, "pxc-splr-ft"},
+	{6, 4004, "pxc-roid"},
+	{6, 4005, "pxc-pin"},
+	{6, 4006, "pxc-spvr"},
+	{6, 4007, "pxc-splr"},
+	{6, 4008, "netcheque"},
+	{6, 4009, "chimera-hwm"},
+	{6, 4010, "samsung-unidex"},
+	{6, 4011, "altserviceboot"},
+	{6, 4012, "pda-gate"},
+	{6, 4013, "acl-manager"},
+	{6, 4014, "taiclock"},
+	{6, 4015, "talarian-mcast1"},
+	{6, 4016, "talarian-mcast2"},
+	{6, 4017, "talarian-mcast3"},
+	{6, 4018, "talarian-mcast4"},
+	{6, 4019, "talarian-mcast5"},
+	{6, 4020, "trap"},
+	{6, 4021, "nexus-portal"},
+	{6, 4022, "dnox"},
+	{6, 4023, "esnm-zoning"},
+	{6, 4024, "tnp1-port"},
+	{6, 4025, "partimage"},
+	{6, 4026, "as-debug"},
+	{6, 4027, "bxp"},
+	{6, 4028, "dtserver-port"},
+	{6, 4029, "ip-qsig"},
+	{6, 4030, "jdmn-port"},
+	{6, 4031, "suucp"},
+	{6, 4032, "vrts-auth-port"},
+	{6, 4033, "sanavigator"},
+	{6, 4034, "ubxd"},
+	{6, 4035, "wap-push-http"},
+	{6, 4036, "wap-push-https"},
+	{6, 4037, "ravehd"},
+	{6, 4038, "fazzt-ptp"},
+	{6, 4039, "fazzt-admin"},
+	{6, 4040, "yo-main"},
+	{6, 4041, "houston"},
+	{6, 4042, "ldxp"},
+	{6, 4043, "nirp"},
+	{6, 4044, "ltp"},
+	{6, 4045, "npp"},
+	{6, 4046, "acp-proto"},
+	{6, 4047, "ctp-state"},
+	{6, 4049, "wafs"},
+	{6, 4050, "cisco-wafs"},
+	{6, 4051, "cppdp"},
+	{6, 4052, "interact"},
+	{6, 4053, "ccu-comm-1"},
+	{6, 4054, "ccu-comm-2"},
+	{6, 4055, "ccu-comm-3"},
+	{6, 4056, "lms"},
+	{6, 4057, "wfm"},
+	{6, 4058, "kingfisher"},
+	{6, 4059, "dlms-cosem"},
+	{6, 4060, "dsmeter-iatc"},
+	{6, 4061, "ice-location"},
+	{6, 4062, "ice-slocation"},
+	{6, 4063, "ice-router"},
+	{6, 4064, "ice-srouter"},
+	{6, 4065, "avanti-cdp"},
+	{6, 4066, "pmas"},
+	{6, 4067, "idp"},
+	{6, 4068, "ipfltbcst"},
+	{6, 4069, "minger"},
+	{6, 4070, "tripe"},
+	{6, 4071, "aibkup"},
+	{6, 4072, "zieto-sock"},
+	{6, 4073, "iRAPP"},
+	{6, 4074, "cequint-cityid"},
+	{6, 4075, "perimlan"},
+	{6, 4076, "seraph"},
+	{6, 4078, "cssp"},
+	{6, 4079, "santools"},
+	{6, 4080, "lorica-in"},
+	{6, 4081, "lorica-in-sec"},
+	{6, 4082, "lorica-out"},
+	{6, 4083, "lorica-out-sec"},
+	{6, 4085, "ezmessagesrv"},
+	{6, 4087, "applusservice"},
+	{6, 4088, "npsp"},
+	{6, 4089, "opencore"},
+	{6, 4090, "omasgport"},
+	{6, 4091, "ewinstaller"},
+	{6, 4092, "ewdgs"},
+	{6, 4093, "pvxpluscs"},
+	{6, 4094, "sysrqd"},
+	{6, 4095, "xtgui"},
+	{6, 4096, "bre"},
+	{6, 4097, "patrolview"},
+	{6, 4098, "drmsfsd"},
+	{6, 4099, "dpcp"},
+	{6, 4100, "igo-incognito"},
+	{6, 4101, "brlp-0"},
+	{6, 4102, "brlp-1"},
+	{6, 4103, "brlp-2"},
+	{6, 4104, "brlp-3"},
+	{6, 4105, "shofar"},
+	{6, 4106, "synchronite"},
+	{6, 4107, "j-ac"},
+	{6, 4108, "accel"},
+	{6, 4109, "izm"},
+	{6, 4110, "g2tag"},
+	{6, 4111, "xgrid"},
+	{6, 4112, "apple-vpns-rp"},
+	{6, 4113, "aipn-reg"},
+	{6, 4114, "jomamqmonitor"},
+	{6, 4115, "cds"},
+	{6, 4116, "smartcard-tls"},
+	{6, 4117, "hillrserv"},
+	{6, 4118, "netscript"},
+	{6, 4119, "assuria-slm"},
+	{6, 4120, "minirem"},
+	{6, 4121, "e-builder"},
+	{6, 4122, "fprams"},
+	{6, 4123, "z-wave"},
+	{6, 4124, "tigv2"},
+	{6, 4125, "opsview-envoy"},
+	{6, 4126, "ddrepl"},
+	{6, 4127, "unikeypro"},
+	{6, 4128, "nufw"},
+	{6, 4129, "nuauth"},
+	{6, 4130, "fronet"},
+	{6, 4131, "stars"},
+	{6, 4132, "nuts-dem"},
+	{6, 4133, "nuts-bootp"},
+	{6, 4134, "nifty-hmi"},
+	{6, 4135, "cl-db-attach"},
+	{6, 4136, "cl-db-request"},
+	{6, 4137, "cl-db-remote"},
+	{6, 4138, "nettest"},
+	{6, 4139, "thrtx"},
+	{6, 4140, "cedros-fds"},
+	{6, 4141, "oirtgsvc"},
+	{6, 4142, "oidocsvc"},
+	{6, 4143, "oidsr"},
+	{6, 4145, "vvr-control"},
+	{6, 4146, "tgcconnect"},
+	{6, 4147, "vrxpservman"},
+	{6, 4148, "hhb-handheld"},
+	{6, 4149, "agslb"},
+	{6, 4150, "PowerAlert-nsa"},
+	{6, 4151, "menandmice-noh"},
+	{6, 4152, "idig-mux"},
+	{6, 4153, "mbl-battd"},
+	{6, 4154, "atlinks"},
+	{6, 4155, "bzr"},
+	{6, 4156, "stat-results"},
+	{6, 4157, "stat-scanner"},
+	{6, 4158, "stat-cc"},
+	{6, 4159, "nss"},
+	{6, 4160, "jini-discovery"},
+	{6, 4161, "omscontact"},
+	{6, 4162, "omstopology"},
+	{6, 4163, "silverpeakpeer"},
+	{6, 4164, "silverpeakcomm"},
+	{6, 4165, "altcp"},
+	{6, 4166, "joost"},
+	{6, 4167, "ddgn"},
+	{6, 4168, "pslicser"},
+	{6, 4169, "iadt"},
+	{6, 4170, "d-cinema-csp"},
+	{6, 4171, "ml-svnet"},
+	{6, 4172, "pcoip"},
+	{6, 4174, "smcluster"},
+	{6, 4175, "bccp"},
+	{6, 4176, "tl-ipcproxy"},
+	{6, 4177, "wello"},
+	{6, 4178, "storman"},
+	{6, 4179, "MaxumSP"},
+	{6, 4180, "httpx"},
+	{6, 4181, "macbak"},
+	{6, 4182, "pcptcpservice"},
+	{6, 4183, "cyborgnet"},
+	{6, 4184, "universe-suite"},
+	{6, 4185, "wcpp"},
+	{6, 4186, "boxbackupstore"},
+	{6, 4187, "csc-proxy"},
+	{6, 4188, "vatata"},
+	{6, 4189, "pcep"},
+	{6, 4190, "sieve"},
+	{6, 4192, "azeti"},
+	{6, 4193, "pvxplusio"},
+	{6, 4194, "spdm"},
+	{6, 4195, "aws-wsp"},
+	{6, 4197, "hctl"},
+	{6, 4199, "eims-admin"},
+	{6, 4300, "corelccam"},
+	{6, 4301, "d-data"},
+	{6, 4302, "d-data-control"},
+	{6, 4303, "srcp"},
+	{6, 4304, "owserver"},
+	{6, 4305, "batman"},
+	{6, 4306, "pinghgl"},
+	{6, 4307, "trueconf"},
+	{6, 4308, "compx-lockview"},
+	{6, 4309, "dserver"},
+	{6, 4310, "mirrtex"},
+	{6, 4311, "p6ssmc"},
+	{6, 4312, "pscl-mgt"},
+	{6, 4313, "perrla"},
+	{6, 4314, "choiceview-agt"},
+	{6, 4316, "choiceview-clt"},
+	{6, 4317, "opentelemetry"},
+	{6, 4319, "fox-skytale"},
+	{6, 4320, "fdt-rcatp"},
+	{6, 4321, "rwhois"},
+	{6, 4322, "trim-event"},
+	{6, 4323, "trim-ice"},
+	{6, 4325, "geognosisadmin"},
+	{6, 4326, "geognosis"},
+	{6, 4327, "jaxer-web"},
+	{6, 4328, "jaxer-manager"},
+	{6, 4329, "publiqare-sync"},
+	{6, 4330, "dey-sapi"},
+	{6, 4331, "ktickets-rest"},
+	{6, 4332, "getty-focus"},
+	{6, 4333, "ahsp"},
+	{6, 4334, "netconf-ch-ssh"},
+	{6, 4335, "netconf-ch-tls"},
+	{6, 4336, "restconf-ch-tls"},
+	{6, 4340, "gaia"},
+	{6, 4343, "unicall"},
+	{6, 4344, "vinainstall"},
+	{6, 4345, "m4-network-as"},
+	{6, 4346, "elanlm"},
+	{6, 4347, "lansurveyor"},
+	{6, 4348, "itose"},
+	{6, 4349, "fsportmap"},
+	{6, 4350, "net-device"},
+	{6, 4351, "plcy-net-svcs"},
+	{6, 4352, "pjlink"},
+	{6, 4353, "f5-iquery"},
+	{6, 4354, "qsnet-trans"},
+	{6, 4355, "qsnet-workst"},
+	{6, 4356, "qsnet-assist"},
+	{6, 4357, "qsnet-cond"},
+	{6, 4358, "qsnet-nucl"},
+	{6, 4359, "omabcastltkm"},
+	{6, 4360, "matrix-vnet"},
+	{6, 4368, "wxbrief"},
+	{6, 4369, "epmd"},
+	{6, 4370, "elpro-tunnel"},
+	{6, 4371, "l2c-control"},
+	{6, 4372, "l2c-data"},
+	{6, 4373, "remctl"},
+	{6, 4374, "psi-ptt"},
+	{6, 4375, "tolteces"},
+	{6, 4376, "bip"},
+	{6, 4377, "cp-spxsvr"},
+	{6, 4378, "cp-spxdpy"},
+	{6, 4379, "ctdb"},
+	{6, 4389, "xandros-cms"},
+	{6, 4390, "wiegand"},
+	{6, 4391, "apwi-imserver"},
+	{6, 4392, "apwi-rxserver"},
+	{6, 4393, "apwi-rxspooler"},
+	{6, 4395, "omnivisionesx"},
+	{6, 4396, "fly"},
+	{6, 4400, "ds-srv"},
+	{6, 4401, "ds-srvr"},
+	{6, 4402, "ds-clnt"},
+	{6, 4403, "ds-user"},
+	{6, 4404, "ds-admin"},
+	{6, 4405, "ds-mail"},
+	{6, 4406, "ds-slp"},
+	{6, 4407, "nacagent"},
+	{6, 4408, "slscc"},
+	{6, 4409, "netcabinet-com"},
+	{6, 4410, "itwo-server"},
+	{6, 4411, "found"},
+	{6, 4413, "avi-nms"},
+	{6, 4414, "updog"},
+	{6, 4415, "brcd-vr-req"},
+	{6, 4416, "pjj-player"},
+	{6, 4417, "workflowdir"},
+	{6, 4419, "cbp"},
+	{6, 4420, "nvme"},
+	{6, 4421, "scaleft"},
+	{6, 4422, "tsepisp"},
+	{6, 4423, "thingkit"},
+	{6, 4425, "netrockey6"},
+	{6, 4426, "beacon-port-2"},
+	{6, 4427, "drizzle"},
+	{6, 4428, "omviserver"},
+	{6, 4429, "omviagent"},
+	{6, 4430, "rsqlserver"},
+	{6, 4431, "wspipe"},
+	{6, 4432, "l-acoustics"},
+	{6, 4433, "vop"},
+	{6, 4442, "saris"},
+	{6, 4443, "pharos"},
+	{6, 4444, "krb524"},
+	{6, 4445, "upnotifyp"},
+	{6, 4446, "n1-fwp"},
+	{6, 4447, "n1-rmgmt"},
+	{6, 4448, "asc-slmd"},
+	{6, 4449, "privatewire"},
+	{6, 4450, "camp"},
+	{6, 4451, "ctisystemmsg"},
+	{6, 4452, "ctiprogramload"},
+	{6, 4453, "nssalertmgr"},
+	{6, 4454, "nssagentmgr"},
+	{6, 4455, "prchat-user"},
+	{6, 4456, "prchat-server"},
+	{6, 4457, "prRegister"},
+	{6, 4458, "mcp"},
+	{6, 4460, "ntske"},
+	{6, 4484, "hpssmgmt"},
+	{6, 4485, "assyst-dr"},
+	{6, 4486, "icms"},
+	{6, 4487, "prex-tcp"},
+	{6, 4488, "awacs-ice"},
+	{6, 4500, "ipsec-nat-t"},
+	{6, 4535, "ehs"},
+	{6, 4536, "ehs-ssl"},
+	{6, 4537, "wssauthsvc"},
+	{6, 4538, "swx-gate"},
+	{6, 4545, "worldscores"},
+	{6, 4546, "sf-lm"},
+	{6, 4547, "lanner-lm"},
+	{6, 4548, "synchromesh"},
+	{6, 4549, "aegate"},
+	{6, 4550, "gds-adppiw-db"},
+	{6, 4551, "ieee-mih"},
+	{6, 4552, "menandmice-mon"},
+	{6, 4553, "icshostsvc"},
+	{6, 4554, "msfrs"},
+	{6, 4555, "rsip"},
+	{6, 4556, "dtn-bundle"},
+	{6, 4559, "hylafax"},
+	{6, 4563, "amahi-anywhere"},
+	{6, 4566, "kwtc"},
+	{6, 4567, "tram"},
+	{6, 4568, "bmc-reporting"},
+	{6, 4569, "iax"},
+	{6, 4570, "deploymentmap"},
+	{6, 4573, "cardifftec-back"},
+	{6, 4590, "rid"},
+	{6, 4591, "l3t-at-an"},
+	{6, 4593, "ipt-anri-anri"},
+	{6, 4594, "ias-session"},
+	{6, 4595, "ias-paging"},
+	{6, 4596, "ias-neighbor"},
+	{6, 4597, "a21-an-1xbs"},
+	{6, 4598, "a16-an-an"},
+	{6, 4599, "a17-an-an"},
+	{6, 4600, "piranha1"},
+	{6, 4601, "piranha2"},
+	{6, 4602, "mtsserver"},
+	{6, 4603, "menandmice-upg"},
+	{6, 4604, "irp"},
+	{6, 4605, "sixchat"},
+	{6, 4606, "sixid"},
+	{6, 4646, "dots-signal"},
+	{6, 4658, "playsta2-app"},
+	{6, 4659, "playsta2-lob"},
+	{6, 4660, "smaclmgr"},
+	{6, 4661, "kar2ouche"},
+	{6, 4662, "oms"},
+	{6, 4663, "noteit"},
+	{6, 4664, "ems"},
+	{6, 4665, "contclientms"},
+	{6, 4666, "eportcomm"},
+	{6, 4667, "mmacomm"},
+	{6, 4668, "mmaeds"},
+	{6, 4669, "eportcommdata"},
+	{6, 4670, "light"},
+	{6, 4671, "acter"},
+	{6, 4672, "rfa"},
+	{6, 4673, "cxws"},
+	{6, 4674, "appiq-mgmt"},
+	{6, 4675, "dhct-status"},
+	{6, 4676, "dhct-alerts"},
+	{6, 4677, "bcs"},
+	{6, 4678, "traversal"},
+	{6, 4679, "mgesupervision"},
+	{6, 4680, "mgemanagement"},
+	{6, 4681, "parliant"},
+	{6, 4682, "finisar"},
+	{6, 4683, "spike"},
+	{6, 4684, "rfid-rp1"},
+	{6, 4685, "autopac"},
+	{6, 4686, "msp-os"},
+	{6, 4687, "nst"},
+	{6, 4688, "mobile-p2p"},
+	{6, 4689, "altovacentral"},
+	{6, 4690, "prelude"},
+	{6, 4691, "mtn"},
+	{6, 4692, "conspiracy"},
+	{6, 4700, "netxms-agent"},
+	{6, 4701, "netxms-mgmt"},
+	{6, 4702, "netxms-sync"},
+	{6, 4703, "npqes-test"},
+	{6, 4704, "assuria-ins"},
+	{6, 4711, "trinity-dist"},
+	{6, 4725, "truckstar"},
+	{6, 4727, "fcis"},
+	{6, 4728, "capmux"},
+	{6, 4730, "gearman"},
+	{6, 4731, "remcap"},
+	{6, 4733, "resorcs"},
+	{6, 4737, "ipdr-sp"},
+	{6, 4738, "solera-lpn"},
+	{6, 4739, "ipfix"},
+	{6, 4740, "ipfixs"},
+	{6, 4741, "lumimgrd"},
+	{6, 4742, "sicct"},
+	{6, 4743, "openhpid"},
+	{6, 4744, "ifsp"},
+	{6, 4745, "fmp"},
+	{6, 4749, "profilemac"},
+	{6, 4750, "ssad"},
+	{6, 4751, "spocp"},
+	{6, 4752, "snap"},
+	{6, 4753, "simon"},
+	{6, 4756, "RDCenter"},
+	{6, 4774, "converge"},
+	{6, 4784, "bfd-multi-ctl"},
+	{6, 4786, "smart-install"},
+	{6, 4787, "sia-ctrl-plane"},
+	{6, 4788, "xmcp"},
+	{6, 4792, "unified-bus"},
+	{6, 4800, "iims"},
+	{6, 4801, "iwec"},
+	{6, 4802, "ilss"},
+	{6, 4803, "notateit"},
+	{6, 4827, "htcp"},
+	{6, 4837, "varadero-0"},
+	{6, 4838, "varadero-1"},
+	{6, 4839, "varadero-2"},
+	{6, 4840, "opcua-tcp"},
+	{6, 4841, "quosa"},
+	{6, 4842, "gw-asv"},
+	{6, 4843, "opcua-tls"},
+	{6, 4844, "gw-log"},
+	{6, 4845, "wcr-remlib"},
+	{6, 4846, "contamac-icm"},
+	{6, 4847, "wfc"},
+	{6, 4848, "appserv-http"},
+	{6, 4849, "appserv-https"},
+	{6, 4850, "sun-as-nodeagt"},
+	{6, 4851, "derby-repli"},
+	{6, 4867, "unify-debug"},
+	{6, 4868, "phrelay"},
+	{6, 4869, "phrelaydbg"},
+	{6, 4870, "cc-tracking"},
+	{6, 4871, "wired"},
+	{6, 4876, "tritium-can"},
+	{6, 4877, "lmcs"},
+	{6, 4879, "wsdl-event"},
+	{6, 4880, "hislip"},
+	{6, 4883, "wmlserver"},
+	{6, 4884, "hivestor"},
+	{6, 4885, "abbs"},
+	{6, 4888, "xcap-portal"},
+	{6, 4889, "xcap-control"},
+	{6, 4894, "lyskom"},
+	{6, 4899, "radmin-port"},
+	{6, 4900, "hfcs"},
+	{6, 4901, "flr-agent"},
+	{6, 4902, "magiccontrol"},
+	{6, 4912, "lutap"},
+	{6, 4913, "lutcp"},
+	{6, 4914, "bones"},
+	{6, 4915, "frcs"},
+	{6, 4940, "eq-office-4940"},
+	{6, 4941, "eq-office-4941"},
+	{6, 4942, "eq-office-4942"},
+	{6, 4949, "munin"},
+	{6, 4950, "sybasesrvmon"},
+	{6, 4951, "pwgwims"},
+	{6, 4952, "sagxtsds"},
+	{6, 4953, "dbsyncarbiter"},
+	{6, 4969, "ccss-qmm"},
+	{6, 4970, "ccss-qsm"},
+	{6, 4971, "burp"},
+	{6, 4984, "webyast"},
+	{6, 4985, "gerhcs"},
+	{6, 4986, "mrip"},
+	{6, 4987, "smar-se-port1"},
+	{6, 4988, "smar-se-port2"},
+	{6, 4989, "parallel"},
+	{6, 4990, "busycal"},
+	{6, 4991, "vrt"},
+	{6, 4999, "hfcs-manager"},
+	{6, 5000, "commplex-main"},
+	{6, 5001, "commplex-link"},
+	{6, 5002, "rfe"},
+	{6, 5003, "fmpro-internal"},
+	{6, 5004, "avt-profile-1"},
+	{6, 5005, "avt-profile-2"},
+	{6, 5006, "wsm-server"},
+	{6, 5007, "wsm-server-ssl"},
+	{6, 5008, "synapsis-edge"},
+	{6, 5009, "winfs"},
+	{6, 5010, "telelpathstart"},
+	{6, 5011, "telelpathattack"},
+	{6, 5012, "nsp"},
+	{6, 5013, "fmpro-v6"},
+	{6, 5015, "fmwp"},
+	{6, 5020, "zenginkyo-1"},
+	{6, 5021, "zenginkyo-2"},
+	{6, 5022, "mice"},
+	{6, 5023, "htuilsrv"},
+	{6, 5024, "scpi-telnet"},
+	{6, 5025, "scpi-raw"},
+	{6, 5026, "strexec-d"},
+	{6, 5027, "strexec-s"},
+	{6, 5028, "qvr"},
+	{6, 5029, "infobright"},
+	{6, 5032, "signacert-agent"},
+	{6, 5033, "jtnetd-server"},
+	{6, 5034, "jtnetd-status"},
+	{6, 5042, "asnaacceler8db"},
+	{6, 5043, "swxadmin"},
+	{6, 5044, "lxi-evntsvc"},
+	{6, 5045, "osp"},
+	{6, 5048, "texai"},
+	{6, 5049, "ivocalize"},
+	{6, 5050, "mmcc"},
+	{6, 5051, "ita-agent"},
+	{6, 5052, "ita-manager"},
+	{6, 5053, "rlm"},
+	{6, 5054, "rlm-admin"},
+	{6, 5055, "unot"},
+	{6, 5056, "intecom-ps1"},
+	{6, 5057, "intecom-ps2"},
+	{6, 5059, "sds"},
+	{6, 5060, "sip"},
+	{6, 5061, "sips"},
+	{6, 5062, "na-localise"},
+	{6, 5063, "csrpc"},
+	{6, 5064, "ca-1"},
+	{6, 5065, "ca-2"},
+	{6, 5066, "stanag-5066"},
+	{6, 5067, "authentx"},
+	{6, 5068, "bitforestsrv"},
+	{6, 5069, "i-net-2000-npr"},
+	{6, 5070, "vtsas"},
+	{6, 5071, "powerschool"},
+	{6, 5072, "ayiya"},
+	{6, 5073, "tag-pm"},
+	{6, 5074, "alesquery"},
+	{6, 5075, "pvaccess"},
+	{6, 5080, "onscreen"},
+	{6, 5081, "sdl-ets"},
+	{6, 5082, "qcp"},
+	{6, 5083, "qfp"},
+	{6, 5084, "llrp"},
+	{6, 5085, "encrypted-llrp"},
+	{6, 5086, "aprigo-cs"},
+	{6, 5087, "biotic"},
+	{6, 5093, "sentinel-lm"},
+	{6, 5094, "hart-ip"},
+	{6, 5099, "sentlm-srv2srv"},
+	{6, 5100, "socalia"},
+	{6, 5101, "talarian-tcp"},
+	{6, 5102, "oms-nonsecure"},
+	{6, 5103, "actifio-c2c"},
+	{6, 5106, "actifioudsagent"},
+	{6, 5107, "actifioreplic"},
+	{6, 5111, "taep-as-svc"},
+	{6, 5112, "pm-cmdsvr"},
+	{6, 5114, "ev-services"},
+	{6, 5115, "autobuild"},
+	{6, 5117, "gradecam"},
+	{6, 5120, "barracuda-bbs"},
+	{6, 5133, "nbt-pc"},
+	{6, 5134, "ppactivation"},
+	{6, 5135, "erp-scale"},
+	{6, 5137, "ctsd"},
+	{6, 5145, "rmonitor-secure"},
+	{6, 5146, "social-alarm"},
+	{6, 5150, "atmp"},
+	{6, 5151, "esri-sde"},
+	{6, 5152, "sde-discovery"},
+	{6, 5154, "bzflag"},
+	{6, 5155, "asctrl-agent"},
+	{6, 5156, "rugameonline"},
+	{6, 5157, "mediat"},
+	{6, 5161, "snmpssh"},
+	{6, 5162, "snmpssh-trap"},
+	{6, 5163, "sbackup"},
+	{6, 5164, "vpa"},
+	{6, 5165, "ife-icorp"},
+	{6, 5166, "winpcs"},
+	{6, 5167, "scte104"},
+	{6, 5168, "scte30"},
+	{6, 5172, "pcoip-mgmt"},
+	{6, 5190, "aol"},
+	{6, 5191, "aol-1"},
+	{6, 5192, "aol-2"},
+	{6, 5193, "aol-3"},
+	{6, 5194, "cpscomm"},
+	{6, 5195, "ampl-lic"},
+	{6, 5196, "ampl-tableproxy"},
+	{6, 5197, "tunstall-lwp"},
+	{6, 5200, "targus-getdata"},
+	{6, 5201, "targus-getdata1"},
+	{6, 5202, "targus-getdata2"},
+	{6, 5203, "targus-getdata3"},
+	{6, 5209, "nomad"},
+	{6, 5215, "noteza"},
+	{6, 5221, "3exmp"},
+	{6, 5222, "xmpp-client"},
+	{6, 5223, "hpvirtgrp"},
+	{6, 5224, "hpvirtctrl"},
+	{6, 5225, "hp-server"},
+	{6, 5226, "hp-status"},
+	{6, 5227, "perfd"},
+	{6, 5228, "hpvroom"},
+	{6, 5229, "jaxflow"},
+	{6, 5230, "jaxflow-data"},
+	{6, 5231, "crusecontrol"},
+	{6, 5232, "csedaemon"},
+	{6, 5233, "enfs"},
+	{6, 5234, "eenet"},
+	{6, 5235, "galaxy-network"},
+	{6, 5236, "padl2sim"},
+	{6, 5237, "mnet-discovery"},
+	{6, 5242, "attune"},
+	{6, 5243, "xycstatus"},
+	{6, 5245, "downtools"},
+	{6, 5248, "caacws"},
+	{6, 5249, "caaclang2"},
+	{6, 5250, "soagateway"},
+	{6, 5251, "caevms"},
+	{6, 5252, "movaz-ssc"},
+	{6, 5253, "kpdp"},
+	{6, 5254, "logcabin"},
+	{6, 5264, "3com-njack-1"},
+	{6, 5265, "3com-njack-2"},
+	{6, 5269, "xmpp-server"},
+	{6, 5270, "cartographerxmp"},
+	{6, 5271, "cuelink"},
+	{6, 5272, "pk"},
+	{6, 5280, "xmpp-bosh"},
+	{6, 5281, "undo-lm"},
+	{6, 5282, "transmit-port"},
+	{6, 5298, "presence"},
+	{6, 5299, "nlg-data"},
+	{6, 5300, "hacl-hb"},
+	{6, 5301, "hacl-gs"},
+	{6, 5302, "hacl-cfg"},
+	{6, 5303, "hacl-probe"},
+	{6, 5304, "hacl-local"},
+	{6, 5305, "hacl-test"},
+	{6, 5306, "sun-mc-grp"},
+	{6, 5307, "sco-aip"},
+	{6, 5308, "cfengine"},
+	{6, 5309, "jprinter"},
+	{6, 5310, "outlaws"},
+	{6, 5312, "permabit-cs"},
+	{6, 5313, "rrdp"},
+	{6, 5314, "opalis-rbt-ipc"},
+	{6, 5315, "hacl-poll"},
+	{6, 5316, "hpbladems"},
+	{6, 5317, "hpdevms"},
+	{6, 5318, "pkix-cmc"},
+	{6, 5320, "bsfserver-zn"},
+	{6, 5321, "bsfsvr-zn-ssl"},
+	{6, 5343, "kfserver"},
+	{6, 5344, "xkotodrcp"},
+	{6, 5349, "stuns"},
+	{6, 5352, "dns-llq"},
+	{6, 5353, "mdns"},
+	{6, 5354, "mdnsresponder"},
+	{6, 5355, "llmnr"},
+	{6, 5356, "ms-smlbiz"},
+	{6, 5357, "wsdapi"},
+	{6, 5358, "wsdapi-s"},
+	{6, 5359, "ms-alerter"},
+	{6, 5360, "ms-sideshow"},
+	{6, 5361, "ms-s-sideshow"},
+	{6, 5362, "serverwsd2"},
+	{6, 5363, "net-projection"},
+	{6, 5397, "stresstester"},
+	{6, 5398, "elektron-admin"},
+	{6, 5399, "securitychase"},
+	{6, 5400, "excerpt"},
+	{6, 5401, "excerpts"},
+	{6, 5402, "mftp"},
+	{6, 5403, "hpoms-ci-lstn"},
+	{6, 5404, "hpoms-dps-lstn"},
+	{6, 5405, "netsupport"},
+	{6, 5406, "systemics-sox"},
+	{6, 5407, "foresyte-clear"},
+	{6, 5408, "foresyte-sec"},
+	{6, 5409, "salient-dtasrv"},
+	{6, 5410, "salient-usrmgr"},
+	{6, 5411, "actnet"},
+	{6, 5412, "continuus"},
+	{6, 5413, "wwiotalk"},
+	{6, 5414, "statusd"},
+	{6, 5415, "ns-server"},
+	{6, 5416, "sns-gateway"},
+	{6, 5417, "sns-agent"},
+	{6, 5418, "mcntp"},
+	{6, 5419, "dj-ice"},
+	{6, 5420, "cylink-c"},
+	{6, 5421, "netsupport2"},
+	{6, 5422, "salient-mux"},
+	{6, 5423, "virtualuser"},
+	{6, 5424, "beyond-remote"},
+	{6, 5425, "br-channel"},
+	{6, 5426, "devbasic"},
+	{6, 5427, "sco-peer-tta"},
+	{6, 5428, "telaconsole"},
+	{6, 5429, "base"},
+	{6, 5430, "radec-corp"},
+	{6, 5431, "park-agent"},
+	{6, 5432, "postgresql"},
+	{6, 5433, "pyrrho"},
+	{6, 5434, "sgi-arrayd"},
+	{6, 5435, "sceanics"},
+	{6, 5443, "spss"},
+	{6, 5445, "smbdirect"},
+	{6, 5450, "tiepie"},
+	{6, 5453, "surebox"},
+	{6, 5454, "apc-5454"},
+	{6, 5455, "apc-5455"},
+	{6, 5456, "apc-5456"},
+	{6, 5461, "silkmeter"},
+	{6, 5462, "ttl-publisher"},
+	{6, 5463, "ttlpriceproxy"},
+	{6, 5464, "quailnet"},
+	{6, 5465, "netops-broker"},
+	{6, 5470, "apsolab-col"},
+	{6, 5471, "apsolab-cols"},
+	{6, 5472, "apsolab-tag"},
+	{6, 5473, "apsolab-tags"},
+	{6, 5475, "apsolab-data"},
+	{6, 5500, "fcp-addr-srvr1"},
+	{6, 5501, "fcp-addr-srvr2"},
+	{6, 5502, "fcp-srvr-inst1"},
+	{6, 5503, "fcp-srvr-inst2"},
+	{6, 5504, "fcp-cics-gw1"},
+	{6, 5505, "checkoutdb"},
+	{6, 5506, "amc"},
+	{6, 5507, "psl-management"},
+	{6, 5540, "matter"},
+	{6, 5550, "cbus"},
+	{6, 5553, "sgi-eventmond"},
+	{6, 5554, "sgi-esphttp"},
+	{6, 5555, "personal-agent"},
+	{6, 5556, "freeciv"},
+	{6, 5557, "farenet"},
+	{6, 5565, "dp-bura"},
+	{6, 5566, "westec-connect"},
+	{6, 5567, "dof-dps-mc-sec"},
+	{6, 5568, "sdt"},
+	{6, 5569, "rdmnet-ctrl"},
+	{6, 5573, "sdmmp"},
+	{6, 5574, "lsi-bobcat"},
+	{6, 5575, "ora-oap"},
+	{6, 5579, "fdtracks"},
+	{6, 5580, "tmosms0"},
+	{6, 5581, "tmosms1"},
+	{6, 5582, "fac-restore"},
+	{6, 5583, "tmo-icon-sync"},
+	{6, 5584, "bis-web"},
+	{6, 5585, "bis-sync"},
+	{6, 5586, "att-mt-sms"},
+	{6, 5597, "ininmessaging"},
+	{6, 5598, "mctfeed"},
+	{6, 5599, "esinstall"},
+	{6, 5600, "esmmanager"},
+	{6, 5601, "esmagent"},
+	{6, 5602, "a1-msc"},
+	{6, 5603, "a1-bs"},
+	{6, 5604, "a3-sdunode"},
+	{6, 5605, "a4-sdunode"},
+	{6, 5618, "efr"},
+	{6, 5627, "ninaf"},
+	{6, 5628, "htrust"},
+	{6, 5629, "symantec-sfdb"},
+	{6, 5630, "precise-comm"},
+	{6, 5631, "pcanywheredata"},
+	{6, 5632, "pcanywherestat"},
+	{6, 5633, "beorl"},
+	{6, 5634, "xprtld"},
+	{6, 5635, "sfmsso"},
+	{6, 5636, "sfm-db-server"},
+	{6, 5637, "cssc"},
+	{6, 5638, "flcrs"},
+	{6, 5639, "ics"},
+	{6, 5646, "vfmobile"},
+	{6, 5666, "nrpe"},
+	{6, 5670, "filemq"},
+	{6, 5671, "amqps"},
+	{6, 5672, "amqp"},
+	{6, 5673, "jms"},
+	{6, 5674, "hyperscsi-port"},
+	{6, 5675, "v5ua"},
+	{6, 5676, "raadmin"},
+	{6, 5677, "questdb2-lnchr"},
+	{6, 5678, "rrac"},
+	{6, 5679, "dccm"},
+	{6, 5680, "auriga-router"},
+	{6, 5681, "ncxcp"},
+	{6, 5683, "coap"},
+	{6, 5684, "coaps"},
+	{6, 5688, "ggz"},
+	{6, 5689, "qmvideo"},
+	{6, 5693, "rbsystem"},
+	{6, 5696, "kmip"},
+	{6, 5700, "supportassist"},
+	{6, 5705, "storageos"},
+	{6, 5713, "proshareaudio"},
+	{6, 5714, "prosharevideo"},
+	{6, 5715, "prosharedata"},
+	{6, 5716, "prosharerequest"},
+	{6, 5717, "prosharenotify"},
+	{6, 5718, "dpm"},
+	{6, 5719, "dpm-agent"},
+	{6, 5720, "ms-licensing"},
+	{6, 5721, "dtpt"},
+	{6, 5722, "msdfsr"},
+	{6, 5723, "omhs"},
+	{6, 5724, "omsdk"},
+	{6, 5725, "ms-ilm"},
+	{6, 5726, "ms-ilm-sts"},
+	{6, 5727, "asgenf"},
+	{6, 5728, "io-dist-data"},
+	{6, 5729, "openmail"},
+	{6, 5730, "unieng"},
+	{6, 5741, "ida-discover1"},
+	{6, 5742, "ida-discover2"},
+	{6, 5743, "watchdoc-pod"},
+	{6, 5744, "watchdoc"},
+	{6, 5745, "fcopy-server"},
+	{6, 5746, "fcopys-server"},
+	{6, 5747, "tunatic"},
+	{6, 5748, "tunalyzer"},
+	{6, 5750, "rscd"},
+	{6, 5755, "openmailg"},
+	{6, 5757, "x500ms"},
+	{6, 5766, "openmailns"},
+	{6, 5767, "s-openmail"},
+	{6, 5768, "openmailpxy"},
+	{6, 5769, "spramsca"},
+	{6, 5770, "spramsd"},
+	{6, 5771, "netagent"},
+	{6, 5777, "starfield-io"},
+	{6, 5780, "vts-rpc"},
+	{6, 5781, "3par-evts"},
+	{6, 5782, "3par-mgmt"},
+	{6, 5783, "3par-mgmt-ssl"},
+	{6, 5785, "3par-rcopy"},
+	{6, 5793, "xtreamx"},
+	{6, 5798, "enlabel-dpl"},
+	{6, 5813, "icmpd"},
+	{6, 5814, "spt-automation"},
+	{6, 5820, "autopassdaemon"},
+	{6, 5841, "shiprush-d-ch"},
+	{6, 5842, "reversion"},
+	{6, 5859, "wherehoo"},
+	{6, 5863, "ppsuitemsg"},
+	{6, 5868, "diameters"},
+	{6, 5883, "jute"},
+	{6, 5900, "rfb"},
+	{6, 5903, "ff-ice"},
+	{6, 5904, "ag-swim"},
+	{6, 5905, "asmgcs"},
+	{6, 5906, "rpas-c2"},
+	{6, 5907, "dsd"},
+	{6, 5908, "ipsma"},
+	{6, 5909, "agma"},
+	{6, 5910, "ats-atn"},
+	{6, 5911, "ats-acars"},
+	{6, 5912, "ais-met"},
+	{6, 5913, "aoc-acars"},
+	{6, 5963, "indy"},
+	{6, 5968, "mppolicy-v5"},
+	{6, 5969, "mppolicy-mgr"},
+	{6, 5984, "couchdb"},
+	{6, 5985, "wsman"},
+	{6, 5986, "wsmans"},
+	{6, 5987, "wbem-rmi"},
+	{6, 5988, "wbem-http"},
+	{6, 5989, "wbem-https"},
+	{6, 5990, "wbem-exp-https"},
+	{6, 5991, "nuxsl"},
+	{6, 5992, "consul-insight"},
+	{6, 5993, "cim-rs"},
+	{6, 5994, "rms-agent"},
+	{6, 5999, "cvsup"},
+	{6, 6064, "ndl-ahp-svc"},
+	{6, 6065, "winpharaoh"},
+	{6, 6066, "ewctsp"},
+	{6, 6068, "gsmp-ancp"},
+	{6, 6069, "trip"},
+	{6, 6070, "messageasap"},
+	{6, 6071, "ssdtp"},
+	{6, 6072, "diagnose-proc"},
+	{6, 6073, "directplay8"},
+	{6, 6074, "max"},
+	{6, 6075, "dpm-acm"},
+	{6, 6076, "msft-dpm-cert"},
+	{6, 6077, "iconstructsrv"},
+	{6, 6084, "reload-config"},
+	{6, 6085, "konspire2b"},
+	{6, 6086, "pdtp"},
+	{6, 6087, "ldss"},
+	{6, 6088, "doglms"},
+	{6, 6099, "raxa-mgmt"},
+	{6, 6100, "synchronet-db"},
+	{6, 6101, "synchronet-rtc"},
+	{6, 6102, "synchronet-upd"},
+	{6, 6103, "rets"},
+	{6, 6104, "dbdb"},
+	{6, 6105, "primaserver"},
+	{6, 6106, "mpsserver"},
+	{6, 6107, "etc-control"},
+	{6, 6108, "sercomm-scadmin"},
+	{6, 6109, "globecast-id"},
+	{6, 6110, "softcm"},
+	{6, 6111, "spc"},
+	{6, 6112, "dtspcd"},
+	{6, 6113, "dayliteserver"},
+	{6, 6114, "wrspice"},
+	{6, 6115, "xic"},
+	{6, 6116, "xtlserv"},
+	{6, 6117, "daylitetouch"},
+	{6, 6121, "spdy"},
+	{6, 6122, "bex-webadmin"},
+	{6, 6123, "backup-express"},
+	{6, 6124, "pnbs"},
+	{6, 6130, "damewaremobgtwy"},
+	{6, 6133, "nbt-wol"},
+	{6, 6140, "pulsonixnls"},
+	{6, 6141, "meta-corp"},
+	{6, 6142, "aspentec-lm"},
+	{6, 6143, "watershed-lm"},
+	{6, 6144, "statsci1-lm"},
+	{6, 6145, "statsci2-lm"},
+	{6, 6146, "lonewolf-lm"},
+	{6, 6147, "montage-lm"},
+	{6, 6148, "ricardo-lm"},
+	{6, 6149, "tal-pod"},
+	{6, 6159, "efb-aci"},
+	{6, 6160, "ecmp"},
+	{6, 6161, "patrol-ism"},
+	{6, 6162, "patrol-coll"},
+	{6, 6163, "pscribe"},
+	{6, 6200, "lm-x"},
+	{6, 6209, "qmtps"},
+	{6, 6222, "radmind"},
+	{6, 6241, "jeol-nsdtp-1"},
+	{6, 6242, "jeol-nsdtp-2"},
+	{6, 6243, "jeol-nsdtp-3"},
+	{6, 6244, "jeol-nsdtp-4"},
+	{6, 6251, "tl1-raw-ssl"},
+	{6, 6252, "tl1-ssh"},
+	{6, 6253, "crip"},
+	{6, 6267, "gld"},
+	{6, 6268, "grid"},
+	{6, 6269, "grid-alt"},
+	{6, 6300, "bmc-grx"},
+	{6, 6301, "bmc-ctd-ldap"},
+	{6, 6306, "ufmp"},
+	{6, 6315, "scup"},
+	{6, 6316, "abb-escp"},
+	{6, 6317, "nav-data-cmd"},
+	{6, 6320, "repsvc"},
+	{6, 6321, "emp-server1"},
+	{6, 6322, "emp-server2"},
+	{6, 6324, "hrd-ncs"},
+	{6, 6325, "dt-mgmtsvc"},
+	{6, 6326, "dt-vra"},
+	{6, 6343, "sflow"},
+	{6, 6344, "streletz"},
+	{6, 6346, "gnutella-svc"},
+	{6, 6347, "gnutella-rtr"},
+	{6, 6350, "adap"},
+	{6, 6355, "pmcs"},
+	{6, 6360, "metaedit-mu"},
+	{6, 6370, "metaedit-se"},
+	{6, 6379, "redis"},
+	{6, 6382, "metatude-mds"},
+	{6, 6389, "clariion-evr01"},
+	{6, 6390, "metaedit-ws"},
+	{6, 6417, "faxcomservice"},
+	{6, 6418, "syserverremote"},
+	{6, 6419, "svdrp"},
+	{6, 6420, "nim-vdrshell"},
+	{6, 6421, "nim-wan"},
+	{6, 6432, "pgbouncer"},
+	{6, 6440, "heliosd"},
+	{6, 6442, "tarp"},
+	{6, 6443, "sun-sr-https"},
+	{6, 6444, "sge-qmaster"},
+	{6, 6445, "sge-execd"},
+	{6, 6446, "mysql-proxy"},
+	{6, 6455, "skip-cert-recv"},
+	{6, 6456, "skip-cert-send"},
+	{6, 6464, "ieee11073-20701"},
+	{6, 6471, "lvision-lm"},
+	{6, 6480, "sun-sr-http"},
+	{6, 6481, "servicetags"},
+	{6, 6482, "ldoms-mgmt"},
+	{6, 6483, "SunVTS-RMI"},
+	{6, 6484, "sun-sr-jms"},
+	{6, 6485, "sun-sr-iiop"},
+	{6, 6486, "sun-sr-iiops"},
+	{6, 6487, "sun-sr-iiop-aut"},
+	{6, 6488, "sun-sr-jmx"},
+	{6, 6489, "sun-sr-admin"},
+	{6, 6500, "boks"},
+	{6, 6501, "boks-servc"},
+	{6, 6502, "boks-servm"},
+	{6, 6503, "boks-clntd"},
+	{6, 6505, "badm-priv"},
+	{6, 6506, "badm-pub"},
+	{6, 6507, "bdir-priv"},
+	{6, 6508, "bdir-pub"},
+	{6, 6509, "mgcs-mfp-port"},
+	{6, 6510, "mcer-port"},
+	{6, 6513, "netconf-tls"},
+	{6, 6514, "syslog-tls"},
+	{6, 6515, "elipse-rec"},
+	{6, 6543, "lds-distrib"},
+	{6, 6544, "lds-dump"},
+	{6, 6547, "apc-6547"},
+	{6, 6548, "apc-6548"},
+	{6, 6549, "apc-6549"},
+	{6, 6550, "fg-sysupdate"},
+	{6, 6551, "sum"},
+	{6, 6556, "checkmk-agent"},
+	{6, 6558, "xdsxdm"},
+	{6, 6566, "sane-port"},
+	{6, 6568, "canit-store"},
+	{6, 6579, "affiliate"},
+	{6, 6580, "parsec-master"},
+	{6, 6581, "parsec-peer"},
+	{6, 6582, "parsec-game"},
+	{6, 6583, "joaJewelSuite"},
+	{6, 6600, "mshvlm"},
+	{6, 6601, "mstmg-sstp"},
+	{6, 6602, "wsscomfrmwk"},
+	{6, 6619, "odette-ftps"},
+	{6, 6620, "kftp-data"},
+	{6, 6621, "kftp"},
+	{6, 6622, "mcftp"},
+	{6, 6623, "ktelnet"},
+	{6, 6624, "datascaler-db"},
+	{6, 6625, "datascaler-ctl"},
+	{6, 6626, "wago-service"},
+	{6, 6627, "nexgen"},
+	{6, 6628, "afesc-mc"},
+	{6, 6629, "nexgen-aux"},
+	{6, 6632, "mxodbc-connect"},
+	{6, 6640, "ovsdb"},
+	{6, 6653, "openflow"},
+	{6, 6655, "pcs-sf-ui-man"},
+	{6, 6656, "emgmsg"},
+	{6, 6670, "vocaltec-gold"},
+	{6, 6671, "p4p-portal"},
+	{6, 6672, "vision-server"},
+	{6, 6673, "vision-elmd"},
+	{6, 6678, "vfbp"},
+	{6, 6679, "osaut"},
+	{6, 6687, "clever-ctrace"},
+	{6, 6688, "clever-tcpip"},
+	{6, 6689, "tsa"},
+	{6, 6690, "cleverdetect"},
+	{6, 6697, "ircs-u"},
+	{6, 6701, "kti-icad-srvr"},
+	{6, 6702, "e-design-net"},
+	{6, 6703, "e-design-web"},
+	{6, 6714, "ibprotocol"},
+	{6, 6715, "fibotrader-com"},
+	{6, 6716, "princity-agent"},
+	{6, 6767, "bmc-perf-agent"},
+	{6, 6768, "bmc-perf-mgrd"},
+	{6, 6769, "adi-gxp-srvprt"},
+	{6, 6770, "plysrv-http"},
+	{6, 6771, "plysrv-https"},
+	{6, 6777, "ntz-tracker"},
+	{6, 6778, "ntz-p2p-storage"},
+	{6, 6785, "dgpf-exchg"},
+	{6, 6786, "smc-jmx"},
+	{6, 6787, "smc-admin"},
+	{6, 6788, "smc-http"},
+	{6, 6789, "radg"},
+	{6, 6790, "hnmp"},
+	{6, 6791, "hnm"},
+	{6, 6801, "acnet"},
+	{6, 6817, "pentbox-sim"},
+	{6, 6831, "ambit-lm"},
+	{6, 6841, "netmo-default"},
+	{6, 6842, "netmo-http"},
+	{6, 6850, "iccrushmore"},
+	{6, 6868, "acctopus-cc"},
+	{6, 6888, "muse"},
+	{6, 6900, "rtimeviewer"},
+	{6, 6901, "jetstream"},
+	{6, 6924, "split-ping"},
+	{6, 6935, "ethoscan"},
+	{6, 6936, "xsmsvc"},
+	{6, 6946, "bioserver"},
+	{6, 6951, "otlp"},
+	{6, 6961, "jmact3"},
+	{6, 6962, "jmevt2"},
+	{6, 6963, "swismgr1"},
+	{6, 6964, "swismgr2"},
+	{6, 6965, "swistrap"},
+	{6, 6966, "swispol"},
+	{6, 6969, "acmsoda"},
+	{6, 6970, "conductor"},
+	{6, 6997, "MobilitySrv"},
+	{6, 6998, "iatp-highpri"},
+	{6, 6999, "iatp-normalpri"},
+	{6, 7000, "afs3-fileserver"},
+	{6, 7001, "afs3-callback"},
+	{6, 7002, "afs3-prserver"},
+	{6, 7003, "afs3-vlserver"},
+	{6, 7004, "afs3-kaserver"},
+	{6, 7005, "afs3-volser"},
+	{6, 7006, "afs3-errors"},
+	{6, 7007, "afs3-bos"},
+	{6, 7008, "afs3-update"},
+	{6, 7009, "afs3-rmtsys"},
+	{6, 7010, "ups-onlinet"},
+	{6, 7011, "talon-disc"},
+	{6, 7012, "talon-engine"},
+	{6, 7013, "microtalon-dis"},
+	{6, 7014, "microtalon-com"},
+	{6, 7015, "talon-webserver"},
+	{6, 7016, "spg"},
+	{6, 7017, "grasp"},
+	{6, 7018, "fisa-svc"},
+	{6, 7019, "doceri-ctl"},
+	{6, 7020, "dpserve"},
+	{6, 7021, "dpserveadmin"},
+	{6, 7022, "ctdp"},
+	{6, 7023, "ct2nmcs"},
+	{6, 7024, "vmsvc"},
+	{6, 7025, "vmsvc-2"},
+	{6, 7026, "loreji-panel"},
+	{6, 7030, "op-probe"},
+	{6, 7031, "iposplanet"},
+	{6, 7070, "arcp"},
+	{6, 7071, "iwg1"},
+	{6, 7072, "iba-cfg"},
+	{6, 7073, "martalk"},
+	{6, 7080, "empowerid"},
+	{6, 7099, "lazy-ptop"},
+	{6, 7100, "font-service"},
+	{6, 7101, "elcn"},
+	{6, 7117, "rothaga"},
+	{6, 7121, "virprot-lm"},
+	{6, 7123, "snif"},
+	{6, 7128, "scenidm"},
+	{6, 7129, "scenccs"},
+	{6, 7161, "cabsm-comm"},
+	{6, 7162, "caistoragemgr"},
+	{6, 7163, "cacsambroker"},
+	{6, 7164, "fsr"},
+	{6, 7165, "doc-server"},
+	{6, 7166, "aruba-server"},
+	{6, 7167, "casrmagent"},
+	{6, 7168, "cnckadserver"},
+	{6, 7169, "ccag-pib"},
+	{6, 7170, "nsrp"},
+	{6, 7171, "drm-production"},
+	{6, 7172, "metalbend"},
+	{6, 7173, "zsecure"},
+	{6, 7174, "clutild"},
+	{6, 7200, "fodms"},
+	{6, 7201, "dlip"},
+	{6, 7202, "pon-ictp"},
+	{6, 7215, "PS-Server"},
+	{6, 7216, "PS-Capture-Pro"},
+	{6, 7227, "ramp"},
+	{6, 7228, "citrixupp"},
+	{6, 7229, "citrixuppg"},
+	{6, 7234, "asa-gateways"},
+	{6, 7236, "display"},
+	{6, 7237, "pads"},
+	{6, 7244, "frc-hicp"},
+	{6, 7262, "cnap"},
+	{6, 7272, "watchme-7272"},
+	{6, 7273, "oma-rlp"},
+	{6, 7274, "oma-rlp-s"},
+	{6, 7275, "oma-ulp"},
+	{6, 7276, "oma-ilp"},
+	{6, 7277, "oma-ilp-s"},
+	{6, 7278, "oma-dcdocbs"},
+	{6, 7279, "ctxlic"},
+	{6, 7280, "itactionserver1"},
+	{6, 7281, "itactionserver2"},
+	{6, 7282, "mzca-action"},
+	{6, 7283, "genstat"},
+	{6, 7365, "lcm-server"},
+	{6, 7391, "mindfilesys"},
+	{6, 7392, "mrssrendezvous"},
+	{6, 7393, "nfoldman"},
+	{6, 7394, "fse"},
+	{6, 7395, "winqedit"},
+	{6, 7397, "hexarc"},
+	{6, 7400, "rtps-discovery"},
+	{6, 7401, "rtps-dd-ut"},
+	{6, 7402, "rtps-dd-mt"},
+	{6, 7410, "ionixnetmon"},
+	{6, 7411, "daqstream"},
+	{6, 7421, "mtportmon"},
+	{6, 7426, "pmdmgr"},
+	{6, 7427, "oveadmgr"},
+	{6, 7428, "ovladmgr"},
+	{6, 7429, "opi-sock"},
+	{6, 7430, "xmpv7"},
+	{6, 7431, "pmd"},
+	{6, 7437, "faximum"},
+	{6, 7443, "oracleas-https"},
+	{6, 7471, "sttunnel"},
+	{6, 7473, "rise"},
+	{6, 7474, "neo4j"},
+	{6, 7478, "openit"},
+	{6, 7491, "telops-lmd"},
+	{6, 7500, "silhouette"},
+	{6, 7501, "ovbus"},
+	{6, 7508, "adcp"},
+	{6, 7509, "acplt"},
+	{6, 7510, "ovhpas"},
+	{6, 7511, "pafec-lm"},
+	{6, 7542, "saratoga"},
+	{6, 7543, "atul"},
+	{6, 7544, "nta-ds"},
+	{6, 7545, "nta-us"},
+	{6, 7546, "cfs"},
+	{6, 7547, "cwmp"},
+	{6, 7548, "tidp"},
+	{6, 7549, "nls-tl"},
+	{6, 7551, "controlone-con"},
+	{6, 7560, "sncp"},
+	{6, 7563, "cfw"},
+	{6, 7566, "vsi-omega"},
+	{6, 7569, "dell-eql-asm"},
+	{6, 7570, "aries-kfinder"},
+	{6, 7574, "coherence"},
+	{6, 7588, "sun-lm"},
+	{6, 7606, "mipi-debug"},
+	{6, 7624, "indi"},
+	{6, 7626, "simco"},
+	{6, 7627, "soap-http"},
+	{6, 7628, "zen-pawn"},
+	{6, 7629, "xdas"},
+	{6, 7630, "hawk"},
+	{6, 7631, "tesla-sys-msg"},
+	{6, 7633, "pmdfmgt"},
+	{6, 7648, "cuseeme"},
+	{6, 7663, "rome"},
+	{6, 7672, "imqstomp"},
+	{6, 7673, "imqstomps"},
+	{6, 7674, "imqtunnels"},
+	{6, 7675, "imqtunnel"},
+	{6, 7676, "imqbrokerd"},
+	{6, 7677, "sun-user-https"},
+	{6, 7680, "ms-do"},
+	{6, 7683, "dmt"},
+	{6, 7687, "bolt"},
+	{6, 7689, "collaber"},
+	{6, 7690, "sovd"},
+	{6, 7697, "klio"},
+	{6, 7700, "em7-secom"},
+	{6, 7707, "sync-em7"},
+	{6, 7708, "scinet"},
+	{6, 7720, "medimageportal"},
+	{6, 7724, "nsdeepfreezectl"},
+	{6, 7725, "nitrogen"},
+	{6, 7726, "freezexservice"},
+	{6, 7727, "trident-data"},
+	{6, 7728, "osvr"},
+	{6, 7734, "smip"},
+	{6, 7738, "aiagent"},
+	{6, 7741, "scriptview"},
+	{6, 7742, "msss"},
+	{6, 7743, "sstp-1"},
+	{6, 7744, "raqmon-pdu"},
+	{6, 7747, "prgp"},
+	{6, 7775, "inetfs"},
+	{6, 7777, "cbt"},
+	{6, 7778, "interwise"},
+	{6, 7779, "vstat"},
+	{6, 7781, "accu-lmgr"},
+	{6, 7786, "minivend"},
+	{6, 7787, "popup-reminders"},
+	{6, 7789, "office-tools"},
+	{6, 7794, "q3ade"},
+	{6, 7797, "pnet-conn"},
+	{6, 7798, "pnet-enc"},
+	{6, 7799, "altbsdp"},
+	{6, 7800, "asr"},
+	{6, 7801, "ssp-client"},
+	{6, 7810, "rbt-wanopt"},
+	{6, 7845, "apc-7845"},
+	{6, 7846, "apc-7846"},
+	{6, 7847, "csoauth"},
+	{6, 7869, "mobileanalyzer"},
+	{6, 7870, "rbt-smc"},
+	{6, 7871, "mdm"},
+	{6, 7878, "owms"},
+	{6, 7880, "pss"},
+	{6, 7887, "ubroker"},
+	{6, 7900, "mevent"},
+	{6, 7901, "tnos-sp"},
+	{6, 7902, "tnos-dp"},
+	{6, 7903, "tnos-dps"},
+	{6, 7913, "qo-secure"},
+	{6, 7932, "t2-drm"},
+	{6, 7933, "t2-brm"},
+	{6, 7962, "generalsync"},
+	{6, 7967, "supercell"},
+	{6, 7979, "micromuse-ncps"},
+	{6, 7980, "quest-vista"},
+	{6, 7981, "sossd-collect"},
+	{6, 7982, "sossd-agent"},
+	{6, 7997, "pushns"},
+	{6, 7999, "irdmi2"},
+	{6, 8000, "irdmi"},
+	{6, 8001, "vcom-tunnel"},
+	{6, 8002, "teradataordbms"},
+	{6, 8003, "mcreport"},
+	{6, 8004, "p2pevolvenet"},
+	{6, 8005, "mxi"},
+	{6, 8006, "wpl-analytics"},
+	{6, 8007, "warppipe"},
+	{6, 8008, "http-alt"},
+	{6, 8009, "nvme-disc"},
+	{6, 8015, "cfg-cloud"},
+	{6, 8016, "ads-s"},
+	{6, 8019, "qbdb"},
+	{6, 8020, "intu-ec-svcdisc"},
+	{6, 8021, "intu-ec-client"},
+	{6, 8022, "oa-system"},
+	{6, 8023, "arca-api"},
+	{6, 8025, "ca-audit-da"},
+	{6, 8026, "ca-audit-ds"},
+	{6, 8027, "papachi-p2p-srv"},
+	{6, 8032, "pro-ed"},
+	{6, 8033, "mindprint"},
+	{6, 8034, "vantronix-mgmt"},
+	{6, 8040, "ampify"},
+	{6, 8041, "enguity-xccetp"},
+	{6, 8042, "fs-agent"},
+	{6, 8043, "fs-server"},
+	{6, 8044, "fs-mgmt"},
+	{6, 8051, "rocrail"},
+	{6, 8052, "senomix01"},
+	{6, 8053, "senomix02"},
+	{6, 8054, "senomix03"},
+	{6, 8055, "senomix04"},
+	{6, 8056, "senomix05"},
+	{6, 8057, "senomix06"},
+	{6, 8058, "senomix07"},
+	{6, 8059, "senomix08"},
+	{6, 8066, "toad-bi-appsrvr"},
+	{6, 8067, "infi-async"},
+	{6, 8070, "ucs-isc"},
+	{6, 8074, "gadugadu"},
+	{6, 8077, "mles"},
+	{6, 8080, "http-alt"},
+	{6, 8081, "sunproxyadmin"},
+	{6, 8082, "us-cli"},
+	{6, 8083, "us-srv"},
+	{6, 8084, "websnp"},
+	{6, 8086, "d-s-n"},
+	{6, 8087, "simplifymedia"},
+	{6, 8088, "radan-http"},
+	{6, 8090, "opsmessaging"},
+	{6, 8091, "jamlink"},
+	{6, 8097, "sac"},
+	{6, 8100, "xprint-server"},
+	{6, 8101, "ldoms-migr"},
+	{6, 8102, "kz-migr"},
+	{6, 8115, "mtl8000-matrix"},
+	{6, 8116, "cp-cluster"},
+	{6, 8117, "purityrpc"},
+	{6, 8118, "privoxy"},
+	{6, 8121, "apollo-data"},
+	{6, 8122, "apollo-admin"},
+	{6, 8128, "paycash-online"},
+	{6, 8129, "paycash-wbp"},
+	{6, 8130, "indigo-vrmi"},
+	{6, 8131, "indigo-vbcp"},
+	{6, 8132, "dbabble"},
+	{6, 8140, "puppet"},
+	{6, 8148, "isdd"},
+	{6, 8153, "quantastor"},
+	{6, 8160, "patrol"},
+	{6, 8161, "patrol-snmp"},
+	{6, 8162, "lpar2rrd"},
+	{6, 8181, "intermapper"},
+	{6, 8182, "vmware-fdm"},
+	{6, 8183, "proremote"},
+	{6, 8184, "itach"},
+	{6, 8190, "gcp-rphy"},
+	{6, 8191, "limnerpressure"},
+	{6, 8192, "spytechphone"},
+	{6, 8194, "blp1"},
+	{6, 8195, "blp2"},
+	{6, 8199, "vvr-data"},
+	{6, 8200, "trivnet1"},
+	{6, 8201, "trivnet2"},
+	{6, 8204, "lm-perfworks"},
+	{6, 8205, "lm-instmgr"},
+	{6, 8206, "lm-dta"},
+	{6, 8207, "lm-sserver"},
+	{6, 8208, "lm-webwatcher"},
+	{6, 8230, "rexecj"},
+	{6, 8243, "synapse-nhttps"},
+	{6, 8270, "robot-remote"},
+	{6, 8276, "ms-mcc"},
+	{6, 8280, "synapse-nhttp"},
+	{6, 8282, "libelle"},
+	{6, 8292, "blp3"},
+	{6, 8293, "hiperscan-id"},
+	{6, 8294, "blp4"},
+	{6, 8300, "tmi"},
+	{6, 8301, "amberon"},
+	{6, 8313, "hub-open-net"},
+	{6, 8320, "tnp-discover"},
+	{6, 8321, "tnp"},
+	{6, 8322, "garmin-marine"},
+	{6, 8351, "server-find"},
+	{6, 8376, "cruise-enum"},
+	{6, 8377, "cruise-swroute"},
+	{6, 8378, "cruise-config"},
+	{6, 8379, "cruise-diags"},
+	{6, 8380, "cruise-update"},
+	{6, 8383, "m2mservices"},
+	{6, 8400, "cvd"},
+	{6, 8401, "sabarsd"},
+	{6, 8402, "abarsd"},
+	{6, 8403, "admind"},
+	{6, 8404, "svcloud"},
+	{6, 8405, "svbackup"},
+	{6, 8415, "dlpx-sp"},
+	{6, 8416, "espeech"},
+	{6, 8417, "espeech-rtp"},
+	{6, 8423, "aritts"},
+	{6, 8432, "pgbackrest"},
+	{6, 8442, "cybro-a-bus"},
+	{6, 8443, "pcsync-https"},
+	{6, 8444, "pcsync-http"},
+	{6, 8445, "copy"},
+	{6, 8450, "npmp"},
+	{6, 8457, "nexentamv"},
+	{6, 8470, "cisco-avp"},
+	{6, 8471, "pim-port"},
+	{6, 8472, "otv"},
+	{6, 8473, "vp2p"},
+	{6, 8474, "noteshare"},
+	{6, 8500, "fmtp"},
+	{6, 8501, "cmtp-mgt"},
+	{6, 8502, "ftnmtp"},
+	{6, 8554, "rtsp-alt"},
+	{6, 8555, "d-fence"},
+	{6, 8567, "dof-tunnel"},
+	{6, 8600, "asterix"},
+	{6, 8610, "canon-mfnp"},
+	{6, 8611, "canon-bjnp1"},
+	{6, 8612, "canon-bjnp2"},
+	{6, 8613, "canon-bjnp3"},
+	{6, 8614, "canon-bjnp4"},
+	{6, 8615, "imink"},
+	{6, 8665, "monetra"},
+	{6, 8666, "monetra-admin"},
+	{6, 8675, "msi-cps-rm"},
+	{6, 8686, "sun-as-jmxrmi"},
+	{6, 8688, "openremote-ctrl"},
+	{6, 8699, "vnyx"},
+	{6, 8710, "semi-grpc"},
+	{6, 8711, "nvc"},
+	{6, 8733, "ibus"},
+	{6, 8750, "dey-keyneg"},
+	{6, 8763, "mc-appserver"},
+	{6, 8764, "openqueue"},
+	{6, 8765, "ultraseek-http"},
+	{6, 8766, "amcs"},
+	{6, 8767, "core-of-source"},
+	{6, 8768, "sandpolis"},
+	{6, 8769, "oktaauthenticat"},
+	{6, 8770, "dpap"},
+	{6, 8778, "uec"},
+	{6, 8786, "msgclnt"},
+	{6, 8787, "msgsrvr"},
+	{6, 8793, "acd-pm"},
+	{6, 8800, "sunwebadmin"},
+	{6, 8804, "truecm"},
+	{6, 8873, "dxspider"},
+	{6, 8880, "cddbp-alt"},
+	{6, 8881, "galaxy4d"},
+	{6, 8883, "secure-mqtt"},
+	{6, 8888, "ddi-tcp-1"},
+	{6, 8889, "ddi-tcp-2"},
+	{6, 8890, "ddi-tcp-3"},
+	{6, 8891, "ddi-tcp-4"},
+	{6, 8892, "ddi-tcp-5"},
+	{6, 8893, "ddi-tcp-6"},
+	{6, 8894, "ddi-tcp-7"},
+	{6, 8899, "ospf-lite"},
+	{6, 8900, "jmb-cds1"},
+	{6, 8901, "jmb-cds2"},
+	{6, 8908, "dpp"},
+	{6, 8910, "manyone-http"},
+	{6, 8911, "manyone-xml"},
+	{6, 8912, "wcbackup"},
+	{6, 8913, "dragonfly"},
+	{6, 8937, "twds"},
+	{6, 8953, "ub-dns-control"},
+	{6, 8954, "cumulus-admin"},
+	{6, 8980, "nod-provider"},
+	{6, 8989, "sunwebadmins"},
+	{6, 8990, "http-wmap"},
+	{6, 8991, "https-wmap"},
+	{6, 8997, "oracle-ms-ens"},
+	{6, 8998, "canto-roboflow"},
+	{6, 8999, "bctp"},
+	{6, 9000, "cslistener"},
+	{6, 9001, "etlservicemgr"},
+	{6, 9002, "dynamid"},
+	{6, 9005, "golem"},
+	{6, 9008, "ogs-server"},
+	{6, 9009, "pichat"},
+	{6, 9010, "sdr"},
+	{6, 9020, "tambora"},
+	{6, 9021, "panagolin-ident"},
+	{6, 9022, "paragent"},
+	{6, 9023, "swa-1"},
+	{6, 9024, "swa-2"},
+	{6, 9025, "swa-3"},
+	{6, 9026, "swa-4"},
+	{6, 9050, "versiera"},
+	{6, 9051, "fio-cmgmt"},
+	{6, 9060, "CardWeb-IO"},
+	{6, 9080, "glrpc"},
+	{6, 9083, "emc-pp-mgmtsvc"},
+	{6, 9084, "aurora"},
+	{6, 9085, "ibm-rsyscon"},
+	{6, 9086, "net2display"},
+	{6, 9087, "classic"},
+	{6, 9088, "sqlexec"},
+	{6, 9089, "sqlexec-ssl"},
+	{6, 9090, "websm"},
+	{6, 9091, "xmltec-xmlmail"},
+	{6, 9092, "XmlIpcRegSvc"},
+	{6, 9093, "copycat"},
+	{6, 9100, "hp-pdl-datastr"},
+	{6, 9101, "bacula-dir"},
+	{6, 9102, "bacula-fd"},
+	{6, 9103, "bacula-sd"},
+	{6, 9104, "peerwire"},
+	{6, 9105, "xadmin"},
+	{6, 9106, "astergate"},
+	{6, 9107, "astergatefax"},
+	{6, 9111, "hexxorecore"},
+	{6, 9119, "mxit"},
+	{6, 9122, "grcmp"},
+	{6, 9123, "grcp"},
+	{6, 9131, "dddp"},
+	{6, 9160, "apani1"},
+	{6, 9161, "apani2"},
+	{6, 9162, "apani3"},
+	{6, 9163, "apani4"},
+	{6, 9164, "apani5"},
+	{6, 9191, "sun-as-jpda"},
+	{6, 9200, "wap-wsp"},
+	{6, 9201, "wap-wsp-wtp"},
+	{6, 9202, "wap-wsp-s"},
+	{6, 9203, "wap-wsp-wtp-s"},
+	{6, 9204, "wap-vcard"},
+	{6, 9205, "wap-vcal"},
+	{6, 9206, "wap-vcard-s"},
+	{6, 9207, "wap-vcal-s"},
+	{6, 9208, "rjcdb-vcards"},
+	{6, 9209, "almobile-system"},
+	{6, 9210, "oma-mlp"},
+	{6, 9211, "oma-mlp-s"},
+	{6, 9212, "serverviewdbms"},
+	{6, 9213, "serverstart"},
+	{6, 9214, "ipdcesgbs"},
+	{6, 9215, "insis"},
+	{6, 9216, "acme"},
+	{6, 9217, "fsc-port"},
+	{6, 9222, "teamcoherence"},
+	{6, 9255, "mon"},
+	{6, 9278, "pegasus"},
+	{6, 9279, "pegasus-ctl"},
+	{6, 9280, "pgps"},
+	{6, 9281, "swtp-port1"},
+	{6, 9282, "swtp-port2"},
+	{6, 9283, "callwaveiam"},
+	{6, 9284, "visd"},
+	{6, 9285, "n2h2server"},
+	{6, 9287, "cumulus"},
+	{6, 9292, "armtechdaemon"},
+	{6, 9293, "storview"},
+	{6, 9294, "armcenterhttp"},
+	{6, 9295, "armcenterhttps"},
+	{6, 9300, "vrace"},
+	{6, 9306, "sphinxql"},
+	{6, 9310, "sapms"},
+	{6, 9312, "sphinxapi"},
+	{6, 9318, "secure-ts"},
+	{6, 9321, "guibase"},
+	{6, 9339, "gnmi-gnoi"},
+	{6, 9340, "gribi"},
+	{6, 9343, "mpidcmgr"},
+	{6, 9344, "mphlpdmc"},
+	{6, 9345, "rancher"},
+	{6, 9346, "ctechlicensing"},
+	{6, 9374, "fjdmimgr"},
+	{6, 9380, "boxp"},
+	{6, 9387, "d2dconfig"},
+	{6, 9388, "d2ddatatrans"},
+	{6, 9389, "adws"},
+	{6, 9390, "otp"},
+	{6, 9396, "fjinvmgr"},
+	{6, 9397, "mpidcagt"},
+	{6, 9400, "sec-t4net-srv"},
+	{6, 9401, "sec-t4net-clt"},
+	{6, 9402, "sec-pc2fax-srv"},
+	{6, 9418, "git"},
+	{6, 9443, "tungsten-https"},
+	{6, 9444, "wso2esb-console"},
+	{6, 9445, "mindarray-ca"},
+	{6, 9450, "sntlkeyssrvr"},
+	{6, 9500, "ismserver"},
+	{6, 9535, "mngsuite"},
+	{6, 9536, "laes-bf"},
+	{6, 9555, "trispen-sra"},
+	{6, 9559, "p4runtime"},
+	{6, 9592, "ldgateway"},
+	{6, 9593, "cba8"},
+	{6, 9594, "msgsys"},
+	{6, 9595, "pds"},
+	{6, 9596, "mercury-disc"},
+	{6, 9597, "pd-admin"},
+	{6, 9598, "vscp"},
+	{6, 9599, "robix"},
+	{6, 9600, "micromuse-ncpw"},
+	{6, 9612, "streamcomm-ds"},
+	{6, 9614, "iadt-tls"},
+	{6, 9616, "erunbook-agent"},
+	{6, 9617, "erunbook-server"},
+	{6, 9618, "condor"},
+	{6, 9628, "odbcpathway"},
+	{6, 9629, "uniport"},
+	{6, 9630, "peoctlr"},
+	{6, 9631, "peocoll"},
+	{6, 9640, "pqsflows"},
+	{6, 9666, "zoomcp"},
+	{6, 9667, "xmms2"},
+	{6, 9668, "tec5-sdctp"},
+	{6, 9694, "client-wakeup"},
+	{6, 9695, "ccnx"},
+	{6, 9700, "board-roar"},
+	{6, 9747, "l5nas-parchan"},
+	{6, 9750, "board-voip"},
+	{6, 9753, "rasadv"},
+	{6, 9762, "tungsten-http"},
+	{6, 9800, "davsrc"},
+	{6, 9801, "sstp-2"},
+	{6, 9802, "davsrcs"},
+	{6, 9875, "sapv1"},
+	{6, 9876, "sd"},
+	{6, 9877, "x510"},
+	{6, 9888, "cyborg-systems"},
+	{6, 9889, "gt-proxy"},
+	{6, 9898, "monkeycom"},
+	{6, 9900, "iua"},
+	{6, 9909, "domaintime"},
+	{6, 9911, "sype-transport"},
+	{6, 9925, "xybrid-cloud"},
+	{6, 9950, "apc-9950"},
+	{6, 9951, "apc-9951"},
+	{6, 9952, "apc-9952"},
+	{6, 9953, "acis"},
+	{6, 9954, "hinp"},
+	{6, 9955, "alljoyn-stm"},
+	{6, 9966, "odnsp"},
+	{6, 9978, "xybrid-rt"},
+	{6, 9979, "visweather"},
+	{6, 9981, "pumpkindb"},
+	{6, 9987, "dsm-scm-target"},
+	{6, 9988, "nsesrvr"},
+	{6, 9990, "osm-appsrvr"},
+	{6, 9991, "osm-oev"},
+	{6, 9992, "palace-1"},
+	{6, 9993, "palace-2"},
+	{6, 9994, "palace-3"},
+	{6, 9995, "palace-4"},
+	{6, 9996, "palace-5"},
+	{6, 9997, "palace-6"},
+	{6, 9998, "distinct32"},
+	{6, 9999, "distinct"},
+	{6, 10000, "ndmp"},
+	{6, 10001, "scp-config"},
+	{6, 10002, "documentum"},
+	{6, 10003, "documentum-s"},
+	{6, 10004, "emcrmirccd"},
+	{6, 10005, "emcrmird"},
+	{6, 10006, "netapp-sync"},
+	{6, 10007, "mvs-capacity"},
+	{6, 10008, "octopus"},
+	{6, 10009, "swdtp-sv"},
+	{6, 10010, "rxapi"},
+	{6, 10020, "abb-hw"},
+	{6, 10050, "zabbix-agent"},
+	{6, 10051, "zabbix-trapper"},
+	{6, 10055, "qptlmd"},
+	{6, 10080, "amanda"},
+	{6, 10081, "famdc"},
+	{6, 10100, "itap-ddtp"},
+	{6, 10101, "ezmeeting-2"},
+	{6, 10102, "ezproxy-2"},
+	{6, 10103, "ezrelay"},
+	{6, 10104, "swdtp"},
+	{6, 10107, "bctp-server"},
+	{6, 10110, "nmea-0183"},
+	{6, 10113, "netiq-endpoint"},
+	{6, 10114, "netiq-qcheck"},
+	{6, 10115, "netiq-endpt"},
+	{6, 10116, "netiq-voipa"},
+	{6, 10117, "iqrm"},
+	{6, 10125, "cimple"},
+	{6, 10128, "bmc-perf-sd"},
+	{6, 10129, "bmc-gms"},
+	{6, 10160, "qb-db-server"},
+	{6, 10161, "snmptls"},
+	{6, 10162, "snmptls-trap"},
+	{6, 10200, "trisoap"},
+	{6, 10201, "rsms"},
+	{6, 10252, "apollo-relay"},
+	{6, 10260, "axis-wimp-port"},
+	{6, 10261, "tile-ml"},
+	{6, 10288, "blocks"},
+	{6, 10321, "cosir"},
+	{6, 10443, "cirrossp"},
+	{6, 10540, "MOS-lower"},
+	{6, 10541, "MOS-upper"},
+	{6, 10542, "MOS-aux"},
+	{6, 10543, "MOS-soap"},
+	{6, 10544, "MOS-soap-opt"},
+	{6, 10548, "serverdocs"},
+	{6, 10631, "printopia"},
+	{6, 10800, "gap"},
+	{6, 10805, "lpdg"},
+	{6, 10809, "nbd"},
+	{6, 10860, "helix"},
+	{6, 10880, "bveapi"},
+	{6, 10933, "octopustentacle"},
+	{6, 10990, "rmiaux"},
+	{6, 11000, "irisa"},
+	{6, 11001, "metasys"},
+	{6, 11095, "weave"},
+	{6, 11103, "origo-sync"},
+	{6, 11104, "netapp-icmgmt"},
+	{6, 11105, "netapp-icdata"},
+	{6, 11106, "sgi-lk"},
+	{6, 11109, "sgi-dmfmgr"},
+	{6, 11110, "sgi-soap"},
+	{6, 11111, "vce"},
+	{6, 11112, "dicom"},
+	{6, 11161, "suncacao-snmp"},
+	{6, 11162, "suncacao-jmxmp"},
+	{6, 11163, "suncacao-rmi"},
+	{6, 11164, "suncacao-csa"},
+	{6, 11165, "suncacao-websvc"},
+	{6, 11172, "oemcacao-jmxmp"},
+	{6, 11173, "t5-straton"},
+	{6, 11174, "oemcacao-rmi"},
+	{6, 11175, "oemcacao-websvc"},
+	{6, 11201, "smsqp"},
+	{6, 11202, "dcsl-backup"},
+	{6, 11208, "wifree"},
+	{6, 11211, "memcache"},
+	{6, 11235, "xcompute"},
+	{6, 11319, "imip"},
+	{6, 11320, "imip-channels"},
+	{6, 11321, "arena-server"},
+	{6, 11367, "atm-uhas"},
+	{6, 11371, "hkp"},
+	{6, 11489, "asgcypresstcps"},
+	{6, 11600, "tempest-port"},
+	{6, 11623, "emc-xsw-dconfig"},
+	{6, 11720, "h323callsigalt"},
+	{6, 11723, "emc-xsw-dcache"},
+	{6, 11751, "intrepid-ssl"},
+	{6, 11796, "lanschool"},
+	{6, 11876, "xoraya"},
+	{6, 11967, "sysinfo-sp"},
+	{6, 11971, "tibsd"},
+	{6, 12000, "entextxid"},
+	{6, 12001, "entextnetwk"},
+	{6, 12002, "entexthigh"},
+	{6, 12003, "entextmed"},
+	{6, 12004, "entextlow"},
+	{6, 12005, "dbisamserver1"},
+	{6, 12006, "dbisamserver2"},
+	{6, 12007, "accuracer"},
+	{6, 12008, "accuracer-dbms"},
+	{6, 12010, "edbsrvr"},
+	{6, 12012, "vipera"},
+	{6, 12013, "vipera-ssl"},
+	{6, 12109, "rets-ssl"},
+	{6, 12121, "nupaper-ss"},
+	{6, 12168, "cawas"},
+	{6, 12172, "hivep"},
+	{6, 12300, "linogridengine"},
+	{6, 12302, "rads"},
+	{6, 12321, "warehouse-sss"},
+	{6, 12322, "warehouse"},
+	{6, 12345, "italk"},
+	{6, 12546, "carb-repl-ctrl"},
+	{6, 12753, "tsaf"},
+	{6, 12865, "netperf"},
+	{6, 13160, "i-zipqd"},
+	{6, 13216, "bcslogc"},
+	{6, 13217, "rs-pias"},
+	{6, 13218, "emc-vcas-tcp"},
+	{6, 13223, "powwow-client"},
+	{6, 13224, "powwow-server"},
+	{6, 13400, "doip-data"},
+	{6, 13720, "bprd"},
+	{6, 13721, "bpdbm"},
+	{6, 13722, "bpjava-msvc"},
+	{6, 13724, "vnetd"},
+	{6, 13782, "bpcd"},
+	{6, 13783, "vopied"},
+	{6, 13785, "nbdb"},
+	{6, 13786, "nomdb"},
+	{6, 13818, "dsmcc-config"},
+	{6, 13819, "dsmcc-session"},
+	{6, 13820, "dsmcc-passthru"},
+	{6, 13821, "dsmcc-download"},
+	{6, 13822, "dsmcc-ccp"},
+	{6, 13823, "bmdss"},
+	{6, 13832, "a-trust-rpc"},
+	{6, 13894, "ucontrol"},
+	{6, 13929, "dta-systems"},
+	{6, 13930, "medevolve"},
+	{6, 14000, "scotty-ft"},
+	{6, 14001, "sua"},
+	{6, 14033, "sage-best-com1"},
+	{6, 14034, "sage-best-com2"},
+	{6, 14141, "vcs-app"},
+	{6, 14142, "icpp"},
+	{6, 14143, "icpps"},
+	{6, 14145, "gcm-app"},
+	{6, 14149, "vrts-tdd"},
+	{6, 14150, "vcscmd"},
+	{6, 14154, "vad"},
+	{6, 14250, "cps"},
+	{6, 14414, "ca-web-update"},
+	{6, 14500, "xpra"},
+	{6, 14936, "hde-lcesrvr-1"},
+	{6, 14937, "hde-lcesrvr-2"},
+	{6, 15000, "hydap"},
+	{6, 15002, "onep-tls"},
+	{6, 15345, "xpilot"},
+	{6, 15363, "3link"},
+	{6, 15555, "cisco-snat"},
+	{6, 15660, "bex-xr"},
+	{6, 15740, "ptp"},
+	{6, 15999, "programmar"},
+	{6, 16000, "fmsas"},
+	{6, 16001, "fmsascon"},
+	{6, 16002, "gsms"},
+	{6, 16020, "jwpc"},
+	{6, 16021, "jwpc-bin"},
+	{6, 16161, "sun-sea-port"},
+	{6, 16162, "solaris-audit"},
+	{6, 16309, "etb4j"},
+	{6, 16310, "pduncs"},
+	{6, 16311, "pdefmns"},
+	{6, 16360, "netserialext1"},
+	{6, 16361, "netserialext2"},
+	{6, 16367, "netserialext3"},
+	{6, 16368, "netserialext4"},
+	{6, 16384, "connected"},
+	{6, 16385, "rdgs"},
+	{6, 16619, "xoms"},
+	{6, 16665, "axon-tunnel"},
+	{6, 16789, "cadsisvr"},
+	{6, 16900, "newbay-snc-mc"},
+	{6, 16950, "sgcip"},
+	{6, 16991, "intel-rci-mp"},
+	{6, 16992, "amt-soap-http"},
+	{6, 16993, "amt-soap-https"},
+	{6, 16994, "amt-redir-tcp"},
+	{6, 16995, "amt-redir-tls"},
+	{6, 17007, "isode-dua"},
+	{6, 17010, "ncpu"},
+	{6, 17184, "vestasdlp"},
+	{6, 17185, "soundsvirtual"},
+	{6, 17219, "chipper"},
+	{6, 17220, "avtp"},
+	{6, 17221, "avdecc"},
+	{6, 17223, "isa100-gci"},
+	{6, 17225, "trdp-md"},
+	{6, 17234, "integrius-stp"},
+	{6, 17235, "ssh-mgmt"},
+	{6, 17500, "db-lsp"},
+	{6, 17555, "ailith"},
+	{6, 17729, "ea"},
+	{6, 17754, "zep"},
+	{6, 17755, "zigbee-ip"},
+	{6, 17756, "zigbee-ips"},
+	{6, 17777, "sw-orion"},
+	{6, 18000, "biimenu"},
+	{6, 18104, "radpdf"},
+	{6, 18136, "racf"},
+	{6, 18181, "opsec-cvp"},
+	{6, 18182, "opsec-ufp"},
+	{6, 18183, "opsec-sam"},
+	{6, 18184, "opsec-lea"},
+	{6, 18185, "opsec-omi"},
+	{6, 18186, "ohsc"},
+	{6, 18187, "opsec-ela"},
+	{6, 18241, "checkpoint-rtm"},
+	{6, 18242, "iclid"},
+	{6, 18243, "clusterxl"},
+	{6, 18262, "gv-pf"},
+	{6, 18463, "ac-cluster"},
+	{6, 18634, "rds-ib"},
+	{6, 18635, "rds-ip"},
+	{6, 18668, "vdmmesh"},
+	{6, 18769, "ique"},
+	{6, 18881, "infotos"},
+	{6, 18888, "apc-necmp"},
+	{6, 19000, "igrid"},
+	{6, 19007, "scintilla"},
+	{6, 19020, "j-link"},
+	{6, 19191, "opsec-uaa"},
+	{6, 19194, "ua-secureagent"},
+	{6, 19220, "cora"},
+	{6, 19283, "keysrvr"},
+	{6, 19315, "keyshadow"},
+	{6, 19398, "mtrgtrans"},
+	{6, 19410, "hp-sco"},
+	{6, 19411, "hp-sca"},
+	{6, 19412, "hp-sessmon"},
+	{6, 19539, "fxuptp"},
+	{6, 19540, "sxuptp"},
+	{6, 19541, "jcp"},
+	{6, 19790, "faircom-db"},
+	{6, 19998, "iec-104-sec"},
+	{6, 19999, "dnp-sec"},
+	{6, 20000, "dnp"},
+	{6, 20001, "microsan"},
+	{6, 20002, "commtact-http"},
+	{6, 20003, "commtact-https"},
+	{6, 20005, "openwebnet"},
+	{6, 20013, "ss-idi"},
+	{6, 20014, "opendeploy"},
+	{6, 20034, "nburn-id"},
+	{6, 20046, "tmophl7mts"},
+	{6, 20048, "mountd"},
+	{6, 20049, "nfsrdma"},
+	{6, 20057, "avesterra"},
+	{6, 20167, "tolfab"},
+	{6, 20202, "ipdtp-port"},
+	{6, 20222, "ipulse-ics"},
+	{6, 20480, "emwavemsg"},
+	{6, 20670, "track"},
+	{6, 20810, "crtech-nlm"},
+	{6, 20999, "athand-mmp"},
+	{6, 21000, "irtrans"},
+	{6, 21010, "notezilla-lan"},
+	{6, 21212, "trinket-agent"},
+	{6, 21213, "cohesity-agent"},
+	{6, 21221, "aigairserver"},
+	{6, 21553, "rdm-tfs"},
+	{6, 21554, "dfserver"},
+	{6, 21590, "vofr-gateway"},
+	{6, 21800, "tvpm"},
+	{6, 21801, "sal"},
+	{6, 21845, "webphone"},
+	{6, 21846, "netspeak-is"},
+	{6, 21847, "netspeak-cs"},
+	{6, 21848, "netspeak-acd"},
+	{6, 21849, "netspeak-cps"},
+	{6, 22000, "snapenetio"},
+	{6, 22001, "optocontrol"},
+	{6, 22002, "optohost002"},
+	{6, 22003, "optohost003"},
+	{6, 22004, "optohost004"},
+	{6, 22005, "optohost004"},
+	{6, 22125, "dcap"},
+	{6, 22128, "gsidcap"},
+	{6, 22222, "easyengine"},
+	{6, 22273, "wnn6"},
+	{6, 22305, "cis"},
+	{6, 22333, "showcockpit-net"},
+	{6, 22335, "shrewd-control"},
+	{6, 22343, "cis-secure"},
+	{6, 22347, "wibukey"},
+	{6, 22350, "codemeter"},
+	{6, 22351, "codemeter-cmwan"},
+	{6, 22537, "caldsoft-backup"},
+	{6, 22555, "vocaltec-wconf"},
+	{6, 22763, "talikaserver"},
+	{6, 22800, "aws-brf"},
+	{6, 22951, "brf-gw"},
+	{6, 23000, "inovaport1"},
+	{6, 23001, "inovaport2"},
+	{6, 23002, "inovaport3"},
+	{6, 23003, "inovaport4"},
+	{6, 23004, "inovaport5"},
+	{6, 23005, "inovaport6"},
+	{6, 23053, "gntp"},
+	{6, 23294, "5afe-dir"},
+	{6, 23333, "elxmgmt"},
+	{6, 23400, "novar-dbase"},
+	{6, 23401, "novar-alarm"},
+	{6, 23402, "novar-global"},
+	{6, 23456, "aequus"},
+	{6, 23457, "aequus-alt"},
+	{6, 23546, "areaguard-neo"},
+	{6, 24000, "med-ltp"},
+	{6, 24001, "med-fsp-rx"},
+	{6, 24002, "med-fsp-tx"},
+	{6, 24003, "med-supp"},
+	{6, 24004, "med-ovw"},
+	{6, 24005, "med-ci"},
+	{6, 24006, "med-net-svc"},
+	{6, 24242, "filesphere"},
+	{6, 24249, "vista-4gl"},
+	{6, 24321, "ild"},
+	{6, 24323, "vrmg-ip"},
+	{6, 24386, "intel-rci"},
+	{6, 24465, "tonidods"},
+	{6, 24554, "binkp"},
+	{6, 24577, "bilobit"},
+	{6, 24666, "sdtvwcam"},
+	{6, 24676, "canditv"},
+	{6, 24677, "flashfiler"},
+	{6, 24678, "proactivate"},
+	{6, 24680, "tcc-http"},
+	{6, 24754, "cslg"},
+	{6, 24922, "find"},
+	{6, 25000, "icl-twobase1"},
+	{6, 25001, "icl-twobase2"},
+	{6, 25002, "icl-twobase3"},
+	{6, 25003, "icl-twobase4"},
+	{6, 25004, "icl-twobase5"},
+	{6, 25005, "icl-twobase6"},
+	{6, 25006, "icl-twobase7"},
+	{6, 25007, "icl-twobase8"},
+	{6, 25008, "icl-twobase9"},
+	{6, 25009, "icl-twobase10"},
+	{6, 25576, "sauterdongle"},
+	{6, 25604, "idtp"},
+	{6, 25793, "vocaltec-hos"},
+	{6, 25900, "tasp-net"},
+	{6, 25901, "niobserver"},
+	{6, 25902, "nilinkanalyst"},
+	{6, 25903, "niprobe"},
+	{6, 26000, "quake"},
+	{6, 26133, "scscp"},
+	{6, 26208, "wnn6-ds"},
+	{6, 26257, "cockroach"},
+	{6, 26260, "ezproxy"},
+	{6, 26261, "ezmeeting"},
+	{6, 26262, "k3software-svr"},
+	{6, 26263, "k3software-cli"},
+	{6, 26486, "exoline-tcp"},
+	{6, 26487, "exoconfig"},
+	{6, 26489, "exonet"},
+	{6, 27010, "flex-lmadmin"},
+	{6, 27017, "mongodb"},
+	{6, 27345, "imagepump"},
+	{6, 27442, "jesmsjc"},
+	{6, 27504, "kopek-httphead"},
+	{6, 27782, "ars-vista"},
+	{6, 27876, "astrolink"},
+	{6, 27999, "tw-auth-key"},
+	{6, 28000, "nxlmd"},
+	{6, 28001, "pqsp"},
+	{6, 28010, "gruber-cashreg"},
+	{6, 28080, "thor-engine"},
+	{6, 28200, "voxelstorm"},
+	{6, 28240, "siemensgsm"},
+	{6, 28589, "bosswave"},
+	{6, 29000, "saltd-licensing"},
+	{6, 29167, "otmp"},
+	{6, 29999, "bingbang"},
+	{6, 30000, "ndmps"},
+	{6, 30001, "pago-services1"},
+	{6, 30002, "pago-services2"},
+	{6, 30003, "amicon-fpsu-ra"},
+	{6, 30100, "rwp"},
+	{6, 30260, "kingdomsonline"},
+	{6, 30400, "gs-realtime"},
+	{6, 30999, "ovobs"},
+	{6, 31016, "ka-sddp"},
+	{6, 31020, "autotrac-acp"},
+	{6, 31337, "eldim"},
+	{6, 31400, "pace-licensed"},
+	{6, 31416, "xqosd"},
+	{6, 31457, "tetrinet"},
+	{6, 31620, "lm-mon"},
+	{6, 31685, "dsx-monitor"},
+	{6, 31765, "gamesmith-port"},
+	{6, 31948, "iceedcp-tx"},
+	{6, 31949, "iceedcp-rx"},
+	{6, 32034, "iracinghelper"},
+	{6, 32249, "t1distproc60"},
+	{6, 32400, "plex"},
+	{6, 32483, "apm-link"},
+	{6, 32635, "sec-ntb-clnt"},
+	{6, 32636, "DMExpress"},
+	{6, 32767, "filenet-powsrm"},
+	{6, 32768, "filenet-tms"},
+	{6, 32769, "filenet-rpc"},
+	{6, 32770, "filenet-nch"},
+	{6, 32771, "filenet-rmi"},
+	{6, 32772, "filenet-pa"},
+	{6, 32773, "filenet-cm"},
+	{6, 32774, "filenet-re"},
+	{6, 32775, "filenet-pch"},
+	{6, 32776, "filenet-peior"},
+	{6, 32777, "filenet-obrok"},
+	{6, 32801, "mlsn"},
+	{6, 32811, "retp"},
+	{6, 32896, "idmgratm"},
+	{6, 33000, "wg-endpt-comms"},
+	{6, 33060, "mysqlx"},
+	{6, 33123, "aurora-balaena"},
+	{6, 33331, "diamondport"},
+	{6, 33333, "dgi-serv"},
+	{6, 33334, "speedtrace"},
+	{6, 33434, "traceroute"},
+	{6, 33656, "snip-slave"},
+	{6, 33890, "digilent-adept"},
+	{6, 34249, "turbonote-2"},
+	{6, 34378, "p-net-local"},
+	{6, 34379, "p-net-remote"},
+	{6, 34567, "dhanalakshmi"},
+	{6, 34962, "profinet-rt"},
+	{6, 34963, "profinet-rtm"},
+	{6, 34964, "profinet-cm"},
+	{6, 34980, "ethercat"},
+	{6, 35000, "heathview"},
+	{6, 35001, "rt-viewer"},
+	{6, 35002, "rt-sound"},
+	{6, 35003, "rt-devicemapper"},
+	{6, 35004, "rt-classmanager"},
+	{6, 35005, "rt-labtracker"},
+	{6, 35006, "rt-helper"},
+	{6, 35100, "axio-disc"},
+	{6, 35354, "kitim"},
+	{6, 35355, "altova-lm"},
+	{6, 35356, "guttersnex"},
+	{6, 35357, "openstack-id"},
+	{6, 36001, "allpeers"},
+	{6, 36524, "febooti-aw"},
+	{6, 36602, "observium-agent"},
+	{6, 36700, "mapx"},
+	{6, 36865, "kastenxpipe"},
+	{6, 37475, "neckar"},
+	{6, 37483, "gdrive-sync"},
+	{6, 37601, "eftp"},
+	{6, 37654, "unisys-eportal"},
+	{6, 38000, "ivs-database"},
+	{6, 38001, "ivs-insertion"},
+	{6, 38002, "cresco-control"},
+	{6, 38201, "galaxy7-data"},
+	{6, 38202, "fairview"},
+	{6, 38203, "agpolicy"},
+	{6, 38638, "psqlmws"},
+	{6, 38800, "sruth"},
+	{6, 38865, "secrmmsafecopya"},
+	{6, 39063, "vroa"},
+	{6, 39681, "turbonote-1"},
+	{6, 40000, "safetynetp"},
+	{6, 40404, "sptx"},
+	{6, 40841, "cscp"},
+	{6, 40842, "csccredir"},
+	{6, 40843, "csccfirewall"},
+	{6, 41111, "fs-qos"},
+	{6, 41121, "tentacle"},
+	{6, 41230, "z-wave-s"},
+	{6, 41794, "crestron-cip"},
+	{6, 41795, "crestron-ctp"},
+	{6, 41796, "crestron-cips"},
+	{6, 41797, "crestron-ctps"},
+	{6, 42508, "candp"},
+	{6, 42509, "candrp"},
+	{6, 42510, "caerpc"},
+	{6, 42999, "curiosity"},
+	{6, 43000, "recvr-rc"},
+	{6, 43188, "reachout"},
+	{6, 43189, "ndm-agent-port"},
+	{6, 43190, "ip-provision"},
+	{6, 43191, "noit-transport"},
+	{6, 43210, "shaperai"},
+	{6, 43439, "eq3-update"},
+	{6, 43440, "ew-mgmt"},
+	{6, 43441, "ciscocsdb"},
+	{6, 44123, "z-wave-tunnel"},
+	{6, 44321, "pmcd"},
+	{6, 44322, "pmcdproxy"},
+	{6, 44323, "pmwebapi"},
+	{6, 44444, "cognex-dataman"},
+	{6, 44445, "acronis-backup"},
+	{6, 44553, "rbr-debug"},
+	{6, 44818, "EtherNet-IP-2"},
+	{6, 44900, "m3da"},
+	{6, 45000, "asmp"},
+	{6, 45001, "asmps"},
+	{6, 45002, "rs-status"},
+	{6, 45045, "synctest"},
+	{6, 45054, "invision-ag"},
+	{6, 45514, "cloudcheck"},
+	{6, 45678, "eba"},
+	{6, 45824, "dai-shell"},
+	{6, 45825, "qdb2service"},
+	{6, 45966, "ssr-servermgr"},
+	{6, 46336, "inedo"},
+	{6, 46998, "spremotetablet"},
+	{6, 46999, "mediabox"},
+	{6, 47000, "mbus"},
+	{6, 47001, "winrm"},
+	{6, 47557, "dbbrowse"},
+	{6, 47624, "directplaysrvr"},
+	{6, 47806, "ap"},
+	{6, 47808, "bacnet"},
+	{6, 48000, "nimcontroller"},
+	{6, 48001, "nimspooler"},
+	{6, 48002, "nimhub"},
+	{6, 48003, "nimgtw"},
+	{6, 48004, "nimbusdb"},
+	{6, 48005, "nimbusdbctrl"},
+	{6, 48048, "juka"},
+	{6, 48049, "3gpp-cbsp"},
+	{6, 48050, "weandsf"},
+	{6, 48128, "isnetserv"},
+	{6, 48129, "blp5"},
+	{6, 48556, "com-bardac-dw"},
+	{6, 48619, "iqobject"},
+	{6, 48653, "robotraconteur"},
+	{6, 49000, "matahari"},
+	{6, 49001, "nusrp"},
+	{6, 49150, "inspider"},
+	{17, 1, "tcpmux"},
+	{17, 2, "compressnet"},
+	{17, 3, "compressnet"},
+	{17, 5, "rje"},
+	{17, 7, "echo"},
+	{17, 9, "discard"},
+	{17, 11, "systat"},
+	{17, 13, "daytime"},
+	{17, 17, "qotd"},
+	{17, 18, "msp"},
+	{17, 19, "chargen"},
+	{17, 20, "ftp-data"},
+	{17, 21, "ftp"},
+	{17, 22, "ssh"},
+	{17, 23, "telnet"},
+	{17, 25, "smtp"},
+	{17, 27, "nsw-fe"},
+	{17, 29, "msg-icp"},
+	{17, 31, "msg-auth"},
+	{17, 33, "dsp"},
+	{17, 37, "time"},
+	{17, 38, "rap"},
+	{17, 39, "rlp"},
+	{17, 41, "graphics"},
+	{17, 42, "name"},
+	{17, 43, "nicname"},
+	{17, 44, "mpm-flags"},
+	{17, 45, "mpm"},
+	{17, 46, "mpm-snd"},
+	{17, 48, "auditd"},
+	{17, 49, "tacacs"},
+	{17, 50, "re-mail-ck"},
+	{17, 52, "xns-time"},
+	{17, 53, "domain"},
+	{17, 54, "xns-ch"},
+	{17, 55, "isi-gl"},
+	{17, 56, "xns-auth"},
+	{17, 58, "xns-mail"},
+	{17, 62, "acas"},
+	{17, 63, "whoispp"},
+	{17, 64, "covia"},
+	{17, 65, "tacacs-ds"},
+	{17, 66, "sql-net"},
+	{17, 67, "bootps"},
+	{17, 68, "bootpc"},
+	{17, 69, "tftp"},
+	{17, 70, "gopher"},
+	{17, 71, "netrjs-1"},
+	{17, 72, "netrjs-2"},
+	{17, 73, "netrjs-3"},
+	{17, 74, "netrjs-4"},
+	{17, 76, "deos"},
+	{17, 78, "vettcp"},
+	{17, 79, "finger"},
+	{17, 80, "http"},
+	{17, 82, "xfer"},
+	{17, 83, "mit-ml-dev"},
+	{17, 84, "ctf"},
+	{17, 85, "mit-ml-dev"},
+	{17, 86, "mfcobol"},
+	{17, 88, "kerberos"},
+	{17, 89, "su-mit-tg"},
+	{17, 90, "dnsix"},
+	{17, 91, "mit-dov"},
+	{17, 92, "npp"},
+	{17, 93, "dcp"},
+	{17, 94, "objcall"},
+	{17, 95, "supdup"},
+	{17, 96, "dixie"},
+	{17, 97, "swift-rvf"},
+	{17, 98, "tacnews"},
+	{17, 99, "metagram"},
+	{17, 101, "hostname"},
+	{17, 102, "iso-tsap"},
+	{17, 103, "gppitnp"},
+	{17, 104, "acr-nema"},
+	{17, 105, "cso"},
+	{17, 106, "3com-tsmux"},
+	{17, 107, "rtelnet"},
+	{17, 108, "snagas"},
+	{17, 109, "pop2"},
+	{17, 110, "pop3"},
+	{17, 111, "sunrpc"},
+	{17, 112, "mcidas"},
+	{17, 113, "auth"},
+	{17, 115, "sftp"},
+	{17, 116, "ansanotify"},
+	{17, 117, "uucp-path"},
+	{17, 118, "sqlserv"},
+	{17, 119, "nntp"},
+	{17, 120, "cfdptkt"},
+	{17, 121, "erpc"},
+	{17, 122, "smakynet"},
+	{17, 123, "ntp"},
+	{17, 124, "ansatrader"},
+	{17, 125, "locus-map"},
+	{17, 126, "nxedit"},
+	{17, 127, "locus-con"},
+	{17, 128, "gss-xlicen"},
+	{17, 129, "pwdgen"},
+	{17, 130, "cisco-fna"},
+	{17, 131, "cisco-tna"},
+	{17, 132, "cisco-sys"},
+	{17, 133, "statsrv"},
+	{17, 134, "ingres-net"},
+	{17, 135, "epmap"},
+	{17, 136, "profile"},
+	{17, 137, "netbios-ns"},
+	{17, 138, "netbios-dgm"},
+	{17, 139, "netbios-ssn"},
+	{17, 140, "emfis-data"},
+	{17, 141, "emfis-cntl"},
+	{17, 142, "bl-idm"},
+	{17, 144, "uma"},
+	{17, 145, "uaac"},
+	{17, 146, "iso-tp0"},
+	{17, 147, "iso-ip"},
+	{17, 148, "jargon"},
+	{17, 149, "aed-512"},
+	{17, 150, "sql-net"},
+	{17, 151, "hems"},
+	{17, 152, "bftp"},
+	{17, 153, "sgmp"},
+	{17, 154, "netsc-prod"},
+	{17, 155, "netsc-dev"},
+	{17, 156, "sqlsrv"},
+	{17, 157, "knet-cmp"},
+	{17, 158, "pcmail-srv"},
+	{17, 159, "nss-routing"},
+	{17, 160, "sgmp-traps"},
+	{17, 161, "snmp"},
+	{17, 162, "snmptrap"},
+	{17, 163, "cmip-man"},
+	{17, 164, "cmip-agent"},
+	{17, 165, "xns-courier"},
+	{17, 166, "s-net"},
+	{17, 167, "namp"},
+	{17, 168, "rsvd"},
+	{17, 169, "send"},
+	{17, 170, "print-srv"},
+	{17, 171, "multiplex"},
+	{17, 172, "cl-1"},
+	{17, 173, "xyplex-mux"},
+	{17, 174, "mailq"},
+	{17, 175, "vmnet"},
+	{17, 176, "genrad-mux"},
+	{17, 177, "xdmcp"},
+	{17, 178, "nextstep"},
+	{17, 179, "bgp"},
+	{17, 180, "ris"},
+	{17, 181, "unify"},
+	{17, 182, "audit"},
+	{17, 183, "ocbinder"},
+	{17, 184, "ocserver"},
+	{17, 185, "remote-kis"},
+	{17, 186, "kis"},
+	{17, 187, "aci"},
+	{17, 188, "mumps"},
+	{17, 189, "qft"},
+	{17, 190, "gacp"},
+	{17, 191, "prospero"},
+	{17, 192, "osu-nms"},
+	{17, 193, "srmp"},
+	{17, 194, "irc"},
+	{17, 195, "dn6-nlm-aud"},
+	{17, 196, "dn6-smm-red"},
+	{17, 197, "dls"},
+	{17, 198, "dls-mon"},
+	{17, 199, "smux"},
+	{17, 200, "src"},
+	{17, 201, "at-rtmp"},
+	{17, 202, "at-nbp"},
+	{17, 203, "at-3"},
+	{17, 204, "at-echo"},
+	{17, 205, "at-5"},
+	{17, 206, "at-zis"},
+	{17, 207, "at-7"},
+	{17, 208, "at-8"},
+	{17, 209, "qmtp"},
+	{17, 210, "z39-50"},
+	{17, 211, "914c-g"},
+	{17, 212, "anet"},
+	{17, 213, "ipx"},
+	{17, 214, "vmpwscs"},
+	{17, 215, "softpc"},
+	{17, 216, "CAIlic"},
+	{17, 217, "dbase"},
+	{17, 218, "mpp"},
+	{17, 219, "uarps"},
+	{17, 220, "imap3"},
+	{17, 221, "fln-spx"},
+	{17, 222, "rsh-spx"},
+	{17, 223, "cdc"},
+	{17, 224, "masqdialer"},
+	{17, 242, "direct"},
+	{17, 243, "sur-meas"},
+	{17, 244, "inbusiness"},
+	{17, 245, "link"},
+	{17, 246, "dsp3270"},
+	{17, 247, "subntbcst-tftp"},
+	{17, 248, "bhfhs"},
+	{17, 256, "rap"},
+	{17, 257, "set"},
+	{17, 259, "esro-gen"},
+	{17, 260, "openport"},
+	{17, 261, "nsiiops"},
+	{17, 262, "arcisdms"},
+	{17, 263, "hdap"},
+	{17, 264, "bgmp"},
+	{17, 265, "x-bone-ctl"},
+	{17, 266, "sst"},
+	{17, 267, "td-service"},
+	{17, 268, "td-replica"},
+	{17, 269, "manet"},
+	{17, 270, "gist"},
+	{17, 280, "http-mgmt"},
+	{17, 281, "personal-link"},
+	{17, 282, "cableport-ax"},
+	{17, 283, "rescap"},
+	{17, 284, "corerjd"},
+	{17, 286, "fxp"},
+	{17, 287, "k-block"},
+	{17, 308, "novastorbakcup"},
+	{17, 309, "entrusttime"},
+	{17, 310, "bhmds"},
+	{17, 311, "asip-webadmin"},
+	{17, 312, "vslmp"},
+	{17, 313, "magenta-logic"},
+	{17, 314, "opalis-robot"},
+	{17, 315, "dpsi"},
+	{17, 316, "decauth"},
+	{17, 317, "zannet"},
+	{17, 318, "pkix-timestamp"},
+	{17, 319, "ptp-event"},
+	{17, 320, "ptp-general"},
+	{17, 321, "pip"},
+	{17, 322, "rtsps"},
+	{17, 333, "texar"},
+	{17, 344, "pdap"},
+	{17, 345, "pawserv"},
+	{17, 346, "zserv"},
+	{17, 347, "fatserv"},
+	{17, 348, "csi-sgwp"},
+	{17, 349, "mftp"},
+	{17, 350, "matip-type-a"},
+	{17, 351, "matip-type-b"},
+	{17, 352, "dtag-ste-sb"},
+	{17, 353, "ndsauth"},
+	{17, 354, "bh611"},
+	{17, 355, "datex-asn"},
+	{17, 356, "cloanto-net-1"},
+	{17, 357, "bhevent"},
+	{17, 358, "shrinkwrap"},
+	{17, 359, "nsrmp"},
+	{17, 360, "scoi2odialog"},
+	{17, 361, "semantix"},
+	{17, 362, "srssend"},
+	{17, 363, "rsvp-tunnel"},
+	{17, 364, "aurora-cmgr"},
+	{17, 365, "dtk"},
+	{17, 366, "odmr"},
+	{17, 367, "mortgageware"},
+	{17, 368, "qbikgdp"},
+	{17, 369, "rpc2portmap"},
+	{17, 370, "codaauth2"},
+	{17, 371, "clearcase"},
+	{17, 372, "ulistproc"},
+	{17, 373, "legent-1"},
+	{17, 374, "legent-2"},
+	{17, 375, "hassle"},
+	{17, 376, "nip"},
+	{17, 377, "tnETOS"},
+	{17, 378, "dsETOS"},
+	{17, 379, "is99c"},
+	{17, 380, "is99s"},
+	{17, 381, "hp-collector"},
+	{17, 382, "hp-managed-node"},
+	{17, 383, "hp-alarm-mgr"},
+	{17, 384, "arns"},
+	{17, 385, "ibm-app"},
+	{17, 386, "asa"},
+	{17, 387, "aurp"},
+	{17, 388, "unidata-ldm"},
+	{17, 389, "ldap"},
+	{17, 390, "uis"},
+	{17, 391, "synotics-relay"},
+	{17, 392, "synotics-broker"},
+	{17, 393, "meta5"},
+	{17, 394, "embl-ndt"},
+	{17, 395, "netcp"},
+	{17, 396, "netware-ip"},
+	{17, 397, "mptn"},
+	{17, 398, "kryptolan"},
+	{17, 399, "iso-tsap-c2"},
+	{17, 400, "osb-sd"},
+	{17, 401, "ups"},
+	{17, 402, "genie"},
+	{17, 403, "decap"},
+	{17, 404, "nced"},
+	{17, 405, "ncld"},
+	{17, 406, "imsp"},
+	{17, 407, "timbuktu"},
+	{17, 408, "prm-sm"},
+	{17, 409, "prm-nm"},
+	{17, 410, "decladebug"},
+	{17, 411, "rmt"},
+	{17, 412, "synoptics-trap"},
+	{17, 413, "smsp"},
+	{17, 414, "infoseek"},
+	{17, 415, "bnet"},
+	{17, 416, "silverplatter"},
+	{17, 417, "onmux"},
+	{17, 418, "hyper-g"},
+	{17, 419, "ariel1"},
+	{17, 420, "smpte"},
+	{17, 421, "ariel2"},
+	{17, 422, "ariel3"},
+	{17, 423, "opc-job-start"},
+	{17, 424, "opc-job-track"},
+	{17, 425, "icad-el"},
+	{17, 426, "smartsdp"},
+	{17, 427, "svrloc"},
+	{17, 428, "ocs-cmu"},
+	{17, 429, "ocs-amu"},
+	{17, 430, "utmpsd"},
+	{17, 431, "utmpcd"},
+	{17, 432, "iasd"},
+	{17, 433, "nnsp"},
+	{17, 434, "mobileip-agent"},
+	{17, 435, "mobilip-mn"},
+	{17, 436, "dna-cml"},
+	{17, 437, "comscm"},
+	{17, 438, "dsfgw"},
+	{17, 439, "dasp"},
+	{17, 440, "sgcp"},
+	{17, 441, "decvms-sysmgt"},
+	{17, 442, "cvc-hostd"},
+	{17, 443, "https"},
+	{17, 444, "snpp"},
+	{17, 445, "microsoft-ds"},
+	{17, 446, "ddm-rdb"},
+	{17, 447, "ddm-dfm"},
+	{17, 448, "ddm-ssl"},
+	{17, 449, "as-servermap"},
+	{17, 450, "tserver"},
+	{17, 451, "sfs-smp-net"},
+	{17, 452, "sfs-config"},
+	{17, 453, "creativeserver"},
+	{17, 454, "contentserver"},
+	{17, 455, "creativepartnr"},
+	{17, 456, "macon-udp"},
+	{17, 457, "scohelp"},
+	{17, 458, "appleqtc"},
+	{17, 459, "ampr-rcmd"},
+	{17, 460, "skronk"},
+	{17, 461, "datasurfsrv"},
+	{17, 462, "datasurfsrvsec"},
+	{17, 463, "alpes"},
+	{17, 464, "kpasswd"},
+	{17, 465, "igmpv3lite"},
+	{17, 466, "digital-vrc"},
+	{17, 467, "mylex-mapd"},
+	{17, 468, "photuris"},
+	{17, 469, "rcp"},
+	{17, 470, "scx-proxy"},
+	{17, 471, "mondex"},
+	{17, 472, "ljk-login"},
+	{17, 473, "hybrid-pop"},
+	{17, 474, "tn-tl-w2"},
+	{17, 475, "tcpnethaspsrv"},
+	{17, 476, "tn-tl-fd1"},
+	{17, 477, "ss7ns"},
+	{17, 478, "spsc"},
+	{17, 479, "iafserver"},
+	{17, 480, "iafdbase"},
+	{17, 481, "ph"},
+	{17, 482, "bgs-nsi"},
+	{17, 483, "ulpnet"},
+	{17, 484, "integra-sme"},
+	{17, 485, "powerburst"},
+	{17, 486, "avian"},
+	{17, 487, "saft"},
+	{17, 488, "gss-http"},
+	{17, 489, "nest-protocol"},
+	{17, 490, "micom-pfs"},
+	{17, 491, "go-login"},
+	{17, 492, "ticf-1"},
+	{17, 493, "ticf-2"},
+	{17, 494, "pov-ray"},
+	{17, 495, "intecourier"},
+	{17, 496, "pim-rp-disc"},
+	{17, 497, "retrospect"},
+	{17, 498, "siam"},
+	{17, 499, "iso-ill"},
+	{17, 500, "isakmp"},
+	{17, 501, "stmf"},
+	{17, 502, "mbap"},
+	{17, 503, "intrinsa"},
+	{17, 504, "citadel"},
+	{17, 505, "mailbox-lm"},
+	{17, 506, "ohimsrv"},
+	{17, 507, "crs"},
+	{17, 508, "xvttp"},
+	{17, 509, "snare"},
+	{17, 510, "fcp"},
+	{17, 511, "passgo"},
+	{17, 512, "comsat"},
+	{17, 513, "who"},
+	{17, 514, "syslog"},
+	{17, 515, "printer"},
+	{17, 516, "videotex"},
+	{17, 517, "talk"},
+	{17, 518, "ntalk"},
+	{17, 519, "utime"},
+	{17, 520, "router"},
+	{17, 521, "ripng"},
+	{17, 522, "ulp"},
+	{17, 523, "ibm-db2"},
+	{17, 524, "ncp"},
+	{17, 525, "timed"},
+	{17, 526, "tempo"},
+	{17, 527, "stx"},
+	{17, 528, "custix"},
+	{17, 529, "irc-serv"},
+	{17, 530, "courier"},
+	{17, 531, "conference"},
+	{17, 532, "netnews"},
+	{17, 533, "netwall"},
+	{17, 534, "windream"},
+	{17, 535, "iiop"},
+	{17, 536, "opalis-rdv"},
+	{17, 537, "nmsp"},
+	{17, 538, "gdomap"},
+	{17, 539, "apertus-ldp"},
+	{17, 540, "uucp"},
+	{17, 541, "uucp-rlogin"},
+	{17, 542, "commerce"},
+	{17, 543, "klogin"},
+	{17, 544, "kshell"},
+	{17, 545, "appleqtcsrvr"},
+	{17, 546, "dhcpv6-client"},
+	{17, 547, "dhcpv6-server"},
+	{17, 548, "afpovertcp"},
+	{17, 549, "idfp"},
+	{17, 550, "new-rwho"},
+	{17, 551, "cybercash"},
+	{17, 552, "devshr-nts"},
+	{17, 553, "pirp"},
+	{17, 554, "rtsp"},
+	{17, 555, "dsf"},
+	{17, 556, "remotefs"},
+	{17, 557, "openvms-sysipc"},
+	{17, 558, "sdnskmp"},
+	{17, 559, "teedtap"},
+	{17, 560, "rmonitor"},
+	{17, 561, "monitor"},
+	{17, 562, "chshell"},
+	{17, 563, "nntps"},
+	{17, 564, "9pfs"},
+	{17, 565, "whoami"},
+	{17, 566, "streettalk"},
+	{17, 567, "banyan-rpc"},
+	{17, 568, "ms-shuttle"},
+	{17, 569, "ms-rome"},
+	{17, 570, "meter"},
+	{17, 571, "meter"},
+	{17, 572, "sonar"},
+	{17, 573, "banyan-vip"},
+	{17, 574, "ftp-agent"},
+	{17, 575, "vemmi"},
+	{17, 576, "ipcd"},
+	{17, 577, "vnas"},
+	{17, 578, "ipdd"},
+	{17, 579, "decbsrv"},
+	{17, 580, "sntp-heartbeat"},
+	{17, 581, "bdp"},
+	{17, 582, "scc-security"},
+	{17, 583, "philips-vc"},
+	{17, 584, "keyserver"},
+	{17, 586, "password-chg"},
+	{17, 587, "submission"},
+	{17, 588, "cal"},
+	{17, 589, "eyelink"},
+	{17, 590, "tns-cml"},
+	{17, 591, "http-alt"},
+	{17, 592, "eudora-set"},
+	{17, 593, "http-rpc-epmap"},
+	{17, 594, "tpip"},
+	{17, 595, "cab-protocol"},
+	{17, 596, "smsd"},
+	{17, 597, "ptcnameservice"},
+	{17, 598, "sco-websrvrmg3"},
+	{17, 599, "acp"},
+	{17, 600, "ipcserver"},
+	{17, 601, "syslog-conn"},
+	{17, 602, "xmlrpc-beep"},
+	{17, 603, "idxp"},
+	{17, 604, "tunnel"},
+	{17, 605, "soap-beep"},
+	{17, 606, "urm"},
+	{17, 607, "nqs"},
+	{17, 608, "sift-uft"},
+	{17, 609, "npmp-trap"},
+	{17, 610, "npmp-local"},
+	{17, 611, "npmp-gui"},
+	{17, 612, "hmmp-ind"},
+	{17, 613, "hmmp-op"},
+	{17, 614, "sshell"},
+	{17, 615, "sco-inetmgr"},
+	{17, 616, "sco-sysmgr"},
+	{17, 617, "sco-dtmgr"},
+	{17, 618, "dei-icda"},
+	{17, 619, "compaq-evm"},
+	{17, 620, "sco-websrvrmgr"},
+	{17, 621, "escp-ip"},
+	{17, 622, "collaborator"},
+	{17, 623, "asf-rmcp"},
+	{17, 624, "cryptoadmin"},
+	{17, 625, "dec-dlm"},
+	{17, 626, "asia"},
+	{17, 627, "passgo-tivoli"},
+	{17, 628, "qmqp"},
+	{17, 629, "3com-amp3"},
+	{17, 630, "rda"},
+	{17, 631, "ipp"},
+	{17, 632, "bmpp"},
+	{17, 633, "servstat"},
+	{17, 634, "ginad"},
+	{17, 635, "rlzdbase"},
+	{17, 636, "ldaps"},
+	{17, 637, "lanserver"},
+	{17, 638, "mcns-sec"},
+	{17, 639, "msdp"},
+	{17, 640, "entrust-sps"},
+	{17, 641, "repcmd"},
+	{17, 642, "esro-emsdp"},
+	{17, 643, "sanity"},
+	{17, 644, "dwr"},
+	{17, 645, "pssc"},
+	{17, 646, "ldp"},
+	{17, 647, "dhcp-failover"},
+	{17, 648, "rrp"},
+	{17, 649, "cadview-3d"},
+	{17, 650, "obex"},
+	{17, 651, "ieee-mms"},
+	{17, 652, "hello-port"},
+	{17, 653, "repscmd"},
+	{17, 654, "aodv"},
+	{17, 655, "tinc"},
+	{17, 656, "spmp"},
+	{17, 657, "rmc"},
+	{17, 658, "tenfold"},
+	{17, 660, "mac-srvr-admin"},
+	{17, 661, "hap"},
+	{17, 662, "pftp"},
+	{17, 663, "purenoise"},
+	{17, 664, "asf-secure-rmcp"},
+	{17, 665, "sun-dr"},
+	{17, 666, "mdqs"},
+	{17, 667, "disclose"},
+	{17, 668, "mecomm"},
+	{17, 669, "meregister"},
+	{17, 670, "vacdsm-sws"},
+	{17, 671, "vacdsm-app"},
+	{17, 672, "vpps-qua"},
+	{17, 673, "cimplex"},
+	{17, 674, "acap"},
+	{17, 675, "dctp"},
+	{17, 676, "vpps-via"},
+	{17, 677, "vpp"},
+	{17, 678, "ggf-ncp"},
+	{17, 679, "mrm"},
+	{17, 680, "entrust-aaas"},
+	{17, 681, "entrust-aams"},
+	{17, 682, "xfr"},
+	{17, 683, "corba-iiop"},
+	{17, 684, "corba-iiop-ssl"},
+	{17, 685, "mdc-portmapper"},
+	{17, 686, "hcp-wismar"},
+	{17, 687, "asipregistry"},
+	{17, 688, "realm-rusd"},
+	{17, 689, "nmap"},
+	{17, 690, "vatp"},
+	{17, 691, "msexch-routing"},
+	{17, 692, "hyperwave-isp"},
+	{17, 693, "connendp"},
+	{17, 694, "ha-cluster"},
+	{17, 695, "ieee-mms-ssl"},
+	{17, 696, "rushd"},
+	{17, 697, "uuidgen"},
+	{17, 698, "olsr"},
+	{17, 699, "accessnetwork"},
+	{17, 700, "epp"},
+	{17, 701, "lmp"},
+	{17, 702, "iris-beep"},
+	{17, 704, "elcsd"},
+	{17, 705, "agentx"},
+	{17, 706, "silc"},
+	{17, 707, "borland-dsj"},
+	{17, 709, "entrust-kmsh"},
+	{17, 710, "entrust-ash"},
+	{17, 711, "cisco-tdp"},
+	{17, 712, "tbrpf"},
+	{17, 713, "iris-xpc"},
+	{17, 714, "iris-xpcs"},
+	{17, 715, "iris-lwz"},
+	{17, 716, "pana"},
+	{17, 729, "netviewdm1"},
+	{17, 730, "netviewdm2"},
+	{17, 731, "netviewdm3"},
+	{17, 741, "netgw"},
+	{17, 742, "netrcs"},
+	{17, 744, "flexlm"},
+	{17, 747, "fujitsu-dev"},
+	{17, 748, "ris-cm"},
+	{17, 749, "kerberos-adm"},
+	{17, 750, "loadav"},
+	{17, 751, "pump"},
+	{17, 752, "qrh"},
+	{17, 753, "rrh"},
+	{17, 754, "tell"},
+	{17, 758, "nlogin"},
+	{17, 759, "con"},
+	{17, 760, "ns"},
+	{17, 761, "rxe"},
+	{17, 762, "quotad"},
+	{17, 763, "cycleserv"},
+	{17, 764, "omserv"},
+	{17, 765, "webster"},
+	{17, 767, "phonebook"},
+	{17, 769, "vid"},
+	{17, 770, "cadlock"},
+	{17, 771, "rtip"},
+	{17, 772, "cycleserv2"},
+	{17, 773, "notify"},
+	{17, 774, "acmaint-dbd"},
+	{17, 775, "acmaint-transd"},
+	{17, 776, "wpages"},
+	{17, 777, "multiling-http"},
+	{17, 780, "wpgs"},
+	{17, 800, "mdbs-daemon"},
+	{17, 801, "device"},
+	{17, 802, "mbap-s"},
+	{17, 810, "fcp-udp"},
+	{17, 828, "itm-mcell-s"},
+	{17, 829, "pkix-3-ca-ra"},
+	{17, 830, "netconf-ssh"},
+	{17, 831, "netconf-beep"},
+	{17, 832, "netconfsoaphttp"},
+	{17, 833, "netconfsoapbeep"},
+	{17, 847, "dhcp-failover2"},
+	{17, 848, "gdoi"},
+	{17, 853, "domain-s"},
+	{17, 854, "dlep"},
+	{17, 860, "iscsi"},
+	{17, 861, "owamp-test"},
+	{17, 862, "twamp-test"},
+	{17, 873, "rsync"},
+	{17, 886, "iclcnet-locate"},
+	{17, 887, "iclcnet-svinfo"},
+	{17, 888, "accessbuilder"},
+	{17, 900, "omginitialrefs"},
+	{17, 901, "smpnameres"},
+	{17, 902, "ideafarm-door"},
+	{17, 903, "ideafarm-panic"},
+	{17, 910, "kink"},
+	{17, 911, "xact-backup"},
+	{17, 912, "apex-mesh"},
+	{17, 913, "apex-edge"},
+	{17, 914, "rift-lies"},
+	{17, 915, "rift-ties"},
+	{17, 989, "ftps-data"},
+	{17, 990, "ftps"},
+	{17, 991, "nas"},
+	{17, 992, "telnets"},
+	{17, 995, "pop3s"},
+	{17, 996, "vsinet"},
+	{17, 997, "maitrd"},
+	{17, 998, "puparp"},
+	{17, 999, "applix"},
+	{17, 1000, "cadlock2"},
+	{17, 1010, "surf"},
+	{17, 1021, "exp1"},
+	{17, 1022, "exp2"},
+	{17, 1025, "blackjack"},
+	{17, 1026, "cap"},
+	{17, 1027, "6a44"},
+	{17, 1029, "solid-mux"},
+	{17, 1033, "netinfo-local"},
+	{17, 1034, "activesync"},
+	{17, 1035, "mxxrlogin"},
+	{17, 1036, "nsstp"},
+	{17, 1037, "ams"},
+	{17, 1038, "mtqp"},
+	{17, 1039, "sbl"},
+	{17, 1040, "netarx"},
+	{17, 1041, "danf-ak2"},
+	{17, 1042, "afrog"},
+	{17, 1043, "boinc-client"},
+	{17, 1044, "dcutility"},
+	{17, 1045, "fpitp"},
+	{17, 1046, "wfremotertm"},
+	{17, 1047, "neod1"},
+	{17, 1048, "neod2"},
+	{17, 1049, "td-postman"},
+	{17, 1050, "cma"},
+	{17, 1051, "optima-vnet"},
+	{17, 1052, "ddt"},
+	{17, 1053, "remote-as"},
+	{17, 1054, "brvread"},
+	{17, 1055, "ansyslmd"},
+	{17, 1056, "vfo"},
+	{17, 1057, "startron"},
+	{17, 1058, "nim"},
+	{17, 1059, "nimreg"},
+	{17, 1060, "polestar"},
+	{17, 1061, "kiosk"},
+	{17, 1062, "veracity"},
+	{17, 1063, "kyoceranetdev"},
+	{17, 1064, "jstel"},
+	{17, 1065, "syscomlan"},
+	{17, 1066, "fpo-fns"},
+	{17, 1067, "instl-boots"},
+	{17, 1068, "instl-bootc"},
+	{17, 1069, "cognex-insight"},
+	{17, 1070, "gmrupdateserv"},
+	{17, 1071, "bsquare-voip"},
+	{17, 1072, "cardax"},
+	{17, 1073, "bridgecontrol"},
+	{17, 1074, "warmspotMgmt"},
+	{17, 1075, "rdrmshc"},
+	{17, 1076, "dab-sti-c"},
+	{17, 1077, "imgames"},
+	{17, 1078, "avocent-proxy"},
+	{17, 1079, "asprovatalk"},
+	{17, 1080, "socks"},
+	{17, 1081, "pvuniwien"},
+	{17, 1082, "amt-esd-prot"},
+	{17, 1083, "ansoft-lm-1"},
+	{17, 1084, "ansoft-lm-2"},
+	{17, 1085, "webobjects"},
+	{17, 1086, "cplscrambler-lg"},
+	{17, 1087, "cplscrambler-in"},
+	{17, 1088, "cplscrambler-al"},
+	{17, 1089, "ff-annunc"},
+	{17, 1090, "ff-fms"},
+	{17, 1091, "ff-sm"},
+	{17, 1092, "obrpd"},
+	{17, 1093, "proofd"},
+	{17, 1094, "rootd"},
+	{17, 1095, "nicelink"},
+	{17, 1096, "cnrprotocol"},
+	{17, 1097, "sunclustermgr"},
+	{17, 1098, "rmiactivation"},
+	{17, 1099, "rmiregistry"},
+	{17, 1100, "mctp"},
+	{17, 1101, "pt2-discover"},
+	{17, 1102, "adobeserver-1"},
+	{17, 1103, "adobeserver-2"},
+	{17, 1104, "xrl"},
+	{17, 1105, "ftranhc"},
+	{17, 1106, "isoipsigport-1"},
+	{17, 1107, "isoipsigport-2"},
+	{17, 1108, "ratio-adp"},
+	{17, 1110, "nfsd-keepalive"},
+	{17, 1111, "lmsocialserver"},
+	{17, 1112, "icp"},
+	{17, 1113, "ltp-deepspace"},
+	{17, 1114, "mini-sql"},
+	{17, 1115, "ardus-trns"},
+	{17, 1116, "ardus-cntl"},
+	{17, 1117, "ardus-mtrns"},
+	{17, 1118, "sacred"},
+	{17, 1119, "bnetgame"},
+	{17, 1120, "bnetfile"},
+	{17, 1121, "rmpp"},
+	{17, 1122, "availant-mgr"},
+	{17, 1123, "murray"},
+	{17, 1124, "hpvmmcontrol"},
+	{17, 1125, "hpvmmagent"},
+	{17, 1126, "hpvmmdata"},
+	{17, 1127, "kwdb-commn"},
+	{17, 1128, "saphostctrl"},
+	{17, 1129, "saphostctrls"},
+	{17, 1130, "casp"},
+	{17, 1131, "caspssl"},
+	{17, 1132, "kvm-via-ip"},
+	{17, 1133, "dfn"},
+	{17, 1134, "aplx"},
+	{17, 1135, "omnivision"},
+	{17, 1136, "hhb-gateway"},
+	{17, 1137, "trim"},
+	{17, 1138, "encrypted-admin"},
+	{17, 1139, "evm"},
+	{17, 1140, "autonoc"},
+	{17, 1141, "mxomss"},
+	{17, 1142, "edtools"},
+	{17, 1143, "imyx"},
+	{17, 1144, "fuscript"},
+	{17, 1145, "x9-icue"},
+	{17, 1146, "audit-transfer"},
+	{17, 1147, "capioverlan"},
+	{17, 1148, "elfiq-repl"},
+	{17, 1149, "bvtsonar"},
+	{17, 1150, "blaze"},
+	{17, 1151, "unizensus"},
+	{17, 1152, "winpoplanmess"},
+	{17, 1153, "c1222-acse"},
+	{17, 1154, "resacommunity"},
+	{17, 1155, "nfa"},
+	{17, 1156, "iascontrol-oms"},
+	{17, 1157, "iascontrol"},
+	{17, 1158, "dbcontrol-oms"},
+	{17, 1159, "oracle-oms"},
+	{17, 1160, "olsv"},
+	{17, 1161, "health-polling"},
+	{17, 1162, "health-trap"},
+	{17, 1163, "sddp"},
+	{17, 1164, "qsm-proxy"},
+	{17, 1165, "qsm-gui"},
+	{17, 1166, "qsm-remote"},
+	{17, 1167, "cisco-ipsla"},
+	{17, 1168, "vchat"},
+	{17, 1169, "tripwire"},
+	{17, 1170, "atc-lm"},
+	{17, 1171, "atc-appserver"},
+	{17, 1172, "dnap"},
+	{17, 1173, "d-cinema-rrp"},
+	{17, 1174, "fnet-remote-ui"},
+	{17, 1175, "dossier"},
+	{17, 1176, "indigo-server"},
+	{17, 1177, "dkmessenger"},
+	{17, 1178, "sgi-storman"},
+	{17, 1179, "b2n"},
+	{17, 1180, "mc-client"},
+	{17, 1181, "3comnetman"},
+	{17, 1182, "accelenet-data"},
+	{17, 1183, "llsurfup-http"},
+	{17, 1184, "llsurfup-https"},
+	{17, 1185, "catchpole"},
+	{17, 1186, "mysql-cluster"},
+	{17, 1187, "alias"},
+	{17, 1188, "hp-webadmin"},
+	{17, 1189, "unet"},
+	{17, 1190, "commlinx-avl"},
+	{17, 1191, "gpfs"},
+	{17, 1192, "caids-sensor"},
+	{17, 1193, "fiveacross"},
+	{17, 1194, "openvpn"},
+	{17, 1195, "rsf-1"},
+	{17, 1196, "netmagic"},
+	{17, 1197, "carrius-rshell"},
+	{17, 1198, "cajo-discovery"},
+	{17, 1199, "dmidi"},
+	{17, 1200, "scol"},
+	{17, 1201, "nucleus-sand"},
+	{17, 1202, "caiccipc"},
+	{17, 1203, "ssslic-mgr"},
+	{17, 1204, "ssslog-mgr"},
+	{17, 1205, "accord-mgc"},
+	{17, 1206, "anthony-data"},
+	{17, 1207, "metasage"},
+	{17, 1208, "seagull-ais"},
+	{17, 1209, "ipcd3"},
+	{17, 1210, "eoss"},
+	{17, 1211, "groove-dpp"},
+	{17, 1212, "lupa"},
+	{17, 1213, "mpc-lifenet"},
+	{17, 1214, "kazaa"},
+	{17, 1215, "scanstat-1"},
+	{17, 1216, "etebac5"},
+	{17, 1217, "hpss-ndapi"},
+	{17, 1218, "aeroflight-ads"},
+	{17, 1219, "aeroflight-ret"},
+	{17, 1220, "qt-serveradmin"},
+	{17, 1221, "sweetware-apps"},
+	{17, 1222, "nerv"},
+	{17, 1223, "tgp"},
+	{17, 1224, "vpnz"},
+	{17, 1225, "slinkysearch"},
+	{17, 1226, "stgxfws"},
+	{17, 1227, "dns2go"},
+	{17, 1228, "florence"},
+	{17, 1229, "zented"},
+	{17, 1230, "periscope"},
+	{17, 1231, "menandmice-lpm"},
+	{17, 1232, "first-defense"},
+	{17, 1233, "univ-appserver"},
+	{17, 1234, "search-agent"},
+	{17, 1235, "mosaicsyssvc1"},
+	{17, 1236, "bvcontrol"},
+	{17, 1237, "tsdos390"},
+	{17, 1238, "hacl-qs"},
+	{17, 1239, "nmsd"},
+	{17, 1240, "instantia"},
+	{17, 1241, "nessus"},
+	{17, 1242, "nmasoverip"},
+	{17, 1243, "serialgateway"},
+	{17, 1244, "isbconference1"},
+	{17, 1245, "isbconference2"},
+	{17, 1246, "payrouter"},
+	{17, 1247, "visionpyramid"},
+	{17, 1248, "hermes"},
+	{17, 1249, "mesavistaco"},
+	{17, 1250, "swldy-sias"},
+	{17, 1251, "servergraph"},
+	{17, 1252, "bspne-pcc"},
+	{17, 1253, "q55-pcc"},
+	{17, 1254, "de-noc"},
+	{17, 1255, "de-cache-query"},
+	{17, 1256, "de-server"},
+	{17, 1257, "shockwave2"},
+	{17, 1258, "opennl"},
+	{17, 1259, "opennl-voice"},
+	{17, 1260, "ibm-ssd"},
+	{17, 1261, "mpshrsv"},
+	{17, 1262, "qnts-orb"},
+	{17, 1263, "dka"},
+	{17, 1264, "prat"},
+	{17, 1265, "dssiapi"},
+	{17, 1266, "dellpwrappks"},
+	{17, 1267, "epc"},
+	{17, 1268, "propel-msgsys"},
+	{17, 1269, "watilapp"},
+	{17, 1270, "opsmgr"},
+	{17, 1271, "excw"},
+	{17, 1272, "cspmlockmgr"},
+	{17, 1273, "emc-gateway"},
+	{17, 1274, "t1distproc"},
+	{17, 1275, "ivcollector"},
+	{17, 1277, "miva-mqs"},
+	{17, 1278, "dellwebadmin-1"},
+	{17, 1279, "dellwebadmin-2"},
+	{17, 1280, "pictrography"},
+	{17, 1281, "healthd"},
+	{17, 1282, "emperion"},
+	{17, 1283, "productinfo"},
+	{17, 1284, "iee-qfx"},
+	{17, 1285, "neoiface"},
+	{17, 1286, "netuitive"},
+	{17, 1287, "routematch"},
+	{17, 1288, "navbuddy"},
+	{17, 1289, "jwalkserver"},
+	{17, 1290, "winjaserver"},
+	{17, 1291, "seagulllms"},
+	{17, 1292, "dsdn"},
+	{17, 1293, "pkt-krb-ipsec"},
+	{17, 1294, "cmmdriver"},
+	{17, 1295, "ehtp"},
+	{17, 1296, "dproxy"},
+	{17, 1297, "sdproxy"},
+	{17, 1298, "lpcp"},
+	{17, 1299, "hp-sci"},
+	{17, 1300, "h323hostcallsc"},
+	{17, 1303, "sftsrv"},
+	{17, 1304, "boomerang"},
+	{17, 1305, "pe-mike"},
+	{17, 1306, "re-conn-proto"},
+	{17, 1307, "pacmand"},
+	{17, 1308, "odsi"},
+	{17, 1309, "jtag-server"},
+	{17, 1310, "husky"},
+	{17, 1311, "rxmon"},
+	{17, 1312, "sti-envision"},
+	{17, 1313, "bmc-patroldb"},
+	{17, 1314, "pdps"},
+	{17, 1315, "els"},
+	{17, 1316, "exbit-escp"},
+	{17, 1317, "vrts-ipcserver"},
+	{17, 1318, "krb5gatekeeper"},
+	{17, 1319, "amx-icsp"},
+	{17, 1320, "amx-axbnet"},
+	{17, 1321, "pip"},
+	{17, 1322, "novation"},
+	{17, 1323, "brcd"},
+	{17, 1324, "delta-mcp"},
+	{17, 1325, "dx-instrument"},
+	{17, 1326, "wimsic"},
+	{17, 1327, "ultrex"},
+	{17, 1328, "ewall"},
+	{17, 1329, "netdb-export"},
+	{17, 1330, "streetperfect"},
+	{17, 1331, "intersan"},
+	{17, 1332, "pcia-rxp-b"},
+	{17, 1333, "passwrd-policy"},
+	{17, 1334, "writesrv"},
+	{17, 1335, "digital-notary"},
+	{17, 1336, "ischat"},
+	{17, 1337, "menandmice-dns"},
+	{17, 1338, "wmc-log-svc"},
+	{17, 1339, "kjtsiteserver"},
+	{17, 1340, "naap"},
+	{17, 1341, "qubes"},
+	{17, 1342, "esbroker"},
+	{17, 1343, "re101"},
+	{17, 1344, "icap"},
+	{17, 1345, "vpjp"},
+	{17, 1346, "alta-ana-lm"},
+	{17, 1347, "bbn-mmc"},
+	{17, 1348, "bbn-mmx"},
+	{17, 1349, "sbook"},
+	{17, 1350, "editbench"},
+	{17, 1351, "equationbuilder"},
+	{17, 1352, "lotusnote"},
+	{17, 1353, "relief"},
+	{17, 1354, "XSIP-network"},
+	{17, 1355, "intuitive-edge"},
+	{17, 1356, "cuillamartin"},
+	{17, 1357, "pegboard"},
+	{17, 1358, "connlcli"},
+	{17, 1359, "ftsrv"},
+	{17, 1360, "mimer"},
+	{17, 1361, "linx"},
+	{17, 1362, "timeflies"},
+	{17, 1363, "ndm-requester"},
+	{17, 1364, "ndm-server"},
+	{17, 1365, "adapt-sna"},
+	{17, 1366, "netware-csp"},
+	{17, 1367, "dcs"},
+	{17, 1368, "screencast"},
+	{17, 1369, "gv-us"},
+	{17, 1370, "us-gv"},
+	{17, 1371, "fc-cli"},
+	{17, 1372, "fc-ser"},
+	{17, 1373, "chromagrafx"},
+	{17, 1374, "molly"},
+	{17, 1375, "bytex"},
+	{17, 1376, "ibm-pps"},
+	{17, 1377, "cichlid"},
+	{17, 1378, "elan"},
+	{17, 1379, "dbreporter"},
+	{17, 1380, "telesis-licman"},
+	{17, 1381, "apple-licman"},
+	{17, 1382, "udt-os"},
+	{17, 1383, "gwha"},
+	{17, 1384, "os-licman"},
+	{17, 1385, "atex-elmd"},
+	{17, 1386, "checksum"},
+	{17, 1387, "cadsi-lm"},
+	{17, 1388, "objective-dbc"},
+	{17, 1389, "iclpv-dm"},
+	{17, 1390, "iclpv-sc"},
+	{17, 1391, "iclpv-sas"},
+	{17, 1392, "iclpv-pm"},
+	{17, 1393, "iclpv-nls"},
+	{17, 1394, "iclpv-nlc"},
+	{17, 1395, "iclpv-wsm"},
+	{17, 1396, "dvl-activemail"},
+	{17, 1397, "audio-activmail"},
+	{17, 1398, "video-activmail"},
+	{17, 1399, "cadkey-licman"},
+	{17, 1400, "cadkey-tablet"},
+	{17, 1401, "goldleaf-licman"},
+	{17, 1402, "prm-sm-np"},
+	{17, 1403, "prm-nm-np"},
+	{17, 1404, "igi-lm"},
+	{17, 1405, "ibm-res"},
+	{17, 1406, "netlabs-lm"},
+	{17, 1408, "sophia-lm"},
+	{17, 1409, "here-lm"},
+	{17, 1410, "hiq"},
+	{17, 1411, "af"},
+	{17, 1412, "innosys"},
+	{17, 1413, "innosys-acl"},
+	{17, 1414, "ibm-mqseries"},
+	{17, 1415, "dbstar"},
+	{17, 1416, "novell-lu6-2"},
+	{17, 1417, "timbuktu-srv1"},
+	{17, 1418, "timbuktu-srv2"},
+	{17, 1419, "timbuktu-srv3"},
+	{17, 1420, "timbuktu-srv4"},
+	{17, 1421, "gandalf-lm"},
+	{17, 1422, "autodesk-lm"},
+	{17, 1423, "essbase"},
+	{17, 1424, "hybrid"},
+	{17, 1425, "zion-lm"},
+	{17, 1426, "sais"},
+	{17, 1427, "mloadd"},
+	{17, 1428, "informatik-lm"},
+	{17, 1429, "nms"},
+	{17, 1430, "tpdu"},
+	{17, 1431, "rgtp"},
+	{17, 1432, "blueberry-lm"},
+	{17, 1433, "ms-sql-s"},
+	{17, 1434, "ms-sql-m"},
+	{17, 1435, "ibm-cics"},
+	{17, 1436, "saism"},
+	{17, 1437, "tabula"},
+	{17, 1438, "eicon-server"},
+	{17, 1439, "eicon-x25"},
+	{17, 1440, "eicon-slp"},
+	{17, 1441, "cadis-1"},
+	{17, 1442, "cadis-2"},
+	{17, 1443, "ies-lm"},
+	{17, 1444, "marcam-lm"},
+	{17, 1445, "proxima-lm"},
+	{17, 1446, "ora-lm"},
+	{17, 1447, "apri-lm"},
+	{17, 1448, "oc-lm"},
+	{17, 1449, "peport"},
+	{17, 1450, "dwf"},
+	{17, 1451, "infoman"},
+	{17, 1452, "gtegsc-lm"},
+	{17, 1453, "genie-lm"},
+	{17, 1454, "interhdl-elmd"},
+	{17, 1455, "esl-lm"},
+	{17, 1456, "dca"},
+	{17, 1457, "valisys-lm"},
+	{17, 1458, "nrcabq-lm"},
+	{17, 1459, "proshare1"},
+	{17, 1460, "proshare2"},
+	{17, 1461, "ibm-wrless-lan"},
+	{17, 1462, "world-lm"},
+	{17, 1463, "nucleus"},
+	{17, 1464, "msl-lmd"},
+	{17, 1465, "pipes"},
+	{17, 1466, "oceansoft-lm"},
+	{17, 1467, "csdmbase"},
+	{17, 1468, "csdm"},
+	{17, 1469, "aal-lm"},
+	{17, 1470, "uaiact"},
+	{17, 1471, "csdmbase"},
+	{17, 1472, "csdm"},
+	{17, 1473, "openmath"},
+	{17, 1474, "telefinder"},
+	{17, 1475, "taligent-lm"},
+	{17, 1476, "clvm-cfg"},
+	{17, 1477, "ms-sna-server"},
+	{17, 1478, "ms-sna-base"},
+	{17, 1479, "dberegister"},
+	{17, 1480, "pacerforum"},
+	{17, 1481, "airs"},
+	{17, 1482, "miteksys-lm"},
+	{17, 1483, "afs"},
+	{17, 1484, "confluent"},
+	{17, 1485, "lansource"},
+	{17, 1486, "nms-topo-serv"},
+	{17, 1487, "localinfosrvr"},
+	{17, 1488, "docstor"},
+	{17, 1489, "dmdocbroker"},
+	{17, 1490, "insitu-conf"},
+	{17, 1492, "stone-design-1"},
+	{17, 1493, "netmap-lm"},
+	{17, 1494, "ica"},
+	{17, 1495, "cvc"},
+	{17, 1496, "liberty-lm"},
+	{17, 1497, "rfx-lm"},
+	{17, 1498, "sybase-sqlany"},
+	{17, 1499, "fhc"},
+	{17, 1500, "vlsi-lm"},
+	{17, 1501, "saiscm"},
+	{17, 1502, "shivadiscovery"},
+	{17, 1503, "imtc-mcs"},
+	{17, 1504, "evb-elm"},
+	{17, 1505, "funkproxy"},
+	{17, 1506, "utcd"},
+	{17, 1507, "symplex"},
+	{17, 1508, "diagmond"},
+	{17, 1509, "robcad-lm"},
+	{17, 1510, "mvx-lm"},
+	{17, 1511, "3l-l1"},
+	{17, 1512, "wins"},
+	{17, 1513, "fujitsu-dtc"},
+	{17, 1514, "fujitsu-dtcns"},
+	{17, 1515, "ifor-protocol"},
+	{17, 1516, "vpad"},
+	{17, 1517, "vpac"},
+	{17, 1518, "vpvd"},
+	{17, 1519, "vpvc"},
+	{17, 1520, "atm-zip-office"},
+	{17, 1521, "ncube-lm"},
+	{17, 1522, "ricardo-lm"},
+	{17, 1523, "cichild-lm"},
+	{17, 1524, "ingreslock"},
+	{17, 1525, "orasrv"},
+	{17, 1526, "pdap-np"},
+	{17, 1527, "tlisrv"},
+	{17, 1528, "norp"},
+	{17, 1529, "coauthor"},
+	{17, 1530, "rap-service"},
+	{17, 1531, "rap-listen"},
+	{17, 1532, "miroconnect"},
+	{17, 1533, "virtual-places"},
+	{17, 1534, "micromuse-lm"},
+	{17, 1535, "ampr-info"},
+	{17, 1536, "ampr-inter"},
+	{17, 1537, "sdsc-lm"},
+	{17, 1538, "3ds-lm"},
+	{17, 1539, "intellistor-lm"},
+	{17, 1540, "rds"},
+	{17, 1541, "rds2"},
+	{17, 1542, "gridgen-elmd"},
+	{17, 1543, "simba-cs"},
+	{17, 1544, "aspeclmd"},
+	{17, 1545, "vistium-share"},
+	{17, 1546, "abbaccuray"},
+	{17, 1547, "laplink"},
+	{17, 1548, "axon-lm"},
+	{17, 1549, "shivasound"},
+	{17, 1550, "3m-image-lm"},
+	{17, 1551, "hecmtl-db"},
+	{17, 1552, "pciarray"},
+	{17, 1553, "sna-cs"},
+	{17, 1554, "caci-lm"},
+	{17, 1555, "livelan"},
+	{17, 1556, "veritas-pbx"},
+	{17, 1557, "arbortext-lm"},
+	{17, 1558, "xingmpeg"},
+	{17, 1559, "web2host"},
+	{17, 1560, "asci-val"},
+	{17, 1561, "facilityview"},
+	{17, 1562, "pconnectmgr"},
+	{17, 1563, "cadabra-lm"},
+	{17, 1564, "pay-per-view"},
+	{17, 1565, "winddlb"},
+	{17, 1566, "corelvideo"},
+	{17, 1567, "jlicelmd"},
+	{17, 1568, "tsspmap"},
+	{17, 1569, "ets"},
+	{17, 1570, "orbixd"},
+	{17, 1571, "rdb-dbs-disp"},
+	{17, 1572, "chip-lm"},
+	{17, 1573, "itscomm-ns"},
+	{17, 1574, "mvel-lm"},
+	{17, 1575, "oraclenames"},
+	{17, 1576, "moldflow-lm"},
+	{17, 1577, "hypercube-lm"},
+	{17, 1578, "jacobus-lm"},
+	{17, 1579, "ioc-sea-lm"},
+	{17, 1580, "tn-tl-r2"},
+	{17, 1581, "mil-2045-47001"},
+	{17, 1582, "msims"},
+	{17, 1583, "simbaexpress"},
+	{17, 1584, "tn-tl-fd2"},
+	{17, 1585, "intv"},
+	{17, 1586, "ibm-abtact"},
+	{17, 1587, "pra-elmd"},
+	{17, 1588, "triquest-lm"},
+	{17, 1589, "vqp"},
+	{17, 1590, "gemini-lm"},
+	{17, 1591, "ncpm-pm"},
+	{17, 1592, "commonspace"},
+	{17, 1593, "mainsoft-lm"},
+	{17, 1594, "sixtrak"},
+	{17, 1595, "radio"},
+	{17, 1596, "radio-bc"},
+	{17, 1597, "orbplus-iiop"},
+	{17, 1598, "picknfs"},
+	{17, 1599, "simbaservices"},
+	{17, 1600, "issd"},
+	{17, 1601, "aas"},
+	{17, 1602, "inspect"},
+	{17, 1603, "picodbc"},
+	{17, 1604, "icabrowser"},
+	{17, 1605, "slp"},
+	{17, 1606, "slm-api"},
+	{17, 1607, "stt"},
+	{17, 1608, "smart-lm"},
+	{17, 1609, "isysg-lm"},
+	{17, 1610, "taurus-wh"},
+	{17, 1611, "ill"},
+	{17, 1612, "netbill-trans"},
+	{17, 1613, "netbill-keyrep"},
+	{17, 1614, "netbill-cred"},
+	{17, 1615, "netbill-auth"},
+	{17, 1616, "netbill-prod"},
+	{17, 1617, "nimrod-agent"},
+	{17, 1618, "skytelnet"},
+	{17, 1619, "xs-openstorage"},
+	{17, 1620, "faxportwinport"},
+	{17, 1621, "softdataphone"},
+	{17, 1622, "ontime"},
+	{17, 1623, "jaleosnd"},
+	{17, 1624, "udp-sr-port"},
+	{17, 1625, "svs-omagent"},
+	{17, 1626, "shockwave"},
+	{17, 1627, "t128-gateway"},
+	{17, 1628, "lontalk-norm"},
+	{17, 1629, "lontalk-urgnt"},
+	{17, 1630, "oraclenet8cman"},
+	{17, 1631, "visitview"},
+	{17, 1632, "pammratc"},
+	{17, 1633, "pammrpc"},
+	{17, 1634, "loaprobe"},
+	{17, 1635, "edb-server1"},
+	{17, 1636, "isdc"},
+	{17, 1637, "islc"},
+	{17, 1638, "ismc"},
+	{17, 1639, "cert-initiator"},
+	{17, 1640, "cert-responder"},
+	{17, 1641, "invision"},
+	{17, 1642, "isis-am"},
+	{17, 1643, "isis-ambc"},
+	{17, 1644, "saiseh"},
+	{17, 1645, "sightline"},
+	{17, 1646, "sa-msg-port"},
+	{17, 1647, "rsap"},
+	{17, 1648, "concurrent-lm"},
+	{17, 1649, "kermit"},
+	{17, 1650, "nkd"},
+	{17, 1651, "shiva-confsrvr"},
+	{17, 1652, "xnmp"},
+	{17, 1653, "alphatech-lm"},
+	{17, 1654, "stargatealerts"},
+	{17, 1655, "dec-mbadmin"},
+	{17, 1656, "dec-mbadmin-h"},
+	{17, 1657, "fujitsu-mmpdc"},
+	{17, 1658, "sixnetudr"},
+	{17, 1659, "sg-lm"},
+	{17, 1660, "skip-mc-gikreq"},
+	{17, 1661, "netview-aix-1"},
+	{17, 1662, "netview-aix-2"},
+	{17, 1663, "netview-aix-3"},
+	{17, 1664, "netview-aix-4"},
+	{17, 1665, "netview-aix-5"},
+	{17, 1666, "netview-aix-6"},
+	{17, 1667, "netview-aix-7"},
+	{17, 1668, "netview-aix-8"},
+	{17, 1669, "netview-aix-9"},
+	{17, 1670, "netview-aix-10"},
+	{17, 1671, "netview-aix-11"},
+	{17, 1672, "netview-aix-12"},
+	{17, 1673, "proshare-mc-1"},
+	{17, 1674, "proshare-mc-2"},
+	{17, 1675, "pdp"},
+	{17, 1676, "netcomm2"},
+	{17, 1677, "groupwise"},
+	{17, 1678, "prolink"},
+	{17, 1679, "darcorp-lm"},
+	{17, 1680, "microcom-sbp"},
+	{17, 1681, "sd-elmd"},
+	{17, 1682, "lanyon-lantern"},
+	{17, 1683, "ncpm-hip"},
+	{17, 1684, "snaresecure"},
+	{17, 1685, "n2nremote"},
+	{17, 1686, "cvmon"},
+	{17, 1687, "nsjtp-ctrl"},
+	{17, 1688, "nsjtp-data"},
+	{17, 1689, "firefox"},
+	{17, 1690, "ng-umds"},
+	{17, 1691, "empire-empuma"},
+	{17, 1692, "sstsys-lm"},
+	{17, 1693, "rrirtr"},
+	{17, 1694, "rrimwm"},
+	{17, 1695, "rrilwm"},
+	{17, 1696, "rrifmm"},
+	{17, 1697, "rrisat"},
+	{17, 1698, "rsvp-encap-1"},
+	{17, 1699, "rsvp-encap-2"},
+	{17, 1700, "mps-raft"},
+	{17, 1701, "l2f"},
+	{17, 1702, "deskshare"},
+	{17, 1703, "hb-engine"},
+	{17, 1704, "bcs-broker"},
+	{17, 1705, "slingshot"},
+	{17, 1706, "jetform"},
+	{17, 1707, "vdmplay"},
+	{17, 1708, "gat-lmd"},
+	{17, 1709, "centra"},
+	{17, 1710, "impera"},
+	{17, 1711, "pptconference"},
+	{17, 1712, "registrar"},
+	{17, 1713, "conferencetalk"},
+	{17, 1714, "sesi-lm"},
+	{17, 1715, "houdini-lm"},
+	{17, 1716, "xmsg"},
+	{17, 1717, "fj-hdnet"},
+	{17, 1718, "h323gatedisc"},
+	{17, 1719, "h323gatestat"},
+	{17, 1720, "h323hostcall"},
+	{17, 1721, "caicci"},
+	{17, 1722, "hks-lm"},
+	{17, 1723, "pptp"},
+	{17, 1724, "csbphonemaster"},
+	{17, 1725, "iden-ralp"},
+	{17, 1726, "iberiagames"},
+	{17, 1727, "winddx"},
+	{17, 1728, "telindus"},
+	{17, 1729, "citynl"},
+	{17, 1730, "roketz"},
+	{17, 1731, "msiccp"},
+	{17, 1732, "proxim"},
+	{17, 1733, "siipat"},
+	{17, 1734, "cambertx-lm"},
+	{17, 1735, "privatechat"},
+	{17, 1736, "street-stream"},
+	{17, 1737, "ultimad"},
+	{17, 1738, "gamegen1"},
+	{17, 1739, "webaccess"},
+	{17, 1740, "encore"},
+	{17, 1741, "cisco-net-mgmt"},
+	{17, 1742, "3Com-nsd"},
+	{17, 1743, "cinegrfx-lm"},
+	{17, 1744, "ncpm-ft"},
+	{17, 1745, "remote-winsock"},
+	{17, 1746, "ftrapid-1"},
+	{17, 1747, "ftrapid-2"},
+	{17, 1748, "oracle-em1"},
+	{17, 1749, "aspen-services"},
+	{17, 1750, "sslp"},
+	{17, 1751, "swiftnet"},
+	{17, 1752, "lofr-lm"},
+	{17, 1754, "oracle-em2"},
+	{17, 1755, "ms-streaming"},
+	{17, 1756, "capfast-lmd"},
+	{17, 1757, "cnhrp"},
+	{17, 1758, "tftp-mcast"},
+	{17, 1759, "spss-lm"},
+	{17, 1760, "www-ldap-gw"},
+	{17, 1761, "cft-0"},
+	{17, 1762, "cft-1"},
+	{17, 1763, "cft-2"},
+	{17, 1764, "cft-3"},
+	{17, 1765, "cft-4"},
+	{17, 1766, "cft-5"},
+	{17, 1767, "cft-6"},
+	{17, 1768, "cft-7"},
+	{17, 1769, "bmc-net-adm"},
+	{17, 1770, "bmc-net-svc"},
+	{17, 1771, "vaultbase"},
+	{17, 1772, "essweb-gw"},
+	{17, 1773, "kmscontrol"},
+	{17, 1774, "global-dtserv"},
+	{17, 1776, "femis"},
+	{17, 1777, "powerguardian"},
+	{17, 1778, "prodigy-intrnet"},
+	{17, 1779, "pharmasoft"},
+	{17, 1780, "dpkeyserv"},
+	{17, 1781, "answersoft-lm"},
+	{17, 1782, "hp-hcip"},
+	{17, 1784, "finle-lm"},
+	{17, 1785, "windlm"},
+	{17, 1786, "funk-logger"},
+	{17, 1787, "funk-license"},
+	{17, 1788, "psmond"},
+	{17, 1789, "hello"},
+	{17, 1790, "nmsp"},
+	{17, 1791, "ea1"},
+	{17, 1792, "ibm-dt-2"},
+	{17, 1793, "rsc-robot"},
+	{17, 1794, "cera-bcm"},
+	{17, 1795, "dpi-proxy"},
+	{17, 1796, "vocaltec-admin"},
+	{17, 1797, "uma"},
+	{17, 1798, "etp"},
+	{17, 1799, "netrisk"},
+	{17, 1800, "ansys-lm"},
+	{17, 1801, "msmq"},
+	{17, 1802, "concomp1"},
+	{17, 1803, "hp-hcip-gwy"},
+	{17, 1804, "enl"},
+	{17, 1805, "enl-name"},
+	{17, 1806, "musiconline"},
+	{17, 1807, "fhsp"},
+	{17, 1808, "oracle-vp2"},
+	{17, 1809, "oracle-vp1"},
+	{17, 1810, "jerand-lm"},
+	{17, 1811, "scientia-sdb"},
+	{17, 1812, "radius"},
+	{17, 1813, "radius-acct"},
+	{17, 1814, "tdp-suite"},
+	{17, 1815, "mmpft"},
+	{17, 1816, "harp"},
+	{17, 1817, "rkb-oscs"},
+	{17, 1818, "etftp"},
+	{17, 1819, "plato-lm"},
+	{17, 1820, "mcagent"},
+	{17, 1821, "donnyworld"},
+	{17, 1822, "es-elmd"},
+	{17, 1823, "unisys-lm"},
+	{17, 1824, "metrics-pas"},
+	{17, 1825, "direcpc-video"},
+	{17, 1826, "ardt"},
+	{17, 1827, "asi"},
+	{17, 1828, "itm-mcell-u"},
+	{17, 1829, "optika-emedia"},
+	{17, 1830, "net8-cman"},
+	{17, 1831, "myrtle"},
+	{17, 1832, "tht-treasure"},
+	{17, 1833, "udpradio"},
+	{17, 1834, "ardusuni"},
+	{17, 1835, "ardusmul"},
+	{17, 1836, "ste-smsc"},
+	{17, 1837, "csoft1"},
+	{17, 1838, "talnet"},
+	{17, 1839, "netopia-vo1"},
+	{17, 1840, "netopia-vo2"},
+	{17, 1841, "netopia-vo3"},
+	{17, 1842, "netopia-vo4"},
+	{17, 1843, "netopia-vo5"},
+	{17, 1844, "direcpc-dll"},
+	{17, 1845, "altalink"},
+	{17, 1846, "tunstall-pnc"},
+	{17, 1847, "slp-notify"},
+	{17, 1848, "fjdocdist"},
+	{17, 1849, "alpha-sms"},
+	{17, 1850, "gsi"},
+	{17, 1851, "ctcd"},
+	{17, 1852, "virtual-time"},
+	{17, 1853, "vids-avtp"},
+	{17, 1854, "buddy-draw"},
+	{17, 1855, "fiorano-rtrsvc"},
+	{17, 1856, "fiorano-msgsvc"},
+	{17, 1857, "datacaptor"},
+	{17, 1858, "privateark"},
+	{17, 1859, "gammafetchsvr"},
+	{17, 1860, "sunscalar-svc"},
+	{17, 1861, "lecroy-vicp"},
+	{17, 1862, "mysql-cm-agent"},
+	{17, 1863, "msnp"},
+	{17, 1864, "paradym-31port"},
+	{17, 1865, "entp"},
+	{17, 1866, "swrmi"},
+	{17, 1867, "udrive"},
+	{17, 1868, "viziblebrowser"},
+	{17, 1869, "transact"},
+	{17, 1870, "sunscalar-dns"},
+	{17, 1871, "canocentral0"},
+	{17, 1872, "canocentral1"},
+	{17, 1873, "fjmpjps"},
+	{17, 1874, "fjswapsnp"},
+	{17, 1875, "westell-stats"},
+	{17, 1876, "ewcappsrv"},
+	{17, 1877, "hp-webqosdb"},
+	{17, 1878, "drmsmc"},
+	{17, 1879, "nettgain-nms"},
+	{17, 1880, "vsat-control"},
+	{17, 1881, "ibm-mqseries2"},
+	{17, 1882, "ecsqdmn"},
+	{17, 1883, "mqtt"},
+	{17, 1884, "idmaps"},
+	{17, 1885, "vrtstrapserver"},
+	{17, 1886, "leoip"},
+	{17, 1887, "filex-lport"},
+	{17, 1888, "ncconfig"},
+	{17, 1889, "unify-adapter"},
+	{17, 1890, "wilkenlistener"},
+	{17, 1891, "childkey-notif"},
+	{17, 1892, "childkey-ctrl"},
+	{17, 1893, "elad"},
+	{17, 1894, "o2server-port"},
+	{17, 1896, "b-novative-ls"},
+	{17, 1897, "metaagent"},
+	{17, 1898, "cymtec-port"},
+	{17, 1899, "mc2studios"},
+	{17, 1900, "ssdp"},
+	{17, 1901, "fjicl-tep-a"},
+	{17, 1902, "fjicl-tep-b"},
+	{17, 1903, "linkname"},
+	{17, 1904, "fjicl-tep-c"},
+	{17, 1905, "sugp"},
+	{17, 1906, "tpmd"},
+	{17, 1907, "intrastar"},
+	{17, 1908, "dawn"},
+	{17, 1909, "global-wlink"},
+	{17, 1910, "ultrabac"},
+	{17, 1911, "mtp"},
+	{17, 1912, "rhp-iibp"},
+	{17, 1913, "armadp"},
+	{17, 1914, "elm-momentum"},
+	{17, 1915, "facelink"},
+	{17, 1916, "persona"},
+	{17, 1917, "noagent"},
+	{17, 1918, "can-nds"},
+	{17, 1919, "can-dch"},
+	{17, 1920, "can-ferret"},
+	{17, 1921, "noadmin"},
+	{17, 1922, "tapestry"},
+	{17, 1923, "spice"},
+	{17, 1924, "xiip"},
+	{17, 1925, "discovery-port"},
+	{17, 1926, "egs"},
+	{17, 1927, "videte-cipc"},
+	{17, 1928, "emsd-port"},
+	{17, 1929, "bandwiz-system"},
+	{17, 1930, "driveappserver"},
+	{17, 1931, "amdsched"},
+	{17, 1932, "ctt-broker"},
+	{17, 1933, "xmapi"},
+	{17, 1934, "xaapi"},
+	{17, 1935, "macromedia-fcs"},
+	{17, 1936, "jetcmeserver"},
+	{17, 1937, "jwserver"},
+	{17, 1938, "jwclient"},
+	{17, 1939, "jvserver"},
+	{17, 1940, "jvclient"},
+	{17, 1941, "dic-aida"},
+	{17, 1942, "res"},
+	{17, 1943, "beeyond-media"},
+	{17, 1944, "close-combat"},
+	{17, 1945, "dialogic-elmd"},
+	{17, 1946, "tekpls"},
+	{17, 1947, "sentinelsrm"},
+	{17, 1948, "eye2eye"},
+	{17, 1949, "ismaeasdaqlive"},
+	{17, 1950, "ismaeasdaqtest"},
+	{17, 1951, "bcs-lmserver"},
+	{17, 1952, "mpnjsc"},
+	{17, 1953, "rapidbase"},
+	{17, 1954, "abr-api"},
+	{17, 1955, "abr-secure"},
+	{17, 1956, "vrtl-vmf-ds"},
+	{17, 1957, "unix-status"},
+	{17, 1958, "dxadmind"},
+	{17, 1959, "simp-all"},
+	{17, 1960, "nasmanager"},
+	{17, 1961, "bts-appserver"},
+	{17, 1962, "biap-mp"},
+	{17, 1963, "webmachine"},
+	{17, 1964, "solid-e-engine"},
+	{17, 1965, "tivoli-npm"},
+	{17, 1966, "slush"},
+	{17, 1967, "sns-quote"},
+	{17, 1968, "lipsinc"},
+	{17, 1969, "lipsinc1"},
+	{17, 1970, "netop-rc"},
+	{17, 1971, "netop-school"},
+	{17, 1972, "intersys-cache"},
+	{17, 1973, "dlsrap"},
+	{17, 1974, "drp"},
+	{17, 1975, "tcoflashagent"},
+	{17, 1976, "tcoregagent"},
+	{17, 1977, "tcoaddressbook"},
+	{17, 1978, "unisql"},
+	{17, 1979, "unisql-java"},
+	{17, 1980, "pearldoc-xact"},
+	{17, 1981, "p2pq"},
+	{17, 1982, "estamp"},
+	{17, 1983, "lhtp"},
+	{17, 1984, "bb"},
+	{17, 1985, "hsrp"},
+	{17, 1986, "licensedaemon"},
+	{17, 1987, "tr-rsrb-p1"},
+	{17, 1988, "tr-rsrb-p2"},
+	{17, 1989, "tr-rsrb-p3"},
+	{17, 1990, "stun-p1"},
+	{17, 1991, "stun-p2"},
+	{17, 1992, "stun-p3"},
+	{17, 1993, "snmp-tcp-port"},
+	{17, 1994, "stun-port"},
+	{17, 1995, "perf-port"},
+	{17, 1996, "tr-rsrb-port"},
+	{17, 1997, "gdp-port"},
+	{17, 1998, "x25-svc-port"},
+	{17, 1999, "tcp-id-port"},
+	{17, 2000, "cisco-sccp"},
+	{17, 2001, "wizard"},
+	{17, 2002, "globe"},
+	{17, 2003, "brutus"},
+	{17, 2004, "emce"},
+	{17, 2005, "oracle"},
+	{17, 2006, "raid-cd"},
+	{17, 2007, "raid-am"},
+	{17, 2008, "terminaldb"},
+	{17, 2009, "whosockami"},
+	{17, 2010, "pipe-server"},
+	{17, 2011, "servserv"},
+	{17, 2012, "raid-ac"},
+	{17, 2013, "raid-cd"},
+	{17, 2014, "raid-sf"},
+	{17, 2015, "raid-cs"},
+	{17, 2016, "bootserver"},
+	{17, 2017, "bootclient"},
+	{17, 2018, "rellpack"},
+	{17, 2019, "about"},
+	{17, 2020, "xinupageserver"},
+	{17, 2021, "xinuexpansion1"},
+	{17, 2022, "xinuexpansion2"},
+	{17, 2023, "xinuexpansion3"},
+	{17, 2024, "xinuexpansion4"},
+	{17, 2025, "xribs"},
+	{17, 2026, "scrabble"},
+	{17, 2027, "shadowserver"},
+	{17, 2028, "submitserver"},
+	{17, 2029, "hsrpv6"},
+	{17, 2030, "device2"},
+	{17, 2031, "mobrien-chat"},
+	{17, 2032, "blackboard"},
+	{17, 2033, "glogger"},
+	{17, 2034, "scoremgr"},
+	{17, 2035, "imsldoc"},
+	{17, 2036, "e-dpnet"},
+	{17, 2037, "applus"},
+	{17, 2038, "objectmanager"},
+	{17, 2039, "prizma"},
+	{17, 2040, "lam"},
+	{17, 2041, "interbase"},
+	{17, 2042, "isis"},
+	{17, 2043, "isis-bcast"},
+	{17, 2044, "rimsl"},
+	{17, 2045, "cdfunc"},
+	{17, 2046, "sdfunc"},
+	{17, 2047, "dls"},
+	{17, 2048, "dls-monitor"},
+	{17, 2049, "shilp"},
+	{17, 2050, "av-emb-config"},
+	{17, 2051, "epnsdp"},
+	{17, 2052, "clearvisn"},
+	{17, 2053, "lot105-ds-upd"},
+	{17, 2054, "weblogin"},
+	{17, 2055, "iop"},
+	{17, 2056, "omnisky"},
+	{17, 2057, "rich-cp"},
+	{17, 2058, "newwavesearch"},
+	{17, 2059, "bmc-messaging"},
+	{17, 2060, "teleniumdaemon"},
+	{17, 2061, "netmount"},
+	{17, 2062, "icg-swp"},
+	{17, 2063, "icg-bridge"},
+	{17, 2064, "icg-iprelay"},
+	{17, 2065, "dlsrpn"},
+	{17, 2066, "aura"},
+	{17, 2067, "dlswpn"},
+	{17, 2068, "avauthsrvprtcl"},
+	{17, 2069, "event-port"},
+	{17, 2070, "ah-esp-encap"},
+	{17, 2071, "acp-port"},
+	{17, 2072, "msync"},
+	{17, 2073, "gxs-data-port"},
+	{17, 2074, "vrtl-vmf-sa"},
+	{17, 2075, "newlixengine"},
+	{17, 2076, "newlixconfig"},
+	{17, 2077, "tsrmagt"},
+	{17, 2078, "tpcsrvr"},
+	{17, 2079, "idware-router"},
+	{17, 2080, "autodesk-nlm"},
+	{17, 2081, "kme-trap-port"},
+	{17, 2082, "infowave"},
+	{17, 2083, "radsec"},
+	{17, 2084, "sunclustergeo"},
+	{17, 2085, "ada-cip"},
+	{17, 2086, "gnunet"},
+	{17, 2087, "eli"},
+	{17, 2088, "ip-blf"},
+	{17, 2089, "sep"},
+	{17, 2090, "lrp"},
+	{17, 2091, "prp"},
+	{17, 2092, "descent3"},
+	{17, 2093, "nbx-cc"},
+	{17, 2094, "nbx-au"},
+	{17, 2095, "nbx-ser"},
+	{17, 2096, "nbx-dir"},
+	{17, 2097, "jetformpreview"},
+	{17, 2098, "dialog-port"},
+	{17, 2099, "h2250-annex-g"},
+	{17, 2100, "amiganetfs"},
+	{17, 2101, "rtcm-sc104"},
+	{17, 2102, "zephyr-srv"},
+	{17, 2103, "zephyr-clt"},
+	{17, 2104, "zephyr-hm"},
+	{17, 2105, "minipay"},
+	{17, 2106, "mzap"},
+	{17, 2107, "bintec-admin"},
+	{17, 2108, "comcam"},
+	{17, 2109, "ergolight"},
+	{17, 2110, "umsp"},
+	{17, 2111, "dsatp"},
+	{17, 2112, "idonix-metanet"},
+	{17, 2113, "hsl-storm"},
+	{17, 2114, "ariascribe"},
+	{17, 2115, "kdm"},
+	{17, 2116, "ccowcmr"},
+	{17, 2117, "mentaclient"},
+	{17, 2118, "mentaserver"},
+	{17, 2119, "gsigatekeeper"},
+	{17, 2120, "qencp"},
+	{17, 2121, "scientia-ssdb"},
+	{17, 2122, "caupc-remote"},
+	{17, 2123, "gtp-control"},
+	{17, 2124, "elatelink"},
+	{17, 2125, "lockstep"},
+	{17, 2126, "pktcable-cops"},
+	{17, 2127, "index-pc-wb"},
+	{17, 2128, "net-steward"},
+	{17, 2129, "cs-live"},
+	{17, 2130, "xds"},
+	{17, 2131, "avantageb2b"},
+	{17, 2132, "solera-epmap"},
+	{17, 2133, "zymed-zpp"},
+	{17, 2134, "avenue"},
+	{17, 2135, "gris"},
+	{17, 2136, "appworxsrv"},
+	{17, 2137, "connect"},
+	{17, 2138, "unbind-cluster"},
+	{17, 2139, "ias-auth"},
+	{17, 2140, "ias-reg"},
+	{17, 2141, "ias-admind"},
+	{17, 2142, "tdmoip"},
+	{17, 2143, "lv-jc"},
+	{17, 2144, "lv-ffx"},
+	{17, 2145, "lv-pici"},
+	{17, 2146, "lv-not"},
+	{17, 2147, "lv-auth"},
+	{17, 2148, "veritas-ucl"},
+	{17, 2149, "acptsys"},
+	{17, 2150, "dynamic3d"},
+	{17, 2151, "docent"},
+	{17, 2152, "gtp-user"},
+	{17, 2153, "ctlptc"},
+	{17, 2154, "stdptc"},
+	{17, 2155, "brdptc"},
+	{17, 2156, "trp"},
+	{17, 2157, "xnds"},
+	{17, 2158, "touchnetplus"},
+	{17, 2159, "gdbremote"},
+	{17, 2160, "apc-2160"},
+	{17, 2161, "apc-2161"},
+	{17, 2162, "navisphere"},
+	{17, 2163, "navisphere-sec"},
+	{17, 2164, "ddns-v3"},
+	{17, 2165, "x-bone-api"},
+	{17, 2166, "iwserver"},
+	{17, 2167, "raw-serial"},
+	{17, 2168, "easy-soft-mux"},
+	{17, 2169, "brain"},
+	{17, 2170, "eyetv"},
+	{17, 2171, "msfw-storage"},
+	{17, 2172, "msfw-s-storage"},
+	{17, 2173, "msfw-replica"},
+	{17, 2174, "msfw-array"},
+	{17, 2175, "airsync"},
+	{17, 2176, "rapi"},
+	{17, 2177, "qwave"},
+	{17, 2178, "bitspeer"},
+	{17, 2179, "vmrdp"},
+	{17, 2180, "mc-gt-srv"},
+	{17, 2181, "eforward"},
+	{17, 2182, "cgn-stat"},
+	{17, 2183, "cgn-config"},
+	{17, 2184, "nvd"},
+	{17, 2185, "onbase-dds"},
+	{17, 2186, "gtaua"},
+	{17, 2187, "ssmd"},
+	{17, 2190, "tivoconnect"},
+	{17, 2191, "tvbus"},
+	{17, 2192, "asdis"},
+	{17, 2193, "drwcs"},
+	{17, 2197, "mnp-exchange"},
+	{17, 2198, "onehome-remote"},
+	{17, 2199, "onehome-help"},
+	{17, 2201, "ats"},
+	{17, 2202, "imtc-map"},
+	{17, 2203, "b2-runtime"},
+	{17, 2204, "b2-license"},
+	{17, 2205, "jps"},
+	{17, 2206, "hpocbus"},
+	{17, 2207, "hpssd"},
+	{17, 2208, "hpiod"},
+	{17, 2209, "rimf-ps"},
+	{17, 2210, "noaaport"},
+	{17, 2211, "emwin"},
+	{17, 2212, "leecoposserver"},
+	{17, 2213, "kali"},
+	{17, 2214, "rpi"},
+	{17, 2215, "ipcore"},
+	{17, 2216, "vtu-comms"},
+	{17, 2217, "gotodevice"},
+	{17, 2218, "bounzza"},
+	{17, 2219, "netiq-ncap"},
+	{17, 2220, "netiq"},
+	{17, 2221, "ethernet-ip-s"},
+	{17, 2222, "EtherNet-IP-1"},
+	{17, 2223, "rockwell-csp2"},
+	{17, 2224, "efi-mg"},
+	{17, 2226, "di-drm"},
+	{17, 2227, "di-msg"},
+	{17, 2228, "ehome-ms"},
+	{17, 2229, "datalens"},
+	{17, 2230, "queueadm"},
+	{17, 2231, "wimaxasncp"},
+	{17, 2232, "ivs-video"},
+	{17, 2233, "infocrypt"},
+	{17, 2234, "directplay"},
+	{17, 2235, "sercomm-wlink"},
+	{17, 2236, "nani"},
+	{17, 2237, "optech-port1-lm"},
+	{17, 2238, "aviva-sna"},
+	{17, 2239, "imagequery"},
+	{17, 2240, "recipe"},
+	{17, 2241, "ivsd"},
+	{17, 2242, "foliocorp"},
+	{17, 2243, "magicom"},
+	{17, 2244, "nmsserver"},
+	{17, 2245, "hao"},
+	{17, 2246, "pc-mta-addrmap"},
+	{17, 2247, "antidotemgrsvr"},
+	{17, 2248, "ums"},
+	{17, 2249, "rfmp"},
+	{17, 2250, "remote-collab"},
+	{17, 2251, "dif-port"},
+	{17, 2252, "njenet-ssl"},
+	{17, 2253, "dtv-chan-req"},
+	{17, 2254, "seispoc"},
+	{17, 2255, "vrtp"},
+	{17, 2256, "pcc-mfp"},
+	{17, 2257, "simple-tx-rx"},
+	{17, 2258, "rcts"},
+	{17, 2259, "bid-serv"},
+	{17, 2260, "apc-2260"},
+	{17, 2261, "comotionmaster"},
+	{17, 2262, "comotionback"},
+	{17, 2263, "ecwcfg"},
+	{17, 2264, "apx500api-1"},
+	{17, 2265, "apx500api-2"},
+	{17, 2266, "mfserver"},
+	{17, 2267, "ontobroker"},
+	{17, 2268, "amt"},
+	{17, 2269, "mikey"},
+	{17, 2270, "starschool"},
+	{17, 2271, "mmcals"},
+	{17, 2272, "mmcal"},
+	{17, 2273, "mysql-im"},
+	{17, 2274, "pcttunnell"},
+	{17, 2275, "ibridge-data"},
+	{17, 2276, "ibridge-mgmt"},
+	{17, 2277, "bluectrlproxy"},
+	{17, 2278, "s3db"},
+	{17, 2279, "xmquery"},
+	{17, 2280, "lnvpoller"},
+	{17, 2281, "lnvconsole"},
+	{17, 2282, "lnvalarm"},
+	{17, 2283, "lnvstatus"},
+	{17, 2284, "lnvmaps"},
+	{17, 2285, "lnvmailmon"},
+	{17, 2286, "nas-metering"},
+	{17, 2287, "dna"},
+	{17, 2288, "netml"},
+	{17, 2289, "dict-lookup"},
+	{17, 2290, "sonus-logging"},
+	{17, 2291, "eapsp"},
+	{17, 2292, "mib-streaming"},
+	{17, 2293, "npdbgmngr"},
+	{17, 2294, "konshus-lm"},
+	{17, 2295, "advant-lm"},
+	{17, 2296, "theta-lm"},
+	{17, 2297, "d2k-datamover1"},
+	{17, 2298, "d2k-datamover2"},
+	{17, 2299, "pc-telecommute"},
+	{17, 2300, "cvmmon"},
+	{17, 2301, "cpq-wbem"},
+	{17, 2302, "binderysupport"},
+	{17, 2303, "proxy-gateway"},
+	{17, 2304, "attachmate-uts"},
+	{17, 2305, "mt-scaleserver"},
+	{17, 2306, "tappi-boxnet"},
+	{17, 2307, "pehelp"},
+	{17, 2308, "sdhelp"},
+	{17, 2309, "sdserver"},
+	{17, 2310, "sdclient"},
+	{17, 2311, "messageservice"},
+	{17, 2312, "wanscaler"},
+	{17, 2313, "iapp"},
+	{17, 2314, "cr-websystems"},
+	{17, 2315, "precise-sft"},
+	{17, 2316, "sent-lm"},
+	{17, 2317, "attachmate-g32"},
+	{17, 2318, "cadencecontrol"},
+	{17, 2319, "infolibria"},
+	{17, 2320, "siebel-ns"},
+	{17, 2321, "rdlap"},
+	{17, 2322, "ofsd"},
+	{17, 2323, "3d-nfsd"},
+	{17, 2324, "cosmocall"},
+	{17, 2325, "ansysli"},
+	{17, 2326, "idcp"},
+	{17, 2327, "xingcsm"},
+	{17, 2328, "netrix-sftm"},
+	{17, 2329, "nvd"},
+	{17, 2330, "tscchat"},
+	{17, 2331, "agentview"},
+	{17, 2332, "rcc-host"},
+	{17, 2333, "snapp"},
+	{17, 2334, "ace-client"},
+	{17, 2335, "ace-proxy"},
+	{17, 2336, "appleugcontrol"},
+	{17, 2337, "ideesrv"},
+	{17, 2338, "norton-lambert"},
+	{17, 2339, "3com-webview"},
+	{17, 2340, "wrs-registry"},
+	{17, 2341, "xiostatus"},
+	{17, 2342, "manage-exec"},
+	{17, 2343, "nati-logos"},
+	{17, 2344, "fcmsys"},
+	{17, 2345, "dbm"},
+	{17, 2346, "redstorm-join"},
+	{17, 2347, "redstorm-find"},
+	{17, 2348, "redstorm-info"},
+	{17, 2349, "redstorm-diag"},
+	{17, 2350, "psbserver"},
+	{17, 2351, "psrserver"},
+	{17, 2352, "pslserver"},
+	{17, 2353, "pspserver"},
+	{17, 2354, "psprserver"},
+	{17, 2355, "psdbserver"},
+	{17, 2356, "gxtelmd"},
+	{17, 2357, "unihub-server"},
+	{17, 2358, "futrix"},
+	{17, 2359, "flukeserver"},
+	{17, 2360, "nexstorindltd"},
+	{17, 2361, "tl1"},
+	{17, 2362, "digiman"},
+	{17, 2363, "mediacntrlnfsd"},
+	{17, 2364, "oi-2000"},
+	{17, 2365, "dbref"},
+	{17, 2366, "qip-login"},
+	{17, 2367, "service-ctrl"},
+	{17, 2368, "opentable"},
+	{17, 2369, "bif-p2p"},
+	{17, 2370, "l3-hbmon"},
+	{17, 2372, "lanmessenger"},
+	{17, 2378, "dali"},
+	{17, 2381, "compaq-https"},
+	{17, 2382, "ms-olap3"},
+	{17, 2383, "ms-olap4"},
+	{17, 2384, "sd-capacity"},
+	{17, 2385, "sd-data"},
+	{17, 2386, "virtualtape"},
+	{17, 2387, "vsamredirector"},
+	{17, 2388, "mynahautostart"},
+	{17, 2389, "ovsessionmgr"},
+	{17, 2390, "rsmtp"},
+	{17, 2391, "3com-net-mgmt"},
+	{17, 2392, "tacticalauth"},
+	{17, 2393, "ms-olap1"},
+	{17, 2394, "ms-olap2"},
+	{17, 2395, "lan900-remote"},
+	{17, 2396, "wusage"},
+	{17, 2397, "ncl"},
+	{17, 2398, "orbiter"},
+	{17, 2399, "fmpro-fdal"},
+	{17, 2400, "opequus-server"},
+	{17, 2401, "cvspserver"},
+	{17, 2402, "taskmaster2000"},
+	{17, 2403, "taskmaster2000"},
+	{17, 2404, "iec-104"},
+	{17, 2405, "trc-netpoll"},
+	{17, 2406, "jediserver"},
+	{17, 2407, "orion"},
+	{17, 2409, "sns-protocol"},
+	{17, 2410, "vrts-registry"},
+	{17, 2411, "netwave-ap-mgmt"},
+	{17, 2412, "cdn"},
+	{17, 2413, "orion-rmi-reg"},
+	{17, 2414, "beeyond"},
+	{17, 2415, "codima-rtp"},
+	{17, 2416, "rmtserver"},
+	{17, 2417, "composit-server"},
+	{17, 2418, "cas"},
+	{17, 2419, "attachmate-s2s"},
+	{17, 2420, "dslremote-mgmt"},
+	{17, 2421, "g-talk"},
+	{17, 2422, "crmsbits"},
+	{17, 2423, "rnrp"},
+	{17, 2424, "kofax-svr"},
+	{17, 2425, "fjitsuappmgr"},
+	{17, 2426, "vcmp"},
+	{17, 2427, "mgcp-gateway"},
+	{17, 2428, "ott"},
+	{17, 2429, "ft-role"},
+	{17, 2430, "venus"},
+	{17, 2431, "venus-se"},
+	{17, 2432, "codasrv"},
+	{17, 2433, "codasrv-se"},
+	{17, 2434, "pxc-epmap"},
+	{17, 2435, "optilogic"},
+	{17, 2436, "topx"},
+	{17, 2437, "unicontrol"},
+	{17, 2438, "msp"},
+	{17, 2439, "sybasedbsynch"},
+	{17, 2440, "spearway"},
+	{17, 2441, "pvsw-inet"},
+	{17, 2442, "netangel"},
+	{17, 2443, "powerclientcsf"},
+	{17, 2444, "btpp2sectrans"},
+	{17, 2445, "dtn1"},
+	{17, 2446, "bues-service"},
+	{17, 2447, "ovwdb"},
+	{17, 2448, "hpppssvr"},
+	{17, 2449, "ratl"},
+	{17, 2450, "netadmin"},
+	{17, 2451, "netchat"},
+	{17, 2452, "snifferclient"},
+	{17, 2453, "madge-ltd"},
+	{17, 2454, "indx-dds"},
+	{17, 2455, "wago-io-system"},
+	{17, 2456, "altav-remmgt"},
+	{17, 2457, "rapido-ip"},
+	{17, 2458, "griffin"},
+	{17, 2459, "xrpl"},
+	{17, 2460, "ms-theater"},
+	{17, 2461, "qadmifoper"},
+	{17, 2462, "qadmifevent"},
+	{17, 2463, "lsi-raid-mgmt"},
+	{17, 2464, "direcpc-si"},
+	{17, 2465, "lbm"},
+	{17, 2466, "lbf"},
+	{17, 2467, "high-criteria"},
+	{17, 2468, "qip-msgd"},
+	{17, 2469, "mti-tcs-comm"},
+	{17, 2470, "taskman-port"},
+	{17, 2471, "seaodbc"},
+	{17, 2472, "c3"},
+	{17, 2473, "aker-cdp"},
+	{17, 2474, "vitalanalysis"},
+	{17, 2475, "ace-server"},
+	{17, 2476, "ace-svr-prop"},
+	{17, 2477, "ssm-cvs"},
+	{17, 2478, "ssm-cssps"},
+	{17, 2479, "ssm-els"},
+	{17, 2480, "powerexchange"},
+	{17, 2481, "giop"},
+	{17, 2482, "giop-ssl"},
+	{17, 2483, "ttc"},
+	{17, 2484, "ttc-ssl"},
+	{17, 2485, "netobjects1"},
+	{17, 2486, "netobjects2"},
+	{17, 2487, "pns"},
+	{17, 2488, "moy-corp"},
+	{17, 2489, "tsilb"},
+	{17, 2490, "qip-qdhcp"},
+	{17, 2491, "conclave-cpp"},
+	{17, 2492, "groove"},
+	{17, 2493, "talarian-mqs"},
+	{17, 2494, "bmc-ar"},
+	{17, 2495, "fast-rem-serv"},
+	{17, 2496, "dirgis"},
+	{17, 2497, "quaddb"},
+	{17, 2498, "odn-castraq"},
+	{17, 2499, "unicontrol"},
+	{17, 2500, "rtsserv"},
+	{17, 2501, "rtsclient"},
+	{17, 2502, "kentrox-prot"},
+	{17, 2503, "nms-dpnss"},
+	{17, 2504, "wlbs"},
+	{17, 2505, "ppcontrol"},
+	{17, 2506, "jbroker"},
+	{17, 2507, "spock"},
+	{17, 2508, "jdatastore"},
+	{17, 2509, "fjmpss"},
+	{17, 2510, "fjappmgrbulk"},
+	{17, 2511, "metastorm"},
+	{17, 2512, "citrixima"},
+	{17, 2513, "citrixadmin"},
+	{17, 2514, "facsys-ntp"},
+	{17, 2515, "facsys-router"},
+	{17, 2516, "maincontrol"},
+	{17, 2517, "call-sig-trans"},
+	{17, 2518, "willy"},
+	{17, 2519, "globmsgsvc"},
+	{17, 2520, "pvsw"},
+	{17, 2521, "adaptecmgr"},
+	{17, 2522, "windb"},
+	{17, 2523, "qke-llc-v3"},
+	{17, 2524, "optiwave-lm"},
+	{17, 2525, "ms-v-worlds"},
+	{17, 2526, "ema-sent-lm"},
+	{17, 2527, "iqserver"},
+	{17, 2528, "ncr-ccl"},
+	{17, 2529, "utsftp"},
+	{17, 2530, "vrcommerce"},
+	{17, 2531, "ito-e-gui"},
+	{17, 2532, "ovtopmd"},
+	{17, 2533, "snifferserver"},
+	{17, 2534, "combox-web-acc"},
+	{17, 2535, "madcap"},
+	{17, 2536, "btpp2audctr1"},
+	{17, 2537, "upgrade"},
+	{17, 2538, "vnwk-prapi"},
+	{17, 2539, "vsiadmin"},
+	{17, 2540, "lonworks"},
+	{17, 2541, "lonworks2"},
+	{17, 2542, "udrawgraph"},
+	{17, 2543, "reftek"},
+	{17, 2544, "novell-zen"},
+	{17, 2545, "sis-emt"},
+	{17, 2546, "vytalvaultbrtp"},
+	{17, 2547, "vytalvaultvsmp"},
+	{17, 2548, "vytalvaultpipe"},
+	{17, 2549, "ipass"},
+	{17, 2550, "ads"},
+	{17, 2551, "isg-uda-server"},
+	{17, 2552, "call-logging"},
+	{17, 2553, "efidiningport"},
+	{17, 2554, "vcnet-link-v10"},
+	{17, 2555, "compaq-wcp"},
+	{17, 2556, "nicetec-nmsvc"},
+	{17, 2557, "nicetec-mgmt"},
+	{17, 2558, "pclemultimedia"},
+	{17, 2559, "lstp"},
+	{17, 2560, "labrat"},
+	{17, 2561, "mosaixcc"},
+	{17, 2562, "delibo"},
+	{17, 2563, "cti-redwood"},
+	{17, 2564, "hp-3000-telnet"},
+	{17, 2565, "coord-svr"},
+	{17, 2566, "pcs-pcw"},
+	{17, 2567, "clp"},
+	{17, 2568, "spamtrap"},
+	{17, 2569, "sonuscallsig"},
+	{17, 2570, "hs-port"},
+	{17, 2571, "cecsvc"},
+	{17, 2572, "ibp"},
+	{17, 2573, "trustestablish"},
+	{17, 2574, "blockade-bpsp"},
+	{17, 2575, "hl7"},
+	{17, 2576, "tclprodebugger"},
+	{17, 2577, "scipticslsrvr"},
+	{17, 2578, "rvs-isdn-dcp"},
+	{17, 2579, "mpfoncl"},
+	{17, 2580, "tributary"},
+	{17, 2581, "argis-te"},
+	{17, 2582, "argis-ds"},
+	{17, 2583, "mon"},
+	{17, 2584, "cyaserv"},
+	{17, 2585, "netx-server"},
+	{17, 2586, "netx-agent"},
+	{17, 2587, "masc"},
+	{17, 2588, "privilege"},
+	{17, 2589, "quartus-tcl"},
+	{17, 2590, "idotdist"},
+	{17, 2591, "maytagshuffle"},
+	{17, 2592, "netrek"},
+	{17, 2593, "mns-mail"},
+	{17, 2594, "dts"},
+	{17, 2595, "worldfusion1"},
+	{17, 2596, "worldfusion2"},
+	{17, 2597, "homesteadglory"},
+	{17, 2598, "citriximaclient"},
+	{17, 2599, "snapd"},
+	{17, 2600, "hpstgmgr"},
+	{17, 2601, "discp-client"},
+	{17, 2602, "discp-server"},
+	{17, 2603, "servicemeter"},
+	{17, 2604, "nsc-ccs"},
+	{17, 2605, "nsc-posa"},
+	{17, 2606, "netmon"},
+	{17, 2607, "connection"},
+	{17, 2608, "wag-service"},
+	{17, 2609, "system-monitor"},
+	{17, 2610, "versa-tek"},
+	{17, 2611, "lionhead"},
+	{17, 2612, "qpasa-agent"},
+	{17, 2613, "smntubootstrap"},
+	{17, 2614, "neveroffline"},
+	{17, 2615, "firepower"},
+	{17, 2616, "appswitch-emp"},
+	{17, 2617, "cmadmin"},
+	{17, 2618, "priority-e-com"},
+	{17, 2619, "bruce"},
+	{17, 2620, "lpsrecommender"},
+	{17, 2621, "miles-apart"},
+	{17, 2622, "metricadbc"},
+	{17, 2623, "lmdp"},
+	{17, 2624, "aria"},
+	{17, 2625, "blwnkl-port"},
+	{17, 2626, "gbjd816"},
+	{17, 2627, "moshebeeri"},
+	{17, 2628, "dict"},
+	{17, 2629, "sitaraserver"},
+	{17, 2630, "sitaramgmt"},
+	{17, 2631, "sitaradir"},
+	{17, 2632, "irdg-post"},
+	{17, 2633, "interintelli"},
+	{17, 2634, "pk-electronics"},
+	{17, 2635, "backburner"},
+	{17, 2636, "solve"},
+	{17, 2637, "imdocsvc"},
+	{17, 2638, "sybaseanywhere"},
+	{17, 2639, "aminet"},
+	{17, 2640, "ami-control"},
+	{17, 2641, "hdl-srv"},
+	{17, 2642, "tragic"},
+	{17, 2643, "gte-samp"},
+	{17, 2644, "travsoft-ipx-t"},
+	{17, 2645, "novell-ipx-cmd"},
+	{17, 2646, "and-lm"},
+	{17, 2647, "syncserver"},
+	{17, 2648, "upsnotifyprot"},
+	{17, 2649, "vpsipport"},
+	{17, 2650, "eristwoguns"},
+	{17, 2651, "ebinsite"},
+	{17, 2652, "interpathpanel"},
+	{17, 2653, "sonus"},
+	{17, 2654, "corel-vncadmin"},
+	{17, 2655, "unglue"},
+	{17, 2656, "kana"},
+	{17, 2657, "sns-dispatcher"},
+	{17, 2658, "sns-admin"},
+	{17, 2659, "sns-query"},
+	{17, 2660, "gcmonitor"},
+	{17, 2661, "olhost"},
+	{17, 2662, "bintec-capi"},
+	{17, 2663, "bintec-tapi"},
+	{17, 2664, "patrol-mq-gm"},
+	{17, 2665, "patrol-mq-nm"},
+	{17, 2666, "extensis"},
+	{17, 2667, "alarm-clock-s"},
+	{17, 2668, "alarm-clock-c"},
+	{17, 2669, "toad"},
+	{17, 2670, "tve-announce"},
+	{17, 2671, "newlixreg"},
+	{17, 2672, "nhserver"},
+	{17, 2673, "firstcall42"},
+	{17, 2674, "ewnn"},
+	{17, 2675, "ttc-etap"},
+	{17, 2676, "simslink"},
+	{17, 2677, "gadgetgate1way"},
+	{17, 2678, "gadgetgate2way"},
+	{17, 2679, "syncserverssl"},
+	{17, 2680, "pxc-sapxom"},
+	{17, 2681, "mpnjsomb"},
+	{17, 2683, "ncdloadbalance"},
+	{17, 2684, "mpnjsosv"},
+	{17, 2685, "mpnjsocl"},
+	{17, 2686, "mpnjsomg"},
+	{17, 2687, "pq-lic-mgmt"},
+	{17, 2688, "md-cg-http"},
+	{17, 2689, "fastlynx"},
+	{17, 2690, "hp-nnm-data"},
+	{17, 2691, "itinternet"},
+	{17, 2692, "admins-lms"},
+	{17, 2694, "pwrsevent"},
+	{17, 2695, "vspread"},
+	{17, 2696, "unifyadmin"},
+	{17, 2697, "oce-snmp-trap"},
+	{17, 2698, "mck-ivpip"},
+	{17, 2699, "csoft-plusclnt"},
+	{17, 2700, "tqdata"},
+	{17, 2701, "sms-rcinfo"},
+	{17, 2702, "sms-xfer"},
+	{17, 2703, "sms-chat"},
+	{17, 2704, "sms-remctrl"},
+	{17, 2705, "sds-admin"},
+	{17, 2706, "ncdmirroring"},
+	{17, 2707, "emcsymapiport"},
+	{17, 2708, "banyan-net"},
+	{17, 2709, "supermon"},
+	{17, 2710, "sso-service"},
+	{17, 2711, "sso-control"},
+	{17, 2712, "aocp"},
+	{17, 2713, "raventbs"},
+	{17, 2714, "raventdm"},
+	{17, 2715, "hpstgmgr2"},
+	{17, 2716, "inova-ip-disco"},
+	{17, 2717, "pn-requester"},
+	{17, 2718, "pn-requester2"},
+	{17, 2719, "scan-change"},
+	{17, 2720, "wkars"},
+	{17, 2721, "smart-diagnose"},
+	{17, 2722, "proactivesrvr"},
+	{17, 2723, "watchdog-nt"},
+	{17, 2724, "qotps"},
+	{17, 2725, "msolap-ptp2"},
+	{17, 2726, "tams"},
+	{17, 2727, "mgcp-callagent"},
+	{17, 2728, "sqdr"},
+	{17, 2729, "tcim-control"},
+	{17, 2730, "nec-raidplus"},
+	{17, 2731, "fyre-messanger"},
+	{17, 2732, "g5m"},
+	{17, 2733, "signet-ctf"},
+	{17, 2734, "ccs-software"},
+	{17, 2735, "netiq-mc"},
+	{17, 2736, "radwiz-nms-srv"},
+	{17, 2737, "srp-feedback"},
+	{17, 2738, "ndl-tcp-ois-gw"},
+	{17, 2739, "tn-timing"},
+	{17, 2740, "alarm"},
+	{17, 2741, "tsb"},
+	{17, 2742, "tsb2"},
+	{17, 2743, "murx"},
+	{17, 2744, "honyaku"},
+	{17, 2745, "urbisnet"},
+	{17, 2746, "cpudpencap"},
+	{17, 2747, "fjippol-swrly"},
+	{17, 2748, "fjippol-polsvr"},
+	{17, 2749, "fjippol-cnsl"},
+	{17, 2750, "fjippol-port1"},
+	{17, 2751, "fjippol-port2"},
+	{17, 2752, "rsisysaccess"},
+	{17, 2753, "de-spot"},
+	{17, 2754, "apollo-cc"},
+	{17, 2755, "expresspay"},
+	{17, 2756, "simplement-tie"},
+	{17, 2757, "cnrp"},
+	{17, 2758, "apollo-status"},
+	{17, 2759, "apollo-gms"},
+	{17, 2760, "sabams"},
+	{17, 2761, "dicom-iscl"},
+	{17, 2762, "dicom-tls"},
+	{17, 2763, "desktop-dna"},
+	{17, 2764, "data-insurance"},
+	{17, 2765, "qip-audup"},
+	{17, 2766, "compaq-scp"},
+	{17, 2767, "uadtc"},
+	{17, 2768, "uacs"},
+	{17, 2769, "exce"},
+	{17, 2770, "veronica"},
+	{17, 2771, "vergencecm"},
+	{17, 2772, "auris"},
+	{17, 2773, "rbakcup1"},
+	{17, 2774, "rbakcup2"},
+	{17, 2775, "smpp"},
+	{17, 2776, "ridgeway1"},
+	{17, 2777, "ridgeway2"},
+	{17, 2778, "gwen-sonya"},
+	{17, 2779, "lbc-sync"},
+	{17, 2780, "lbc-control"},
+	{17, 2781, "whosells"},
+	{17, 2782, "everydayrc"},
+	{17, 2783, "aises"},
+	{17, 2784, "www-dev"},
+	{17, 2785, "aic-np"},
+	{17, 2786, "aic-oncrpc"},
+	{17, 2787, "piccolo"},
+	{17, 2788, "fryeserv"},
+	{17, 2789, "media-agent"},
+	{17, 2790, "plgproxy"},
+	{17, 2791, "mtport-regist"},
+	{17, 2792, "f5-globalsite"},
+	{17, 2793, "initlsmsad"},
+	{17, 2795, "livestats"},
+	{17, 2796, "ac-tech"},
+	{17, 2797, "esp-encap"},
+	{17, 2798, "tmesis-upshot"},
+	{17, 2799, "icon-discover"},
+	{17, 2800, "acc-raid"},
+	{17, 2801, "igcp"},
+	{17, 2802, "veritas-udp1"},
+	{17, 2803, "btprjctrl"},
+	{17, 2804, "dvr-esm"},
+	{17, 2805, "wta-wsp-s"},
+	{17, 2806, "cspuni"},
+	{17, 2807, "cspmulti"},
+	{17, 2808, "j-lan-p"},
+	{17, 2809, "corbaloc"},
+	{17, 2810, "netsteward"},
+	{17, 2811, "gsiftp"},
+	{17, 2812, "atmtcp"},
+	{17, 2813, "llm-pass"},
+	{17, 2814, "llm-csv"},
+	{17, 2815, "lbc-measure"},
+	{17, 2816, "lbc-watchdog"},
+	{17, 2817, "nmsigport"},
+	{17, 2818, "rmlnk"},
+	{17, 2819, "fc-faultnotify"},
+	{17, 2820, "univision"},
+	{17, 2821, "vrts-at-port"},
+	{17, 2822, "ka0wuc"},
+	{17, 2823, "cqg-netlan"},
+	{17, 2824, "cqg-netlan-1"},
+	{17, 2826, "slc-systemlog"},
+	{17, 2827, "slc-ctrlrloops"},
+	{17, 2828, "itm-lm"},
+	{17, 2829, "silkp1"},
+	{17, 2830, "silkp2"},
+	{17, 2831, "silkp3"},
+	{17, 2832, "silkp4"},
+	{17, 2833, "glishd"},
+	{17, 2834, "evtp"},
+	{17, 2835, "evtp-data"},
+	{17, 2836, "catalyst"},
+	{17, 2837, "repliweb"},
+	{17, 2838, "starbot"},
+	{17, 2839, "nmsigport"},
+	{17, 2840, "l3-exprt"},
+	{17, 2841, "l3-ranger"},
+	{17, 2842, "l3-hawk"},
+	{17, 2843, "pdnet"},
+	{17, 2844, "bpcp-poll"},
+	{17, 2845, "bpcp-trap"},
+	{17, 2846, "aimpp-hello"},
+	{17, 2847, "aimpp-port-req"},
+	{17, 2848, "amt-blc-port"},
+	{17, 2849, "fxp"},
+	{17, 2850, "metaconsole"},
+	{17, 2851, "webemshttp"},
+	{17, 2852, "bears-01"},
+	{17, 2853, "ispipes"},
+	{17, 2854, "infomover"},
+	{17, 2856, "cesdinv"},
+	{17, 2857, "simctlp"},
+	{17, 2858, "ecnp"},
+	{17, 2859, "activememory"},
+	{17, 2860, "dialpad-voice1"},
+	{17, 2861, "dialpad-voice2"},
+	{17, 2862, "ttg-protocol"},
+	{17, 2863, "sonardata"},
+	{17, 2864, "astronova-main"},
+	{17, 2865, "pit-vpn"},
+	{17, 2866, "iwlistener"},
+	{17, 2867, "esps-portal"},
+	{17, 2868, "npep-messaging"},
+	{17, 2869, "icslap"},
+	{17, 2870, "daishi"},
+	{17, 2871, "msi-selectplay"},
+	{17, 2872, "radix"},
+	{17, 2873, "psrt"},
+	{17, 2874, "dxmessagebase1"},
+	{17, 2875, "dxmessagebase2"},
+	{17, 2876, "sps-tunnel"},
+	{17, 2877, "bluelance"},
+	{17, 2878, "aap"},
+	{17, 2879, "ucentric-ds"},
+	{17, 2880, "synapse"},
+	{17, 2881, "ndsp"},
+	{17, 2882, "ndtp"},
+	{17, 2883, "ndnp"},
+	{17, 2884, "flashmsg"},
+	{17, 2885, "topflow"},
+	{17, 2886, "responselogic"},
+	{17, 2887, "aironetddp"},
+	{17, 2888, "spcsdlobby"},
+	{17, 2889, "rsom"},
+	{17, 2890, "cspclmulti"},
+	{17, 2891, "cinegrfx-elmd"},
+	{17, 2892, "snifferdata"},
+	{17, 2893, "vseconnector"},
+	{17, 2894, "abacus-remote"},
+	{17, 2895, "natuslink"},
+	{17, 2896, "ecovisiong6-1"},
+	{17, 2897, "citrix-rtmp"},
+	{17, 2898, "appliance-cfg"},
+	{17, 2899, "powergemplus"},
+	{17, 2900, "quicksuite"},
+	{17, 2901, "allstorcns"},
+	{17, 2902, "netaspi"},
+	{17, 2903, "suitcase"},
+	{17, 2904, "m2ua"},
+	{17, 2906, "caller9"},
+	{17, 2907, "webmethods-b2b"},
+	{17, 2908, "mao"},
+	{17, 2909, "funk-dialout"},
+	{17, 2910, "tdaccess"},
+	{17, 2911, "blockade"},
+	{17, 2912, "epicon"},
+	{17, 2913, "boosterware"},
+	{17, 2914, "gamelobby"},
+	{17, 2915, "tksocket"},
+	{17, 2916, "elvin-server"},
+	{17, 2917, "elvin-client"},
+	{17, 2918, "kastenchasepad"},
+	{17, 2919, "roboer"},
+	{17, 2920, "roboeda"},
+	{17, 2921, "cesdcdman"},
+	{17, 2922, "cesdcdtrn"},
+	{17, 2923, "wta-wsp-wtp-s"},
+	{17, 2924, "precise-vip"},
+	{17, 2926, "mobile-file-dl"},
+	{17, 2927, "unimobilectrl"},
+	{17, 2928, "redstone-cpss"},
+	{17, 2929, "amx-webadmin"},
+	{17, 2930, "amx-weblinx"},
+	{17, 2931, "circle-x"},
+	{17, 2932, "incp"},
+	{17, 2933, "4-tieropmgw"},
+	{17, 2934, "4-tieropmcli"},
+	{17, 2935, "qtp"},
+	{17, 2936, "otpatch"},
+	{17, 2937, "pnaconsult-lm"},
+	{17, 2938, "sm-pas-1"},
+	{17, 2939, "sm-pas-2"},
+	{17, 2940, "sm-pas-3"},
+	{17, 2941, "sm-pas-4"},
+	{17, 2942, "sm-pas-5"},
+	{17, 2943, "ttnrepository"},
+	{17, 2944, "megaco-h248"},
+	{17, 2945, "h248-binary"},
+	{17, 2946, "fjsvmpor"},
+	{17, 2947, "gpsd"},
+	{17, 2948, "wap-push"},
+	{17, 2949, "wap-pushsecure"},
+	{17, 2950, "esip"},
+	{17, 2951, "ottp"},
+	{17, 2952, "mpfwsas"},
+	{17, 2953, "ovalarmsrv"},
+	{17, 2954, "ovalarmsrv-cmd"},
+	{17, 2955, "csnotify"},
+	{17, 2956, "ovrimosdbman"},
+	{17, 2957, "jmact5"},
+	{17, 2958, "jmact6"},
+	{17, 2959, "rmopagt"},
+	{17, 2960, "dfoxserver"},
+	{17, 2961, "boldsoft-lm"},
+	{17, 2962, "iph-policy-cli"},
+	{17, 2963, "iph-policy-adm"},
+	{17, 2964, "bullant-srap"},
+	{17, 2965, "bullant-rap"},
+	{17, 2966, "idp-infotrieve"},
+	{17, 2967, "ssc-agent"},
+	{17, 2968, "enpp"},
+	{17, 2969, "essp"},
+	{17, 2970, "index-net"},
+	{17, 2971, "netclip"},
+	{17, 2972, "pmsm-webrctl"},
+	{17, 2973, "svnetworks"},
+	{17, 2974, "signal"},
+	{17, 2975, "fjmpcm"},
+	{17, 2976, "cns-srv-port"},
+	{17, 2977, "ttc-etap-ns"},
+	{17, 2978, "ttc-etap-ds"},
+	{17, 2979, "h263-video"},
+	{17, 2980, "wimd"},
+	{17, 2981, "mylxamport"},
+	{17, 2982, "iwb-whiteboard"},
+	{17, 2983, "netplan"},
+	{17, 2984, "hpidsadmin"},
+	{17, 2985, "hpidsagent"},
+	{17, 2986, "stonefalls"},
+	{17, 2987, "identify"},
+	{17, 2988, "hippad"},
+	{17, 2989, "zarkov"},
+	{17, 2990, "boscap"},
+	{17, 2991, "wkstn-mon"},
+	{17, 2992, "avenyo"},
+	{17, 2993, "veritas-vis1"},
+	{17, 2994, "veritas-vis2"},
+	{17, 2995, "idrs"},
+	{17, 2996, "vsixml"},
+	{17, 2997, "rebol"},
+	{17, 2998, "realsecure"},
+	{17, 2999, "remoteware-un"},
+	{17, 3000, "hbci"},
+	{17, 3002, "exlm-agent"},
+	{17, 3003, "cgms"},
+	{17, 3004, "csoftragent"},
+	{17, 3005, "geniuslm"},
+	{17, 3006, "ii-admin"},
+	{17, 3007, "lotusmtap"},
+	{17, 3008, "midnight-tech"},
+	{17, 3009, "pxc-ntfy"},
+	{17, 3010, "ping-pong"},
+	{17, 3011, "trusted-web"},
+	{17, 3012, "twsdss"},
+	{17, 3013, "gilatskysurfer"},
+	{17, 3014, "broker-service"},
+	{17, 3015, "nati-dstp"},
+	{17, 3016, "notify-srvr"},
+	{17, 3017, "event-listener"},
+	{17, 3018, "srvc-registry"},
+	{17, 3019, "resource-mgr"},
+	{17, 3020, "cifs"},
+	{17, 3021, "agriserver"},
+	{17, 3022, "csregagent"},
+	{17, 3023, "magicnotes"},
+	{17, 3024, "nds-sso"},
+	{17, 3025, "arepa-raft"},
+	{17, 3026, "agri-gateway"},
+	{17, 3027, "LiebDevMgmt-C"},
+	{17, 3028, "LiebDevMgmt-DM"},
+	{17, 3029, "LiebDevMgmt-A"},
+	{17, 3030, "arepa-cas"},
+	{17, 3031, "eppc"},
+	{17, 3032, "redwood-chat"},
+	{17, 3033, "pdb"},
+	{17, 3034, "osmosis-aeea"},
+	{17, 3035, "fjsv-gssagt"},
+	{17, 3036, "hagel-dump"},
+	{17, 3037, "hp-san-mgmt"},
+	{17, 3038, "santak-ups"},
+	{17, 3039, "cogitate"},
+	{17, 3040, "tomato-springs"},
+	{17, 3041, "di-traceware"},
+	{17, 3042, "journee"},
+	{17, 3043, "brp"},
+	{17, 3044, "epp"},
+	{17, 3045, "responsenet"},
+	{17, 3046, "di-ase"},
+	{17, 3047, "hlserver"},
+	{17, 3048, "pctrader"},
+	{17, 3049, "nsws"},
+	{17, 3050, "gds-db"},
+	{17, 3051, "galaxy-server"},
+	{17, 3052, "apc-3052"},
+	{17, 3053, "dsom-server"},
+	{17, 3054, "amt-cnf-prot"},
+	{17, 3055, "policyserver"},
+	{17, 3056, "cdl-server"},
+	{17, 3057, "goahead-fldup"},
+	{17, 3058, "videobeans"},
+	{17, 3059, "qsoft"},
+	{17, 3060, "interserver"},
+	{17, 3061, "cautcpd"},
+	{17, 3062, "ncacn-ip-tcp"},
+	{17, 3063, "ncadg-ip-udp"},
+	{17, 3064, "rprt"},
+	{17, 3065, "slinterbase"},
+	{17, 3066, "netattachsdmp"},
+	{17, 3067, "fjhpjp"},
+	{17, 3068, "ls3bcast"},
+	{17, 3069, "ls3"},
+	{17, 3070, "mgxswitch"},
+	{17, 3072, "csd-monitor"},
+	{17, 3073, "vcrp"},
+	{17, 3074, "xbox"},
+	{17, 3075, "orbix-locator"},
+	{17, 3076, "orbix-config"},
+	{17, 3077, "orbix-loc-ssl"},
+	{17, 3078, "orbix-cfg-ssl"},
+	{17, 3079, "lv-frontpanel"},
+	{17, 3080, "stm-pproc"},
+	{17, 3081, "tl1-lv"},
+	{17, 3082, "tl1-raw"},
+	{17, 3083, "tl1-telnet"},
+	{17, 3084, "itm-mccs"},
+	{17, 3085, "pcihreq"},
+	{17, 3086, "jdl-dbkitchen"},
+	{17, 3087, "asoki-sma"},
+	{17, 3088, "xdtp"},
+	{17, 3089, "ptk-alink"},
+	{17, 3090, "stss"},
+	{17, 3091, "1ci-smcs"},
+	{17, 3093, "rapidmq-center"},
+	{17, 3094, "rapidmq-reg"},
+	{17, 3095, "panasas"},
+	{17, 3096, "ndl-aps"},
+	{17, 3098, "umm-port"},
+	{17, 3099, "chmd"},
+	{17, 3100, "opcon-xps"},
+	{17, 3101, "hp-pxpib"},
+	{17, 3102, "slslavemon"},
+	{17, 3103, "autocuesmi"},
+	{17, 3104, "autocuetime"},
+	{17, 3105, "cardbox"},
+	{17, 3106, "cardbox-http"},
+	{17, 3107, "business"},
+	{17, 3108, "geolocate"},
+	{17, 3109, "personnel"},
+	{17, 3110, "sim-control"},
+	{17, 3111, "wsynch"},
+	{17, 3112, "ksysguard"},
+	{17, 3113, "cs-auth-svr"},
+	{17, 3114, "ccmad"},
+	{17, 3115, "mctet-master"},
+	{17, 3116, "mctet-gateway"},
+	{17, 3117, "mctet-jserv"},
+	{17, 3118, "pkagent"},
+	{17, 3119, "d2000kernel"},
+	{17, 3120, "d2000webserver"},
+	{17, 3122, "vtr-emulator"},
+	{17, 3123, "edix"},
+	{17, 3124, "beacon-port"},
+	{17, 3125, "a13-an"},
+	{17, 3127, "ctx-bridge"},
+	{17, 3128, "ndl-aas"},
+	{17, 3129, "netport-id"},
+	{17, 3130, "icpv2"},
+	{17, 3131, "netbookmark"},
+	{17, 3132, "ms-rule-engine"},
+	{17, 3133, "prism-deploy"},
+	{17, 3134, "ecp"},
+	{17, 3135, "peerbook-port"},
+	{17, 3136, "grubd"},
+	{17, 3137, "rtnt-1"},
+	{17, 3138, "rtnt-2"},
+	{17, 3139, "incognitorv"},
+	{17, 3140, "ariliamulti"},
+	{17, 3141, "vmodem"},
+	{17, 3142, "rdc-wh-eos"},
+	{17, 3143, "seaview"},
+	{17, 3144, "tarantella"},
+	{17, 3145, "csi-lfap"},
+	{17, 3146, "bears-02"},
+	{17, 3147, "rfio"},
+	{17, 3148, "nm-game-admin"},
+	{17, 3149, "nm-game-server"},
+	{17, 3150, "nm-asses-admin"},
+	{17, 3151, "nm-assessor"},
+	{17, 3152, "feitianrockey"},
+	{17, 3153, "s8-client-port"},
+	{17, 3154, "ccmrmi"},
+	{17, 3155, "jpegmpeg"},
+	{17, 3156, "indura"},
+	{17, 3157, "e3consultants"},
+	{17, 3158, "stvp"},
+	{17, 3159, "navegaweb-port"},
+	{17, 3160, "tip-app-server"},
+	{17, 3161, "doc1lm"},
+	{17, 3162, "sflm"},
+	{17, 3163, "res-sap"},
+	{17, 3164, "imprs"},
+	{17, 3165, "newgenpay"},
+	{17, 3166, "sossecollector"},
+	{17, 3167, "nowcontact"},
+	{17, 3168, "poweronnud"},
+	{17, 3169, "serverview-as"},
+	{17, 3170, "serverview-asn"},
+	{17, 3171, "serverview-gf"},
+	{17, 3172, "serverview-rm"},
+	{17, 3173, "serverview-icc"},
+	{17, 3174, "armi-server"},
+	{17, 3175, "t1-e1-over-ip"},
+	{17, 3176, "ars-master"},
+	{17, 3177, "phonex-port"},
+	{17, 3178, "radclientport"},
+	{17, 3179, "h2gf-w-2m"},
+	{17, 3180, "mc-brk-srv"},
+	{17, 3181, "bmcpatrolagent"},
+	{17, 3182, "bmcpatrolrnvu"},
+	{17, 3183, "cops-tls"},
+	{17, 3184, "apogeex-port"},
+	{17, 3185, "smpppd"},
+	{17, 3186, "iiw-port"},
+	{17, 3187, "odi-port"},
+	{17, 3188, "brcm-comm-port"},
+	{17, 3189, "pcle-infex"},
+	{17, 3190, "csvr-proxy"},
+	{17, 3191, "csvr-sslproxy"},
+	{17, 3192, "firemonrcc"},
+	{17, 3193, "spandataport"},
+	{17, 3194, "magbind"},
+	{17, 3195, "ncu-1"},
+	{17, 3196, "ncu-2"},
+	{17, 3197, "embrace-dp-s"},
+	{17, 3198, "embrace-dp-c"},
+	{17, 3199, "dmod-workspace"},
+	{17, 3200, "tick-port"},
+	{17, 3201, "cpq-tasksmart"},
+	{17, 3202, "intraintra"},
+	{17, 3203, "netwatcher-mon"},
+	{17, 3204, "netwatcher-db"},
+	{17, 3205, "isns"},
+	{17, 3206, "ironmail"},
+	{17, 3207, "vx-auth-port"},
+	{17, 3208, "pfu-prcallback"},
+	{17, 3209, "netwkpathengine"},
+	{17, 3210, "flamenco-proxy"},
+	{17, 3211, "avsecuremgmt"},
+	{17, 3212, "surveyinst"},
+	{17, 3213, "neon24x7"},
+	{17, 3214, "jmq-daemon-1"},
+	{17, 3215, "jmq-daemon-2"},
+	{17, 3216, "ferrari-foam"},
+	{17, 3217, "unite"},
+	{17, 3218, "smartpackets"},
+	{17, 3219, "wms-messenger"},
+	{17, 3220, "xnm-ssl"},
+	{17, 3221, "xnm-clear-text"},
+	{17, 3222, "glbp"},
+	{17, 3223, "digivote"},
+	{17, 3224, "aes-discovery"},
+	{17, 3225, "fcip-port"},
+	{17, 3226, "isi-irp"},
+	{17, 3227, "dwnmshttp"},
+	{17, 3228, "dwmsgserver"},
+	{17, 3229, "global-cd-port"},
+	{17, 3230, "sftdst-port"},
+	{17, 3231, "vidigo"},
+	{17, 3232, "mdtp"},
+	{17, 3233, "whisker"},
+	{17, 3234, "alchemy"},
+	{17, 3235, "mdap-port"},
+	{17, 3236, "apparenet-ts"},
+	{17, 3237, "apparenet-tps"},
+	{17, 3238, "apparenet-as"},
+	{17, 3239, "apparenet-ui"},
+	{17, 3240, "triomotion"},
+	{17, 3241, "sysorb"},
+	{17, 3242, "sdp-id-port"},
+	{17, 3243, "timelot"},
+	{17, 3244, "onesaf"},
+	{17, 3245, "vieo-fe"},
+	{17, 3246, "dvt-system"},
+	{17, 3247, "dvt-data"},
+	{17, 3248, "procos-lm"},
+	{17, 3249, "ssp"},
+	{17, 3250, "hicp"},
+	{17, 3251, "sysscanner"},
+	{17, 3252, "dhe"},
+	{17, 3253, "pda-data"},
+	{17, 3254, "pda-sys"},
+	{17, 3255, "semaphore"},
+	{17, 3256, "cpqrpm-agent"},
+	{17, 3257, "cpqrpm-server"},
+	{17, 3258, "ivecon-port"},
+	{17, 3259, "epncdp2"},
+	{17, 3260, "iscsi-target"},
+	{17, 3261, "winshadow"},
+	{17, 3262, "necp"},
+	{17, 3263, "ecolor-imager"},
+	{17, 3264, "ccmail"},
+	{17, 3265, "altav-tunnel"},
+	{17, 3266, "ns-cfg-server"},
+	{17, 3267, "ibm-dial-out"},
+	{17, 3268, "msft-gc"},
+	{17, 3269, "msft-gc-ssl"},
+	{17, 3270, "verismart"},
+	{17, 3271, "csoft-prev"},
+	{17, 3272, "user-manager"},
+	{17, 3273, "sxmp"},
+	{17, 3274, "ordinox-server"},
+	{17, 3275, "samd"},
+	{17, 3276, "maxim-asics"},
+	{17, 3277, "awg-proxy"},
+	{17, 3278, "lkcmserver"},
+	{17, 3279, "admind"},
+	{17, 3280, "vs-server"},
+	{17, 3281, "sysopt"},
+	{17, 3282, "datusorb"},
+	{17, 3283, "Apple Remote Desktop (Net Assistant)"},
+	{17, 3284, "4talk"},
+	{17, 3285, "plato"},
+	{17, 3286, "e-net"},
+	{17, 3287, "directvdata"},
+	{17, 3288, "cops"},
+	{17, 3289, "enpc"},
+	{17, 3290, "caps-lm"},
+	{17, 3291, "sah-lm"},
+	{17, 3292, "cart-o-rama"},
+	{17, 3293, "fg-fps"},
+	{17, 3294, "fg-gip"},
+	{17, 3295, "dyniplookup"},
+	{17, 3296, "rib-slm"},
+	{17, 3297, "cytel-lm"},
+	{17, 3298, "deskview"},
+	{17, 3299, "pdrncs"},
+	{17, 3301, "tarantool"},
+	{17, 3302, "mcs-fastmail"},
+	{17, 3303, "opsession-clnt"},
+	{17, 3304, "opsession-srvr"},
+	{17, 3305, "odette-ftp"},
+	{17, 3306, "mysql"},
+	{17, 3307, "opsession-prxy"},
+	{17, 3308, "tns-server"},
+	{17, 3309, "tns-adv"},
+	{17, 3310, "dyna-access"},
+	{17, 3311, "mcns-tel-ret"},
+	{17, 3312, "appman-server"},
+	{17, 3313, "uorb"},
+	{17, 3314, "uohost"},
+	{17, 3315, "cdid"},
+	{17, 3316, "aicc-cmi"},
+	{17, 3317, "vsaiport"},
+	{17, 3318, "ssrip"},
+	{17, 3319, "sdt-lmd"},
+	{17, 3320, "officelink2000"},
+	{17, 3321, "vnsstr"},
+	{17, 3326, "sftu"},
+	{17, 3327, "bbars"},
+	{17, 3328, "egptlm"},
+	{17, 3329, "hp-device-disc"},
+	{17, 3330, "mcs-calypsoicf"},
+	{17, 3331, "mcs-messaging"},
+	{17, 3332, "mcs-mailsvr"},
+	{17, 3333, "dec-notes"},
+	{17, 3334, "directv-web"},
+	{17, 3335, "directv-soft"},
+	{17, 3336, "directv-tick"},
+	{17, 3337, "directv-catlg"},
+	{17, 3338, "anet-b"},
+	{17, 3339, "anet-l"},
+	{17, 3340, "anet-m"},
+	{17, 3341, "anet-h"},
+	{17, 3342, "webtie"},
+	{17, 3343, "ms-cluster-net"},
+	{17, 3344, "bnt-manager"},
+	{17, 3345, "influence"},
+	{17, 3346, "trnsprntproxy"},
+	{17, 3347, "phoenix-rpc"},
+	{17, 3348, "pangolin-laser"},
+	{17, 3349, "chevinservices"},
+	{17, 3350, "findviatv"},
+	{17, 3351, "btrieve"},
+	{17, 3352, "ssql"},
+	{17, 3353, "fatpipe"},
+	{17, 3354, "suitjd"},
+	{17, 3355, "ordinox-dbase"},
+	{17, 3356, "upnotifyps"},
+	{17, 3357, "adtech-test"},
+	{17, 3358, "mpsysrmsvr"},
+	{17, 3359, "wg-netforce"},
+	{17, 3360, "kv-server"},
+	{17, 3361, "kv-agent"},
+	{17, 3362, "dj-ilm"},
+	{17, 3363, "nati-vi-server"},
+	{17, 3364, "creativeserver"},
+	{17, 3365, "contentserver"},
+	{17, 3366, "creativepartnr"},
+	{17, 3372, "tip2"},
+	{17, 3373, "lavenir-lm"},
+	{17, 3374, "cluster-disc"},
+	{17, 3375, "vsnm-agent"},
+	{17, 3376, "cdbroker"},
+	{17, 3377, "cogsys-lm"},
+	{17, 3378, "wsicopy"},
+	{17, 3379, "socorfs"},
+	{17, 3380, "sns-channels"},
+	{17, 3381, "geneous"},
+	{17, 3382, "fujitsu-neat"},
+	{17, 3383, "esp-lm"},
+	{17, 3384, "hp-clic"},
+	{17, 3385, "qnxnetman"},
+	{17, 3386, "gprs-sig"},
+	{17, 3387, "backroomnet"},
+	{17, 3388, "cbserver"},
+	{17, 3389, "ms-wbt-server"},
+	{17, 3390, "dsc"},
+	{17, 3391, "savant"},
+	{17, 3392, "efi-lm"},
+	{17, 3393, "d2k-tapestry1"},
+	{17, 3394, "d2k-tapestry2"},
+	{17, 3395, "dyna-lm"},
+	{17, 3396, "printer-agent"},
+	{17, 3397, "cloanto-lm"},
+	{17, 3398, "mercantile"},
+	{17, 3399, "csms"},
+	{17, 3400, "csms2"},
+	{17, 3401, "filecast"},
+	{17, 3402, "fxaengine-net"},
+	{17, 3405, "nokia-ann-ch1"},
+	{17, 3406, "nokia-ann-ch2"},
+	{17, 3407, "ldap-admin"},
+	{17, 3408, "BESApi"},
+	{17, 3409, "networklens"},
+	{17, 3410, "networklenss"},
+	{17, 3411, "biolink-auth"},
+	{17, 3412, "xmlblaster"},
+	{17, 3413, "svnet"},
+	{17, 3414, "wip-port"},
+	{17, 3415, "bcinameservice"},
+	{17, 3416, "commandport"},
+	{17, 3417, "csvr"},
+	{17, 3418, "rnmap"},
+	{17, 3419, "softaudit"},
+	{17, 3420, "ifcp-port"},
+	{17, 3421, "bmap"},
+	{17, 3422, "rusb-sys-port"},
+	{17, 3423, "xtrm"},
+	{17, 3424, "xtrms"},
+	{17, 3425, "agps-port"},
+	{17, 3426, "arkivio"},
+	{17, 3427, "websphere-snmp"},
+	{17, 3428, "twcss"},
+	{17, 3429, "gcsp"},
+	{17, 3430, "ssdispatch"},
+	{17, 3431, "ndl-als"},
+	{17, 3432, "osdcp"},
+	{17, 3433, "opnet-smp"},
+	{17, 3434, "opencm"},
+	{17, 3435, "pacom"},
+	{17, 3436, "gc-config"},
+	{17, 3437, "autocueds"},
+	{17, 3438, "spiral-admin"},
+	{17, 3439, "hri-port"},
+	{17, 3440, "ans-console"},
+	{17, 3441, "connect-client"},
+	{17, 3442, "connect-server"},
+	{17, 3443, "ov-nnm-websrv"},
+	{17, 3444, "denali-server"},
+	{17, 3445, "monp"},
+	{17, 3446, "3comfaxrpc"},
+	{17, 3447, "directnet"},
+	{17, 3448, "dnc-port"},
+	{17, 3449, "hotu-chat"},
+	{17, 3450, "castorproxy"},
+	{17, 3451, "asam"},
+	{17, 3452, "sabp-signal"},
+	{17, 3453, "pscupd"},
+	{17, 3454, "mira"},
+	{17, 3455, "prsvp"},
+	{17, 3456, "vat"},
+	{17, 3457, "vat-control"},
+	{17, 3458, "d3winosfi"},
+	{17, 3459, "integral"},
+	{17, 3460, "edm-manager"},
+	{17, 3461, "edm-stager"},
+	{17, 3462, "edm-std-notify"},
+	{17, 3463, "edm-adm-notify"},
+	{17, 3464, "edm-mgr-sync"},
+	{17, 3465, "edm-mgr-cntrl"},
+	{17, 3466, "workflow"},
+	{17, 3467, "rcst"},
+	{17, 3468, "ttcmremotectrl"},
+	{17, 3469, "pluribus"},
+	{17, 3470, "jt400"},
+	{17, 3471, "jt400-ssl"},
+	{17, 3472, "jaugsremotec-1"},
+	{17, 3473, "jaugsremotec-2"},
+	{17, 3474, "ttntspauto"},
+	{17, 3475, "genisar-port"},
+	{17, 3476, "nppmp"},
+	{17, 3477, "ecomm"},
+	{17, 3478, "stun"},
+	{17, 3479, "twrpc"},
+	{17, 3480, "plethora"},
+	{17, 3481, "cleanerliverc"},
+	{17, 3482, "vulture"},
+	{17, 3483, "slim-devices"},
+	{17, 3484, "gbs-stp"},
+	{17, 3485, "celatalk"},
+	{17, 3486, "ifsf-hb-port"},
+	{17, 3487, "ltcudp"},
+	{17, 3488, "fs-rh-srv"},
+	{17, 3489, "dtp-dia"},
+	{17, 3490, "colubris"},
+	{17, 3491, "swr-port"},
+	{17, 3492, "tvdumtray-port"},
+	{17, 3493, "nut"},
+	{17, 3494, "ibm3494"},
+	{17, 3495, "seclayer-tcp"},
+	{17, 3496, "seclayer-tls"},
+	{17, 3497, "ipether232port"},
+	{17, 3498, "dashpas-port"},
+	{17, 3499, "sccip-media"},
+	{17, 3500, "rtmp-port"},
+	{17, 3501, "isoft-p2p"},
+	{17, 3502, "avinstalldisc"},
+	{17, 3503, "lsp-ping"},
+	{17, 3504, "ironstorm"},
+	{17, 3505, "ccmcomm"},
+	{17, 3506, "apc-3506"},
+	{17, 3507, "nesh-broker"},
+	{17, 3508, "interactionweb"},
+	{17, 3509, "vt-ssl"},
+	{17, 3510, "xss-port"},
+	{17, 3511, "webmail-2"},
+	{17, 3512, "aztec"},
+	{17, 3513, "arcpd"},
+	{17, 3514, "must-p2p"},
+	{17, 3515, "must-backplane"},
+	{17, 3516, "smartcard-port"},
+	{17, 3517, "802-11-iapp"},
+	{17, 3518, "artifact-msg"},
+	{17, 3519, "galileo"},
+	{17, 3520, "galileolog"},
+	{17, 3521, "mc3ss"},
+	{17, 3522, "nssocketport"},
+	{17, 3523, "odeumservlink"},
+	{17, 3524, "ecmport"},
+	{17, 3525, "eisport"},
+	{17, 3526, "starquiz-port"},
+	{17, 3527, "beserver-msg-q"},
+	{17, 3528, "jboss-iiop"},
+	{17, 3529, "jboss-iiop-ssl"},
+	{17, 3530, "gf"},
+	{17, 3531, "joltid"},
+	{17, 3532, "raven-rmp"},
+	{17, 3533, "raven-rdp"},
+	{17, 3534, "urld-port"},
+	{17, 3535, "ms-la"},
+	{17, 3536, "snac"},
+	{17, 3537, "ni-visa-remote"},
+	{17, 3538, "ibm-diradm"},
+	{17, 3539, "ibm-diradm-ssl"},
+	{17, 3540, "pnrp-port"},
+	{17, 3541, "voispeed-port"},
+	{17, 3542, "hacl-monitor"},
+	{17, 3543, "qftest-lookup"},
+	{17, 3544, "teredo"},
+	{17, 3545, "camac"},
+	{17, 3547, "symantec-sim"},
+	{17, 3548, "interworld"},
+	{17, 3549, "tellumat-nms"},
+	{17, 3550, "ssmpp"},
+	{17, 3551, "apcupsd"},
+	{17, 3552, "taserver"},
+	{17, 3553, "rbr-discovery"},
+	{17, 3554, "questnotify"},
+	{17, 3555, "razor"},
+	{17, 3556, "sky-transport"},
+	{17, 3557, "personalos-001"},
+	{17, 3558, "mcp-port"},
+	{17, 3559, "cctv-port"},
+	{17, 3560, "iniserve-port"},
+	{17, 3561, "bmc-onekey"},
+	{17, 3562, "sdbproxy"},
+	{17, 3563, "watcomdebug"},
+	{17, 3564, "esimport"},
+	{17, 3567, "dof-eps"},
+	{17, 3568, "dof-tunnel-sec"},
+	{17, 3569, "mbg-ctrl"},
+	{17, 3570, "mccwebsvr-port"},
+	{17, 3571, "megardsvr-port"},
+	{17, 3572, "megaregsvrport"},
+	{17, 3573, "tag-ups-1"},
+	{17, 3574, "dmaf-caster"},
+	{17, 3575, "ccm-port"},
+	{17, 3576, "cmc-port"},
+	{17, 3577, "config-port"},
+	{17, 3578, "data-port"},
+	{17, 3579, "ttat3lb"},
+	{17, 3580, "nati-svrloc"},
+	{17, 3581, "kfxaclicensing"},
+	{17, 3582, "press"},
+	{17, 3583, "canex-watch"},
+	{17, 3584, "u-dbap"},
+	{17, 3585, "emprise-lls"},
+	{17, 3586, "emprise-lsc"},
+	{17, 3587, "p2pgroup"},
+	{17, 3588, "sentinel"},
+	{17, 3589, "isomair"},
+	{17, 3590, "wv-csp-sms"},
+	{17, 3591, "gtrack-server"},
+	{17, 3592, "gtrack-ne"},
+	{17, 3593, "bpmd"},
+	{17, 3594, "mediaspace"},
+	{17, 3595, "shareapp"},
+	{17, 3596, "iw-mmogame"},
+	{17, 3597, "a14"},
+	{17, 3598, "a15"},
+	{17, 3599, "quasar-server"},
+	{17, 3600, "trap-daemon"},
+	{17, 3601, "visinet-gui"},
+	{17, 3602, "infiniswitchcl"},
+	{17, 3603, "int-rcv-cntrl"},
+	{17, 3604, "bmc-jmx-port"},
+	{17, 3605, "comcam-io"},
+	{17, 3606, "splitlock"},
+	{17, 3607, "precise-i3"},
+	{17, 3608, "trendchip-dcp"},
+	{17, 3609, "cpdi-pidas-cm"},
+	{17, 3610, "echonet"},
+	{17, 3611, "six-degrees"},
+	{17, 3612, "dataprotector"},
+	{17, 3613, "alaris-disc"},
+	{17, 3614, "sigma-port"},
+	{17, 3615, "start-network"},
+	{17, 3616, "cd3o-protocol"},
+	{17, 3617, "sharp-server"},
+	{17, 3618, "aairnet-1"},
+	{17, 3619, "aairnet-2"},
+	{17, 3620, "ep-pcp"},
+	{17, 3621, "ep-nsp"},
+	{17, 3622, "ff-lr-port"},
+	{17, 3623, "haipe-discover"},
+	{17, 3624, "dist-upgrade"},
+	{17, 3625, "volley"},
+	{17, 3626, "bvcdaemon-port"},
+	{17, 3627, "jamserverport"},
+	{17, 3628, "ept-machine"},
+	{17, 3629, "escvpnet"},
+	{17, 3630, "cs-remote-db"},
+	{17, 3631, "cs-services"},
+	{17, 3632, "distcc"},
+	{17, 3633, "wacp"},
+	{17, 3634, "hlibmgr"},
+	{17, 3635, "sdo"},
+	{17, 3636, "servistaitsm"},
+	{17, 3637, "scservp"},
+	{17, 3638, "ehp-backup"},
+	{17, 3639, "xap-ha"},
+	{17, 3640, "netplay-port1"},
+	{17, 3641, "netplay-port2"},
+	{17, 3642, "juxml-port"},
+	{17, 3643, "audiojuggler"},
+	{17, 3644, "ssowatch"},
+	{17, 3645, "cyc"},
+	{17, 3646, "xss-srv-port"},
+	{17, 3647, "splitlock-gw"},
+	{17, 3648, "fjcp"},
+	{17, 3649, "nmmp"},
+	{17, 3650, "prismiq-plugin"},
+	{17, 3651, "xrpc-registry"},
+	{17, 3652, "vxcrnbuport"},
+	{17, 3653, "tsp"},
+	{17, 3654, "vaprtm"},
+	{17, 3655, "abatemgr"},
+	{17, 3656, "abatjss"},
+	{17, 3657, "immedianet-bcn"},
+	{17, 3658, "ps-ams"},
+	{17, 3659, "apple-sasl"},
+	{17, 3660, "can-nds-ssl"},
+	{17, 3661, "can-ferret-ssl"},
+	{17, 3662, "pserver"},
+	{17, 3663, "dtp"},
+	{17, 3664, "ups-engine"},
+	{17, 3665, "ent-engine"},
+	{17, 3666, "eserver-pap"},
+	{17, 3667, "infoexch"},
+	{17, 3668, "dell-rm-port"},
+	{17, 3669, "casanswmgmt"},
+	{17, 3670, "smile"},
+	{17, 3671, "efcp"},
+	{17, 3672, "lispworks-orb"},
+	{17, 3673, "mediavault-gui"},
+	{17, 3674, "wininstall-ipc"},
+	{17, 3675, "calltrax"},
+	{17, 3676, "va-pacbase"},
+	{17, 3677, "roverlog"},
+	{17, 3678, "ipr-dglt"},
+	{17, 3679, "Escale (Newton Dock)"},
+	{17, 3680, "npds-tracker"},
+	{17, 3681, "bts-x73"},
+	{17, 3682, "cas-mapi"},
+	{17, 3683, "bmc-ea"},
+	{17, 3684, "faxstfx-port"},
+	{17, 3685, "dsx-agent"},
+	{17, 3686, "tnmpv2"},
+	{17, 3687, "simple-push"},
+	{17, 3688, "simple-push-s"},
+	{17, 3689, "daap"},
+	{17, 3690, "svn"},
+	{17, 3691, "magaya-network"},
+	{17, 3692, "intelsync"},
+	{17, 3695, "bmc-data-coll"},
+	{17, 3696, "telnetcpcd"},
+	{17, 3697, "nw-license"},
+	{17, 3698, "sagectlpanel"},
+	{17, 3699, "kpn-icw"},
+	{17, 3700, "lrs-paging"},
+	{17, 3701, "netcelera"},
+	{17, 3702, "ws-discovery"},
+	{17, 3703, "adobeserver-3"},
+	{17, 3704, "adobeserver-4"},
+	{17, 3705, "adobeserver-5"},
+	{17, 3706, "rt-event"},
+	{17, 3707, "rt-event-s"},
+	{17, 3708, "sun-as-iiops"},
+	{17, 3709, "ca-idms"},
+	{17, 3710, "portgate-auth"},
+	{17, 3711, "edb-server2"},
+	{17, 3712, "sentinel-ent"},
+	{17, 3713, "tftps"},
+	{17, 3714, "delos-dms"},
+	{17, 3715, "anoto-rendezv"},
+	{17, 3716, "wv-csp-sms-cir"},
+	{17, 3717, "wv-csp-udp-cir"},
+	{17, 3718, "opus-services"},
+	{17, 3719, "itelserverport"},
+	{17, 3720, "ufastro-instr"},
+	{17, 3721, "xsync"},
+	{17, 3722, "xserveraid"},
+	{17, 3723, "sychrond"},
+	{17, 3724, "blizwow"},
+	{17, 3725, "na-er-tip"},
+	{17, 3726, "array-manager"},
+	{17, 3727, "e-mdu"},
+	{17, 3728, "e-woa"},
+	{17, 3729, "fksp-audit"},
+	{17, 3730, "client-ctrl"},
+	{17, 3731, "smap"},
+	{17, 3732, "m-wnn"},
+	{17, 3733, "multip-msg"},
+	{17, 3734, "synel-data"},
+	{17, 3735, "pwdis"},
+	{17, 3736, "rs-rmi"},
+	{17, 3738, "versatalk"},
+	{17, 3739, "launchbird-lm"},
+	{17, 3740, "heartbeat"},
+	{17, 3741, "wysdma"},
+	{17, 3742, "cst-port"},
+	{17, 3743, "ipcs-command"},
+	{17, 3744, "sasg"},
+	{17, 3745, "gw-call-port"},
+	{17, 3746, "linktest"},
+	{17, 3747, "linktest-s"},
+	{17, 3748, "webdata"},
+	{17, 3749, "cimtrak"},
+	{17, 3750, "cbos-ip-port"},
+	{17, 3751, "gprs-cube"},
+	{17, 3752, "vipremoteagent"},
+	{17, 3753, "nattyserver"},
+	{17, 3754, "timestenbroker"},
+	{17, 3755, "sas-remote-hlp"},
+	{17, 3756, "canon-capt"},
+	{17, 3757, "grf-port"},
+	{17, 3758, "apw-registry"},
+	{17, 3759, "exapt-lmgr"},
+	{17, 3760, "adtempusclient"},
+	{17, 3761, "gsakmp"},
+	{17, 3762, "gbs-smp"},
+	{17, 3763, "xo-wave"},
+	{17, 3764, "mni-prot-rout"},
+	{17, 3765, "rtraceroute"},
+	{17, 3767, "listmgr-port"},
+	{17, 3768, "rblcheckd"},
+	{17, 3769, "haipe-otnk"},
+	{17, 3770, "cindycollab"},
+	{17, 3771, "paging-port"},
+	{17, 3772, "ctp"},
+	{17, 3773, "ctdhercules"},
+	{17, 3774, "zicom"},
+	{17, 3775, "ispmmgr"},
+	{17, 3776, "dvcprov-port"},
+	{17, 3777, "jibe-eb"},
+	{17, 3778, "c-h-it-port"},
+	{17, 3779, "cognima"},
+	{17, 3780, "nnp"},
+	{17, 3781, "abcvoice-port"},
+	{17, 3782, "iso-tp0s"},
+	{17, 3783, "bim-pem"},
+	{17, 3784, "bfd-control"},
+	{17, 3785, "bfd-echo"},
+	{17, 3786, "upstriggervsw"},
+	{17, 3787, "fintrx"},
+	{17, 3788, "isrp-port"},
+	{17, 3789, "remotedeploy"},
+	{17, 3790, "quickbooksrds"},
+	{17, 3791, "tvnetworkvideo"},
+	{17, 3792, "sitewatch"},
+	{17, 3793, "dcsoftware"},
+	{17, 3794, "jaus"},
+	{17, 3795, "myblast"},
+	{17, 3796, "spw-dialer"},
+	{17, 3797, "idps"},
+	{17, 3798, "minilock"},
+	{17, 3799, "radius-dynauth"},
+	{17, 3800, "pwgpsi"},
+	{17, 3801, "ibm-mgr"},
+	{17, 3802, "vhd"},
+	{17, 3803, "soniqsync"},
+	{17, 3804, "iqnet-port"},
+	{17, 3805, "tcpdataserver"},
+	{17, 3806, "wsmlb"},
+	{17, 3807, "spugna"},
+	{17, 3808, "sun-as-iiops-ca"},
+	{17, 3809, "apocd"},
+	{17, 3810, "wlanauth"},
+	{17, 3811, "amp"},
+	{17, 3812, "neto-wol-server"},
+	{17, 3813, "rap-ip"},
+	{17, 3814, "neto-dcs"},
+	{17, 3815, "lansurveyorxml"},
+	{17, 3816, "sunlps-http"},
+	{17, 3817, "tapeware"},
+	{17, 3818, "crinis-hb"},
+	{17, 3819, "epl-slp"},
+	{17, 3820, "scp"},
+	{17, 3821, "pmcp"},
+	{17, 3822, "acp-discovery"},
+	{17, 3823, "acp-conduit"},
+	{17, 3824, "acp-policy"},
+	{17, 3825, "ffserver"},
+	{17, 3826, "warmux"},
+	{17, 3827, "netmpi"},
+	{17, 3828, "neteh"},
+	{17, 3829, "neteh-ext"},
+	{17, 3830, "cernsysmgmtagt"},
+	{17, 3831, "dvapps"},
+	{17, 3832, "xxnetserver"},
+	{17, 3833, "aipn-auth"},
+	{17, 3834, "spectardata"},
+	{17, 3835, "spectardb"},
+	{17, 3836, "markem-dcp"},
+	{17, 3837, "mkm-discovery"},
+	{17, 3838, "sos"},
+	{17, 3839, "amx-rms"},
+	{17, 3840, "flirtmitmir"},
+	{17, 3842, "nhci"},
+	{17, 3843, "quest-agent"},
+	{17, 3844, "rnm"},
+	{17, 3845, "v-one-spp"},
+	{17, 3846, "an-pcp"},
+	{17, 3847, "msfw-control"},
+	{17, 3848, "item"},
+	{17, 3849, "spw-dnspreload"},
+	{17, 3850, "qtms-bootstrap"},
+	{17, 3851, "spectraport"},
+	{17, 3852, "sse-app-config"},
+	{17, 3853, "sscan"},
+	{17, 3854, "stryker-com"},
+	{17, 3855, "opentrac"},
+	{17, 3856, "informer"},
+	{17, 3857, "trap-port"},
+	{17, 3858, "trap-port-mom"},
+	{17, 3859, "nav-port"},
+	{17, 3860, "sasp"},
+	{17, 3861, "winshadow-hd"},
+	{17, 3862, "giga-pocket"},
+	{17, 3863, "asap-udp"},
+	{17, 3865, "xpl"},
+	{17, 3866, "dzdaemon"},
+	{17, 3867, "dzoglserver"},
+	{17, 3869, "ovsam-mgmt"},
+	{17, 3870, "ovsam-d-agent"},
+	{17, 3871, "avocent-adsap"},
+	{17, 3872, "oem-agent"},
+	{17, 3873, "fagordnc"},
+	{17, 3874, "sixxsconfig"},
+	{17, 3875, "pnbscada"},
+	{17, 3876, "dl-agent"},
+	{17, 3877, "xmpcr-interface"},
+	{17, 3878, "fotogcad"},
+	{17, 3879, "appss-lm"},
+	{17, 3880, "igrs"},
+	{17, 3881, "idac"},
+	{17, 3882, "msdts1"},
+	{17, 3883, "vrpn"},
+	{17, 3884, "softrack-meter"},
+	{17, 3885, "topflow-ssl"},
+	{17, 3886, "nei-management"},
+	{17, 3887, "ciphire-data"},
+	{17, 3888, "ciphire-serv"},
+	{17, 3889, "dandv-tester"},
+	{17, 3890, "ndsconnect"},
+	{17, 3891, "rtc-pm-port"},
+	{17, 3892, "pcc-image-port"},
+	{17, 3893, "cgi-starapi"},
+	{17, 3894, "syam-agent"},
+	{17, 3895, "syam-smc"},
+	{17, 3896, "sdo-tls"},
+	{17, 3897, "sdo-ssh"},
+	{17, 3898, "senip"},
+	{17, 3899, "itv-control"},
+	{17, 3900, "udt-os"},
+	{17, 3901, "nimsh"},
+	{17, 3902, "nimaux"},
+	{17, 3903, "charsetmgr"},
+	{17, 3904, "omnilink-port"},
+	{17, 3905, "mupdate"},
+	{17, 3906, "topovista-data"},
+	{17, 3907, "imoguia-port"},
+	{17, 3908, "hppronetman"},
+	{17, 3909, "surfcontrolcpa"},
+	{17, 3910, "prnrequest"},
+	{17, 3911, "prnstatus"},
+	{17, 3912, "gbmt-stars"},
+	{17, 3913, "listcrt-port"},
+	{17, 3914, "listcrt-port-2"},
+	{17, 3915, "agcat"},
+	{17, 3916, "wysdmc"},
+	{17, 3917, "aftmux"},
+	{17, 3918, "pktcablemmcops"},
+	{17, 3919, "hyperip"},
+	{17, 3920, "exasoftport1"},
+	{17, 3921, "herodotus-net"},
+	{17, 3922, "sor-update"},
+	{17, 3923, "symb-sb-port"},
+	{17, 3924, "mpl-gprs-port"},
+	{17, 3925, "zmp"},
+	{17, 3926, "winport"},
+	{17, 3927, "natdataservice"},
+	{17, 3928, "netboot-pxe"},
+	{17, 3929, "smauth-port"},
+	{17, 3930, "syam-webserver"},
+	{17, 3931, "msr-plugin-port"},
+	{17, 3932, "dyn-site"},
+	{17, 3933, "plbserve-port"},
+	{17, 3934, "sunfm-port"},
+	{17, 3935, "sdp-portmapper"},
+	{17, 3936, "mailprox"},
+	{17, 3937, "dvbservdsc"},
+	{17, 3938, "dbcontrol-agent"},
+	{17, 3939, "aamp"},
+	{17, 3940, "xecp-node"},
+	{17, 3941, "homeportal-web"},
+	{17, 3942, "srdp"},
+	{17, 3943, "tig"},
+	{17, 3944, "sops"},
+	{17, 3945, "emcads"},
+	{17, 3946, "backupedge"},
+	{17, 3947, "ccp"},
+	{17, 3948, "apdap"},
+	{17, 3949, "drip"},
+	{17, 3950, "namemunge"},
+	{17, 3951, "pwgippfax"},
+	{17, 3952, "i3-sessionmgr"},
+	{17, 3953, "xmlink-connect"},
+	{17, 3954, "adrep"},
+	{17, 3955, "p2pcommunity"},
+	{17, 3956, "gvcp"},
+	{17, 3957, "mqe-broker"},
+	{17, 3958, "mqe-agent"},
+	{17, 3959, "treehopper"},
+	{17, 3960, "bess"},
+	{17, 3961, "proaxess"},
+	{17, 3962, "sbi-agent"},
+	{17, 3963, "thrp"},
+	{17, 3964, "sasggprs"},
+	{17, 3965, "ati-ip-to-ncpe"},
+	{17, 3966, "bflckmgr"},
+	{17, 3967, "ppsms"},
+	{17, 3968, "ianywhere-dbns"},
+	{17, 3969, "landmarks"},
+	{17, 3970, "lanrevagent"},
+	{17, 3971, "lanrevserver"},
+	{17, 3972, "iconp"},
+	{17, 3973, "progistics"},
+	{17, 3974, "xk22"},
+	{17, 3975, "airshot"},
+	{17, 3976, "opswagent"},
+	{17, 3977, "opswmanager"},
+	{17, 3978, "secure-cfg-svr"},
+	{17, 3979, "smwan"},
+	{17, 3981, "starfish"},
+	{17, 3982, "eis"},
+	{17, 3983, "eisp"},
+	{17, 3984, "mapper-nodemgr"},
+	{17, 3985, "mapper-mapethd"},
+	{17, 3986, "mapper-ws-ethd"},
+	{17, 3987, "centerline"},
+	{17, 3988, "dcs-config"},
+	{17, 3989, "bv-queryengine"},
+	{17, 3990, "bv-is"},
+	{17, 3991, "bv-smcsrv"},
+	{17, 3992, "bv-ds"},
+	{17, 3993, "bv-agent"},
+	{17, 3995, "iss-mgmt-ssl"},
+	{17, 3996, "abcsoftware"},
+	{17, 3997, "agentsease-db"},
+	{17, 3998, "dnx"},
+	{17, 3999, "nvcnet"},
+	{17, 4000, "terabase"},
+	{17, 4001, "newoak"},
+	{17, 4002, "pxc-spvr-ft"},
+	{17, 4003, "pxc-splr-ft"},
+	{17, 4004, "pxc-roid"},
+	{17, 4005, "pxc-pin"},
+	{17, 4006, "pxc-spvr"},
+	{17, 4007, "pxc-splr"},
+	{17, 4008, "netcheque"},
+	{17, 4009, "chimera-hwm"},
+	{17, 4010, "samsung-unidex"},
+	{17, 4011, "altserviceboot"},
+	{17, 4012, "pda-gate"},
+	{17, 4013, "acl-manager"},
+	{17, 4014, "taiclock"},
+	{17, 4015, "talarian-mcast1"},
+	{17, 4016, "talarian-mcast2"},
+	{17, 4017, "talarian-mcast3"},
+	{17, 4018, "talarian-mcast4"},
+	{17, 4019, "talarian-mcast5"},
+	{17, 4020, "trap"},
+	{17, 4021, "nexus-portal"},
+	{17, 4022, "dnox"},
+	{17, 4023, "esnm-zoning"},
+	{17, 4024, "tnp1-port"},
+	{17, 4025, "partimage"},
+	{17, 4026, "as-debug"},
+	{17, 4027, "bxp"},
+	{17, 4028, "dtserver-port"},
+	{17, 4029, "ip-qsig"},
+	{17, 4030, "jdmn-port"},
+	{17, 4031, "suucp"},
+	{17, 4032, "vrts-auth-port"},
+	{17, 4033, "sanavigator"},
+	{17, 4034, "ubxd"},
+	{17, 4035, "wap-push-http"},
+	{17, 4036, "wap-push-https"},
+	{17, 4037, "ravehd"},
+	{17, 4038, "fazzt-ptp"},
+	{17, 4039, "fazzt-admin"},
+	{17, 4040, "yo-main"},
+	{17, 4041, "houston"},
+	{17, 4042, "ldxp"},
+	{17, 4043, "nirp"},
+	{17, 4044, "ltp"},
+	{17, 4045, "npp"},
+	{17, 4046, "acp-proto"},
+	{17, 4047, "ctp-state"},
+	{17, 4049, "wafs"},
+	{17, 4050, "cisco-wafs"},
+	{17, 4051, "cppdp"},
+	{17, 4052, "interact"},
+	{17, 4053, "ccu-comm-1"},
+	{17, 4054, "ccu-comm-2"},
+	{17, 4055, "ccu-comm-3"},
+	{17, 4056, "lms"},
+	{17, 4057, "wfm"},
+	{17, 4058, "kingfisher"},
+	{17, 4059, "dlms-cosem"},
+	{17, 4060, "dsmeter-iatc"},
+	{17, 4061, "ice-location"},
+	{17, 4062, "ice-slocation"},
+	{17, 4063, "ice-router"},
+	{17, 4064, "ice-srouter"},
+	{17, 4065, "avanti-cdp"},
+	{17, 4066, "pmas"},
+	{17, 4067, "idp"},
+	{17, 4068, "ipfltbcst"},
+	{17, 4069, "minger"},
+	{17, 4070, "tripe"},
+	{17, 4071, "aibkup"},
+	{17, 4072, "zieto-sock"},
+	{17, 4073, "iRAPP"},
+	{17, 4074, "cequint-cityid"},
+	{17, 4075, "perimlan"},
+	{17, 4076, "seraph"},
+	{17, 4077, "ascomalarm"},
+	{17, 4079, "santools"},
+	{17, 4080, "lorica-in"},
+	{17, 4081, "lorica-in-sec"},
+	{17, 4082, "lorica-out"},
+	{17, 4083, "lorica-out-sec"},
+	{17, 4084, "fortisphere-vm"},
+	{17, 4086, "ftsync"},
+	{17, 4089, "opencore"},
+	{17, 4090, "omasgport"},
+	{17, 4091, "ewinstaller"},
+	{17, 4092, "ewdgs"},
+	{17, 4093, "pvxpluscs"},
+	{17, 4094, "sysrqd"},
+	{17, 4095, "xtgui"},
+	{17, 4096, "bre"},
+	{17, 4097, "patrolview"},
+	{17, 4098, "drmsfsd"},
+	{17, 4099, "dpcp"},
+	{17, 4100, "igo-incognito"},
+	{17, 4101, "brlp-0"},
+	{17, 4102, "brlp-1"},
+	{17, 4103, "brlp-2"},
+	{17, 4104, "brlp-3"},
+	{17, 4105, "shofar"},
+	{17, 4106, "synchronite"},
+	{17, 4107, "j-ac"},
+	{17, 4108, "accel"},
+	{17, 4109, "izm"},
+	{17, 4110, "g2tag"},
+	{17, 4111, "xgrid"},
+	{17, 4112, "apple-vpns-rp"},
+	{17, 4113, "aipn-reg"},
+	{17, 4114, "jomamqmonitor"},
+	{17, 4115, "cds"},
+	{17, 4116, "smartcard-tls"},
+	{17, 4117, "hillrserv"},
+	{17, 4118, "netscript"},
+	{17, 4119, "assuria-slm"},
+	{17, 4121, "e-builder"},
+	{17, 4122, "fprams"},
+	{17, 4123, "z-wave"},
+	{17, 4124, "tigv2"},
+	{17, 4125, "opsview-envoy"},
+	{17, 4126, "ddrepl"},
+	{17, 4127, "unikeypro"},
+	{17, 4128, "nufw"},
+	{17, 4129, "nuauth"},
+	{17, 4130, "fronet"},
+	{17, 4131, "stars"},
+	{17, 4132, "nuts-dem"},
+	{17, 4133, "nuts-bootp"},
+	{17, 4134, "nifty-hmi"},
+	{17, 4135, "cl-db-attach"},
+	{17, 4136, "cl-db-request"},
+	{17, 4137, "cl-db-remote"},
+	{17, 4138, "nettest"},
+	{17, 4139, "thrtx"},
+	{17, 4140, "cedros-fds"},
+	{17, 4141, "oirtgsvc"},
+	{17, 4142, "oidocsvc"},
+	{17, 4143, "oidsr"},
+	{17, 4145, "vvr-control"},
+	{17, 4146, "tgcconnect"},
+	{17, 4147, "vrxpservman"},
+	{17, 4148, "hhb-handheld"},
+	{17, 4149, "agslb"},
+	{17, 4150, "PowerAlert-nsa"},
+	{17, 4151, "menandmice-noh"},
+	{17, 4152, "idig-mux"},
+	{17, 4153, "mbl-battd"},
+	{17, 4154, "atlinks"},
+	{17, 4155, "bzr"},
+	{17, 4156, "stat-results"},
+	{17, 4157, "stat-scanner"},
+	{17, 4158, "stat-cc"},
+	{17, 4159, "nss"},
+	{17, 4160, "jini-discovery"},
+	{17, 4161, "omscontact"},
+	{17, 4162, "omstopology"},
+	{17, 4163, "silverpeakpeer"},
+	{17, 4164, "silverpeakcomm"},
+	{17, 4165, "altcp"},
+	{17, 4166, "joost"},
+	{17, 4167, "ddgn"},
+	{17, 4168, "pslicser"},
+	{17, 4169, "iadt-disc"},
+	{17, 4172, "pcoip"},
+	{17, 4173, "mma-discovery"},
+	{17, 4174, "sm-disc"},
+	{17, 4177, "wello"},
+	{17, 4178, "storman"},
+	{17, 4179, "MaxumSP"},
+	{17, 4180, "httpx"},
+	{17, 4181, "macbak"},
+	{17, 4182, "pcptcpservice"},
+	{17, 4183, "cyborgnet"},
+	{17, 4184, "universe-suite"},
+	{17, 4185, "wcpp"},
+	{17, 4188, "vatata"},
+	{17, 4191, "dsmipv6"},
+	{17, 4192, "azeti-bd"},
+	{17, 4195, "aws-wsp"},
+	{17, 4197, "hctl"},
+	{17, 4199, "eims-admin"},
+	{17, 4300, "corelccam"},
+	{17, 4301, "d-data"},
+	{17, 4302, "d-data-control"},
+	{17, 4303, "srcp"},
+	{17, 4304, "owserver"},
+	{17, 4305, "batman"},
+	{17, 4306, "pinghgl"},
+	{17, 4307, "trueconf"},
+	{17, 4308, "compx-lockview"},
+	{17, 4309, "dserver"},
+	{17, 4310, "mirrtex"},
+	{17, 4319, "fox-skytale"},
+	{17, 4320, "fdt-rcatp"},
+	{17, 4321, "rwhois"},
+	{17, 4322, "trim-event"},
+	{17, 4323, "trim-ice"},
+	{17, 4325, "geognosisman"},
+	{17, 4326, "geognosis"},
+	{17, 4327, "jaxer-web"},
+	{17, 4328, "jaxer-manager"},
+	{17, 4333, "ahsp"},
+	{17, 4340, "gaia"},
+	{17, 4341, "lisp-data"},
+	{17, 4342, "lisp-control"},
+	{17, 4343, "unicall"},
+	{17, 4344, "vinainstall"},
+	{17, 4345, "m4-network-as"},
+	{17, 4346, "elanlm"},
+	{17, 4347, "lansurveyor"},
+	{17, 4348, "itose"},
+	{17, 4349, "fsportmap"},
+	{17, 4350, "net-device"},
+	{17, 4351, "plcy-net-svcs"},
+	{17, 4352, "pjlink"},
+	{17, 4353, "f5-iquery"},
+	{17, 4354, "qsnet-trans"},
+	{17, 4355, "qsnet-workst"},
+	{17, 4356, "qsnet-assist"},
+	{17, 4357, "qsnet-cond"},
+	{17, 4358, "qsnet-nucl"},
+	{17, 4359, "omabcastltkm"},
+	{17, 4361, "nacnl"},
+	{17, 4362, "afore-vdp-disc"},
+	{17, 4366, "shadowstream"},
+	{17, 4368, "wxbrief"},
+	{17, 4369, "epmd"},
+	{17, 4370, "elpro-tunnel"},
+	{17, 4371, "l2c-disc"},
+	{17, 4372, "l2c-data"},
+	{17, 4373, "remctl"},
+	{17, 4375, "tolteces"},
+	{17, 4376, "bip"},
+	{17, 4377, "cp-spxsvr"},
+	{17, 4378, "cp-spxdpy"},
+	{17, 4379, "ctdb"},
+	{17, 4389, "xandros-cms"},
+	{17, 4390, "wiegand"},
+	{17, 4394, "apwi-disc"},
+	{17, 4395, "omnivisionesx"},
+	{17, 4400, "ds-srv"},
+	{17, 4401, "ds-srvr"},
+	{17, 4402, "ds-clnt"},
+	{17, 4403, "ds-user"},
+	{17, 4404, "ds-admin"},
+	{17, 4405, "ds-mail"},
+	{17, 4406, "ds-slp"},
+	{17, 4412, "smallchat"},
+	{17, 4413, "avi-nms-disc"},
+	{17, 4416, "pjj-player-disc"},
+	{17, 4418, "axysbridge"},
+	{17, 4420, "nvme"},
+	{17, 4425, "netrockey6"},
+	{17, 4426, "beacon-port-2"},
+	{17, 4430, "rsqlserver"},
+	{17, 4432, "l-acoustics"},
+	{17, 4441, "netblox"},
+	{17, 4442, "saris"},
+	{17, 4443, "pharos"},
+	{17, 4444, "krb524"},
+	{17, 4445, "upnotifyp"},
+	{17, 4446, "n1-fwp"},
+	{17, 4447, "n1-rmgmt"},
+	{17, 4448, "asc-slmd"},
+	{17, 4449, "privatewire"},
+	{17, 4450, "camp"},
+	{17, 4451, "ctisystemmsg"},
+	{17, 4452, "ctiprogramload"},
+	{17, 4453, "nssalertmgr"},
+	{17, 4454, "nssagentmgr"},
+	{17, 4455, "prchat-user"},
+	{17, 4456, "prchat-server"},
+	{17, 4457, "prRegister"},
+	{17, 4458, "mcp"},
+	{17, 4484, "hpssmgmt"},
+	{17, 4486, "icms"},
+	{17, 4488, "awacs-ice"},
+	{17, 4500, "ipsec-nat-t"},
+	{17, 4534, "armagetronad"},
+	{17, 4535, "ehs"},
+	{17, 4536, "ehs-ssl"},
+	{17, 4537, "wssauthsvc"},
+	{17, 4538, "swx-gate"},
+	{17, 4545, "worldscores"},
+	{17, 4546, "sf-lm"},
+	{17, 4547, "lanner-lm"},
+	{17, 4548, "synchromesh"},
+	{17, 4549, "aegate"},
+	{17, 4550, "gds-adppiw-db"},
+	{17, 4551, "ieee-mih"},
+	{17, 4552, "menandmice-mon"},
+	{17, 4554, "msfrs"},
+	{17, 4555, "rsip"},
+	{17, 4556, "dtn-bundle"},
+	{17, 4557, "mtcevrunqss"},
+	{17, 4558, "mtcevrunqman"},
+	{17, 4559, "hylafax"},
+	{17, 4566, "kwtc"},
+	{17, 4567, "tram"},
+	{17, 4568, "bmc-reporting"},
+	{17, 4569, "iax"},
+	{17, 4591, "l3t-at-an"},
+	{17, 4592, "hrpd-ith-at-an"},
+	{17, 4593, "ipt-anri-anri"},
+	{17, 4594, "ias-session"},
+	{17, 4595, "ias-paging"},
+	{17, 4596, "ias-neighbor"},
+	{17, 4597, "a21-an-1xbs"},
+	{17, 4598, "a16-an-an"},
+	{17, 4599, "a17-an-an"},
+	{17, 4600, "piranha1"},
+	{17, 4601, "piranha2"},
+	{17, 4621, "ventoso"},
+	{17, 4646, "dots-signal"},
+	{17, 4658, "playsta2-app"},
+	{17, 4659, "playsta2-lob"},
+	{17, 4660, "smaclmgr"},
+	{17, 4661, "kar2ouche"},
+	{17, 4662, "oms"},
+	{17, 4663, "noteit"},
+	{17, 4664, "ems"},
+	{17, 4665, "contclientms"},
+	{17, 4666, "eportcomm"},
+	{17, 4667, "mmacomm"},
+	{17, 4668, "mmaeds"},
+	{17, 4669, "eportcommdata"},
+	{17, 4670, "light"},
+	{17, 4671, "acter"},
+	{17, 4672, "rfa"},
+	{17, 4673, "cxws"},
+	{17, 4674, "appiq-mgmt"},
+	{17, 4675, "dhct-status"},
+	{17, 4676, "dhct-alerts"},
+	{17, 4677, "bcs"},
+	{17, 4678, "traversal"},
+	{17, 4679, "mgesupervision"},
+	{17, 4680, "mgemanagement"},
+	{17, 4681, "parliant"},
+	{17, 4682, "finisar"},
+	{17, 4683, "spike"},
+	{17, 4684, "rfid-rp1"},
+	{17, 4685, "autopac"},
+	{17, 4686, "msp-os"},
+	{17, 4687, "nst"},
+	{17, 4688, "mobile-p2p"},
+	{17, 4689, "altovacentral"},
+	{17, 4690, "prelude"},
+	{17, 4691, "mtn"},
+	{17, 4692, "conspiracy"},
+	{17, 4700, "netxms-agent"},
+	{17, 4701, "netxms-mgmt"},
+	{17, 4702, "netxms-sync"},
+	{17, 4711, "trinity-dist"},
+	{17, 4725, "truckstar"},
+	{17, 4726, "a26-fap-fgw"},
+	{17, 4727, "fcis-disc"},
+	{17, 4728, "capmux"},
+	{17, 4729, "gsmtap"},
+	{17, 4730, "gearman"},
+	{17, 4732, "ohmtrigger"},
+	{17, 4737, "ipdr-sp"},
+	{17, 4738, "solera-lpn"},
+	{17, 4739, "ipfix"},
+	{17, 4740, "ipfixs"},
+	{17, 4741, "lumimgrd"},
+	{17, 4742, "sicct-sdp"},
+	{17, 4743, "openhpid"},
+	{17, 4744, "ifsp"},
+	{17, 4745, "fmp"},
+	{17, 4746, "intelliadm-disc"},
+	{17, 4747, "buschtrommel"},
+	{17, 4749, "profilemac"},
+	{17, 4750, "ssad"},
+	{17, 4751, "spocp"},
+	{17, 4752, "snap"},
+	{17, 4753, "simon-disc"},
+	{17, 4754, "gre-in-udp"},
+	{17, 4755, "gre-udp-dtls"},
+	{17, 4784, "bfd-multi-ctl"},
+	{17, 4785, "cncp"},
+	{17, 4789, "vxlan"},
+	{17, 4790, "vxlan-gpe"},
+	{17, 4791, "roce"},
+	{17, 4792, "unified-bus"},
+	{17, 4800, "iims"},
+	{17, 4801, "iwec"},
+	{17, 4802, "ilss"},
+	{17, 4803, "notateit-disc"},
+	{17, 4804, "aja-ntv4-disc"},
+	{17, 4827, "htcp"},
+	{17, 4837, "varadero-0"},
+	{17, 4838, "varadero-1"},
+	{17, 4839, "varadero-2"},
+	{17, 4840, "opcua-udp"},
+	{17, 4841, "quosa"},
+	{17, 4842, "gw-asv"},
+	{17, 4843, "opcua-tls"},
+	{17, 4844, "gw-log"},
+	{17, 4845, "wcr-remlib"},
+	{17, 4846, "contamac-icm"},
+	{17, 4847, "wfc"},
+	{17, 4848, "appserv-http"},
+	{17, 4849, "appserv-https"},
+	{17, 4850, "sun-as-nodeagt"},
+	{17, 4851, "derby-repli"},
+	{17, 4867, "unify-debug"},
+	{17, 4868, "phrelay"},
+	{17, 4869, "phrelaydbg"},
+	{17, 4870, "cc-tracking"},
+	{17, 4871, "wired"},
+	{17, 4876, "tritium-can"},
+	{17, 4877, "lmcs"},
+	{17, 4878, "inst-discovery"},
+	{17, 4881, "socp-t"},
+	{17, 4882, "socp-c"},
+	{17, 4884, "hivestor"},
+	{17, 4885, "abbs"},
+	{17, 4894, "lyskom"},
+	{17, 4899, "radmin-port"},
+	{17, 4900, "hfcs"},
+	{17, 4914, "bones"},
+	{17, 4936, "an-signaling"},
+	{17, 4937, "atsc-mh-ssc"},
+	{17, 4940, "eq-office-4940"},
+	{17, 4941, "eq-office-4941"},
+	{17, 4942, "eq-office-4942"},
+	{17, 4949, "munin"},
+	{17, 4950, "sybasesrvmon"},
+	{17, 4951, "pwgwims"},
+	{17, 4952, "sagxtsds"},
+	{17, 4969, "ccss-qmm"},
+	{17, 4970, "ccss-qsm"},
+	{17, 4980, "ctxs-vpp"},
+	{17, 4986, "mrip"},
+	{17, 4987, "smar-se-port1"},
+	{17, 4988, "smar-se-port2"},
+	{17, 4989, "parallel"},
+	{17, 4990, "busycal"},
+	{17, 4991, "vrt"},
+	{17, 4999, "hfcs-manager"},
+	{17, 5000, "commplex-main"},
+	{17, 5001, "commplex-link"},
+	{17, 5002, "rfe"},
+	{17, 5003, "fmpro-internal"},
+	{17, 5004, "avt-profile-1"},
+	{17, 5005, "avt-profile-2"},
+	{17, 5006, "wsm-server"},
+	{17, 5007, "wsm-server-ssl"},
+	{17, 5008, "synapsis-edge"},
+	{17, 5009, "winfs"},
+	{17, 5010, "telelpathstart"},
+	{17, 5011, "telelpathattack"},
+	{17, 5012, "nsp"},
+	{17, 5013, "fmpro-v6"},
+	{17, 5014, "onpsocket"},
+	{17, 5020, "zenginkyo-1"},
+	{17, 5021, "zenginkyo-2"},
+	{17, 5022, "mice"},
+	{17, 5023, "htuilsrv"},
+	{17, 5024, "scpi-telnet"},
+	{17, 5025, "scpi-raw"},
+	{17, 5026, "strexec-d"},
+	{17, 5027, "strexec-s"},
+	{17, 5029, "infobright"},
+	{17, 5031, "dmp"},
+	{17, 5042, "asnaacceler8db"},
+	{17, 5043, "swxadmin"},
+	{17, 5044, "lxi-evntsvc"},
+	{17, 5046, "vpm-udp"},
+	{17, 5047, "iscape"},
+	{17, 5049, "ivocalize"},
+	{17, 5050, "mmcc"},
+	{17, 5051, "ita-agent"},
+	{17, 5052, "ita-manager"},
+	{17, 5053, "rlm-disc"},
+	{17, 5055, "unot"},
+	{17, 5056, "intecom-ps1"},
+	{17, 5057, "intecom-ps2"},
+	{17, 5058, "locus-disc"},
+	{17, 5059, "sds"},
+	{17, 5060, "sip"},
+	{17, 5061, "sips"},
+	{17, 5062, "na-localise"},
+	{17, 5064, "ca-1"},
+	{17, 5065, "ca-2"},
+	{17, 5066, "stanag-5066"},
+	{17, 5067, "authentx"},
+	{17, 5069, "i-net-2000-npr"},
+	{17, 5070, "vtsas"},
+	{17, 5071, "powerschool"},
+	{17, 5072, "ayiya"},
+	{17, 5073, "tag-pm"},
+	{17, 5074, "alesquery"},
+	{17, 5078, "pixelpusher"},
+	{17, 5079, "cp-spxrpts"},
+	{17, 5080, "onscreen"},
+	{17, 5081, "sdl-ets"},
+	{17, 5082, "qcp"},
+	{17, 5083, "qfp"},
+	{17, 5084, "llrp"},
+	{17, 5085, "encrypted-llrp"},
+	{17, 5092, "magpie"},
+	{17, 5093, "sentinel-lm"},
+	{17, 5094, "hart-ip"},
+	{17, 5099, "sentlm-srv2srv"},
+	{17, 5100, "socalia"},
+	{17, 5101, "talarian-udp"},
+	{17, 5102, "oms-nonsecure"},
+	{17, 5104, "tinymessage"},
+	{17, 5105, "hughes-ap"},
+	{17, 5111, "taep-as-svc"},
+	{17, 5112, "pm-cmdsvr"},
+	{17, 5116, "emb-proj-cmd"},
+	{17, 5120, "barracuda-bbs"},
+	{17, 5133, "nbt-pc"},
+	{17, 5136, "minotaur-sa"},
+	{17, 5137, "ctsd"},
+	{17, 5145, "rmonitor-secure"},
+	{17, 5150, "atmp"},
+	{17, 5151, "esri-sde"},
+	{17, 5152, "sde-discovery"},
+	{17, 5154, "bzflag"},
+	{17, 5155, "asctrl-agent"},
+	{17, 5164, "vpa-disc"},
+	{17, 5165, "ife-icorp"},
+	{17, 5166, "winpcs"},
+	{17, 5167, "scte104"},
+	{17, 5168, "scte30"},
+	{17, 5190, "aol"},
+	{17, 5191, "aol-1"},
+	{17, 5192, "aol-2"},
+	{17, 5193, "aol-3"},
+	{17, 5200, "targus-getdata"},
+	{17, 5201, "targus-getdata1"},
+	{17, 5202, "targus-getdata2"},
+	{17, 5203, "targus-getdata3"},
+	{17, 5223, "hpvirtgrp"},
+	{17, 5224, "hpvirtctrl"},
+	{17, 5225, "hp-server"},
+	{17, 5226, "hp-status"},
+	{17, 5227, "perfd"},
+	{17, 5234, "eenet"},
+	{17, 5235, "galaxy-network"},
+	{17, 5236, "padl2sim"},
+	{17, 5237, "mnet-discovery"},
+	{17, 5245, "downtools-disc"},
+	{17, 5246, "capwap-control"},
+	{17, 5247, "capwap-data"},
+	{17, 5248, "caacws"},
+	{17, 5249, "caaclang2"},
+	{17, 5250, "soagateway"},
+	{17, 5251, "caevms"},
+	{17, 5252, "movaz-ssc"},
+	{17, 5264, "3com-njack-1"},
+	{17, 5265, "3com-njack-2"},
+	{17, 5270, "cartographerxmp"},
+	{17, 5271, "cuelink-disc"},
+	{17, 5272, "pk"},
+	{17, 5282, "transmit-port"},
+	{17, 5298, "presence"},
+	{17, 5299, "nlg-data"},
+	{17, 5300, "hacl-hb"},
+	{17, 5301, "hacl-gs"},
+	{17, 5302, "hacl-cfg"},
+	{17, 5303, "hacl-probe"},
+	{17, 5304, "hacl-local"},
+	{17, 5305, "hacl-test"},
+	{17, 5306, "sun-mc-grp"},
+	{17, 5307, "sco-aip"},
+	{17, 5308, "cfengine"},
+	{17, 5309, "jprinter"},
+	{17, 5310, "outlaws"},
+	{17, 5312, "permabit-cs"},
+	{17, 5313, "rrdp"},
+	{17, 5314, "opalis-rbt-ipc"},
+	{17, 5315, "hacl-poll"},
+	{17, 5343, "kfserver"},
+	{17, 5344, "xkotodrcp"},
+	{17, 5349, "stuns"},
+	{17, 5350, "pcp-multicast"},
+	{17, 5351, "pcp"},
+	{17, 5352, "dns-llq"},
+	{17, 5353, "mdns"},
+	{17, 5354, "mdnsresponder"},
+	{17, 5355, "llmnr"},
+	{17, 5356, "ms-smlbiz"},
+	{17, 5357, "wsdapi"},
+	{17, 5358, "wsdapi-s"},
+	{17, 5359, "ms-alerter"},
+	{17, 5360, "ms-sideshow"},
+	{17, 5361, "ms-s-sideshow"},
+	{17, 5362, "serverwsd2"},
+	{17, 5363, "net-projection"},
+	{17, 5364, "kdnet"},
+	{17, 5397, "stresstester"},
+	{17, 5398, "elektron-admin"},
+	{17, 5399, "securitychase"},
+	{17, 5400, "excerpt"},
+	{17, 5401, "excerpts"},
+	{17, 5402, "mftp"},
+	{17, 5403, "hpoms-ci-lstn"},
+	{17, 5404, "hpoms-dps-lstn"},
+	{17, 5405, "netsupport"},
+	{17, 5406, "systemics-sox"},
+	{17, 5407, "foresyte-clear"},
+	{17, 5408, "foresyte-sec"},
+	{17, 5409, "salient-dtasrv"},
+	{17, 5410, "salient-usrmgr"},
+	{17, 5411, "actnet"},
+	{17, 5412, "continuus"},
+	{17, 5413, "wwiotalk"},
+	{17, 5414, "statusd"},
+	{17, 5415, "ns-server"},
+	{17, 5416, "sns-gateway"},
+	{17, 5417, "sns-agent"},
+	{17, 5418, "mcntp"},
+	{17, 5419, "dj-ice"},
+	{17, 5420, "cylink-c"},
+	{17, 5421, "netsupport2"},
+	{17, 5422, "salient-mux"},
+	{17, 5423, "virtualuser"},
+	{17, 5424, "beyond-remote"},
+	{17, 5425, "br-channel"},
+	{17, 5426, "devbasic"},
+	{17, 5427, "sco-peer-tta"},
+	{17, 5428, "telaconsole"},
+	{17, 5429, "base"},
+	{17, 5430, "radec-corp"},
+	{17, 5431, "park-agent"},
+	{17, 5432, "postgresql"},
+	{17, 5433, "pyrrho"},
+	{17, 5434, "sgi-arrayd"},
+	{17, 5435, "sceanics"},
+	{17, 5436, "pmip6-cntl"},
+	{17, 5437, "pmip6-data"},
+	{17, 5443, "spss"},
+	{17, 5450, "tiepie-disc"},
+	{17, 5453, "surebox"},
+	{17, 5454, "apc-5454"},
+	{17, 5455, "apc-5455"},
+	{17, 5456, "apc-5456"},
+	{17, 5461, "silkmeter"},
+	{17, 5462, "ttl-publisher"},
+	{17, 5463, "ttlpriceproxy"},
+	{17, 5464, "quailnet"},
+	{17, 5465, "netops-broker"},
+	{17, 5474, "apsolab-rpc"},
+	{17, 5500, "fcp-addr-srvr1"},
+	{17, 5501, "fcp-addr-srvr2"},
+	{17, 5502, "fcp-srvr-inst1"},
+	{17, 5503, "fcp-srvr-inst2"},
+	{17, 5504, "fcp-cics-gw1"},
+	{17, 5505, "checkoutdb"},
+	{17, 5506, "amc"},
+	{17, 5540, "matter"},
+	{17, 5553, "sgi-eventmond"},
+	{17, 5554, "sgi-esphttp"},
+	{17, 5555, "personal-agent"},
+	{17, 5556, "freeciv"},
+	{17, 5567, "dof-dps-mc-sec"},
+	{17, 5568, "sdt"},
+	{17, 5569, "rdmnet-device"},
+	{17, 5573, "sdmmp"},
+	{17, 5580, "tmosms0"},
+	{17, 5581, "tmosms1"},
+	{17, 5582, "fac-restore"},
+	{17, 5583, "tmo-icon-sync"},
+	{17, 5584, "bis-web"},
+	{17, 5585, "bis-sync"},
+	{17, 5597, "ininmessaging"},
+	{17, 5598, "mctfeed"},
+	{17, 5599, "esinstall"},
+	{17, 5600, "esmmanager"},
+	{17, 5601, "esmagent"},
+	{17, 5602, "a1-msc"},
+	{17, 5603, "a1-bs"},
+	{17, 5604, "a3-sdunode"},
+	{17, 5605, "a4-sdunode"},
+	{17, 5627, "ninaf"},
+	{17, 5628, "htrust"},
+	{17, 5629, "symantec-sfdb"},
+	{17, 5630, "precise-comm"},
+	{17, 5631, "pcanywheredata"},
+	{17, 5632, "pcanywherestat"},
+	{17, 5633, "beorl"},
+	{17, 5634, "xprtld"},
+	{17, 5670, "zre-disc"},
+	{17, 5671, "amqps"},
+	{17, 5672, "amqp"},
+	{17, 5673, "jms"},
+	{17, 5674, "hyperscsi-port"},
+	{17, 5675, "v5ua"},
+	{17, 5676, "raadmin"},
+	{17, 5677, "questdb2-lnchr"},
+	{17, 5678, "rrac"},
+	{17, 5679, "dccm"},
+	{17, 5680, "auriga-router"},
+	{17, 5681, "ncxcp"},
+	{17, 5682, "brightcore"},
+	{17, 5683, "coap"},
+	{17, 5684, "coaps"},
+	{17, 5687, "gog-multiplayer"},
+	{17, 5688, "ggz"},
+	{17, 5689, "qmvideo"},
+	{17, 5713, "proshareaudio"},
+	{17, 5714, "prosharevideo"},
+	{17, 5715, "prosharedata"},
+	{17, 5716, "prosharerequest"},
+	{17, 5717, "prosharenotify"},
+	{17, 5718, "dpm"},
+	{17, 5719, "dpm-agent"},
+	{17, 5720, "ms-licensing"},
+	{17, 5721, "dtpt"},
+	{17, 5722, "msdfsr"},
+	{17, 5723, "omhs"},
+	{17, 5724, "omsdk"},
+	{17, 5728, "io-dist-group"},
+	{17, 5729, "openmail"},
+	{17, 5730, "unieng"},
+	{17, 5741, "ida-discover1"},
+	{17, 5742, "ida-discover2"},
+	{17, 5743, "watchdoc-pod"},
+	{17, 5744, "watchdoc"},
+	{17, 5745, "fcopy-server"},
+	{17, 5746, "fcopys-server"},
+	{17, 5747, "tunatic"},
+	{17, 5748, "tunalyzer"},
+	{17, 5750, "rscd"},
+	{17, 5755, "openmailg"},
+	{17, 5757, "x500ms"},
+	{17, 5766, "openmailns"},
+	{17, 5767, "s-openmail"},
+	{17, 5768, "openmailpxy"},
+	{17, 5769, "spramsca"},
+	{17, 5770, "spramsd"},
+	{17, 5771, "netagent"},
+	{17, 5777, "starfield-io"},
+	{17, 5781, "3par-evts"},
+	{17, 5782, "3par-mgmt"},
+	{17, 5783, "3par-mgmt-ssl"},
+	{17, 5784, "ibar"},
+	{17, 5785, "3par-rcopy"},
+	{17, 5786, "cisco-redu"},
+	{17, 5787, "waascluster"},
+	{17, 5793, "xtreamx"},
+	{17, 5794, "spdp"},
+	{17, 5813, "icmpd"},
+	{17, 5814, "spt-automation"},
+	{17, 5859, "wherehoo"},
+	{17, 5863, "ppsuitemsg"},
+	{17, 5900, "rfb"},
+	{17, 5903, "ff-ice"},
+	{17, 5904, "ag-swim"},
+	{17, 5905, "asmgcs"},
+	{17, 5906, "rpas-c2"},
+	{17, 5907, "dsd"},
+	{17, 5908, "ipsma"},
+	{17, 5909, "agma"},
+	{17, 5910, "ats-atn"},
+	{17, 5911, "ats-acars"},
+	{17, 5912, "ais-met"},
+	{17, 5913, "aoc-acars"},
+	{17, 5963, "indy"},
+	{17, 5968, "mppolicy-v5"},
+	{17, 5969, "mppolicy-mgr"},
+	{17, 5984, "couchdb"},
+	{17, 5985, "wsman"},
+	{17, 5986, "wsmans"},
+	{17, 5987, "wbem-rmi"},
+	{17, 5988, "wbem-http"},
+	{17, 5989, "wbem-https"},
+	{17, 5990, "wbem-exp-https"},
+	{17, 5991, "nuxsl"},
+	{17, 5992, "consul-insight"},
+	{17, 5999, "cvsup"},
+	{17, 6064, "ndl-ahp-svc"},
+	{17, 6065, "winpharaoh"},
+	{17, 6066, "ewctsp"},
+	{17, 6069, "trip"},
+	{17, 6070, "messageasap"},
+	{17, 6071, "ssdtp"},
+	{17, 6072, "diagnose-proc"},
+	{17, 6073, "directplay8"},
+	{17, 6074, "max"},
+	{17, 6080, "gue"},
+	{17, 6081, "geneve"},
+	{17, 6082, "p25cai"},
+	{17, 6083, "miami-bcast"},
+	{17, 6085, "konspire2b"},
+	{17, 6086, "pdtp"},
+	{17, 6087, "ldss"},
+	{17, 6088, "doglms-notify"},
+	{17, 6100, "synchronet-db"},
+	{17, 6101, "synchronet-rtc"},
+	{17, 6102, "synchronet-upd"},
+	{17, 6103, "rets"},
+	{17, 6104, "dbdb"},
+	{17, 6105, "primaserver"},
+	{17, 6106, "mpsserver"},
+	{17, 6107, "etc-control"},
+	{17, 6108, "sercomm-scadmin"},
+	{17, 6109, "globecast-id"},
+	{17, 6110, "softcm"},
+	{17, 6111, "spc"},
+	{17, 6112, "dtspcd"},
+	{17, 6118, "tipc"},
+	{17, 6122, "bex-webadmin"},
+	{17, 6123, "backup-express"},
+	{17, 6124, "pnbs"},
+	{17, 6133, "nbt-wol"},
+	{17, 6140, "pulsonixnls"},
+	{17, 6141, "meta-corp"},
+	{17, 6142, "aspentec-lm"},
+	{17, 6143, "watershed-lm"},
+	{17, 6144, "statsci1-lm"},
+	{17, 6145, "statsci2-lm"},
+	{17, 6146, "lonewolf-lm"},
+	{17, 6147, "montage-lm"},
+	{17, 6148, "ricardo-lm"},
+	{17, 6149, "tal-pod"},
+	{17, 6160, "ecmp-data"},
+	{17, 6161, "patrol-ism"},
+	{17, 6162, "patrol-coll"},
+	{17, 6163, "pscribe"},
+	{17, 6200, "lm-x"},
+	{17, 6201, "thermo-calc"},
+	{17, 6209, "qmtps"},
+	{17, 6222, "radmind"},
+	{17, 6241, "jeol-nsddp-1"},
+	{17, 6242, "jeol-nsddp-2"},
+	{17, 6243, "jeol-nsddp-3"},
+	{17, 6244, "jeol-nsddp-4"},
+	{17, 6251, "tl1-raw-ssl"},
+	{17, 6252, "tl1-ssh"},
+	{17, 6253, "crip"},
+	{17, 6268, "grid"},
+	{17, 6269, "grid-alt"},
+	{17, 6300, "bmc-grx"},
+	{17, 6301, "bmc-ctd-ldap"},
+	{17, 6306, "ufmp"},
+	{17, 6315, "scup-disc"},
+	{17, 6316, "abb-escp"},
+	{17, 6317, "nav-data"},
+	{17, 6320, "repsvc"},
+	{17, 6321, "emp-server1"},
+	{17, 6322, "emp-server2"},
+	{17, 6324, "hrd-ns-disc"},
+	{17, 6343, "sflow"},
+	{17, 6346, "gnutella-svc"},
+	{17, 6347, "gnutella-rtr"},
+	{17, 6350, "adap"},
+	{17, 6355, "pmcs"},
+	{17, 6360, "metaedit-mu"},
+	{17, 6363, "ndn"},
+	{17, 6370, "metaedit-se"},
+	{17, 6382, "metatude-mds"},
+	{17, 6389, "clariion-evr01"},
+	{17, 6390, "metaedit-ws"},
+	{17, 6417, "faxcomservice"},
+	{17, 6419, "svdrp-disc"},
+	{17, 6420, "nim-vdrshell"},
+	{17, 6421, "nim-wan"},
+	{17, 6443, "sun-sr-https"},
+	{17, 6444, "sge-qmaster"},
+	{17, 6445, "sge-execd"},
+	{17, 6446, "mysql-proxy"},
+	{17, 6455, "skip-cert-recv"},
+	{17, 6456, "skip-cert-send"},
+	{17, 6464, "ieee11073-20701"},
+	{17, 6471, "lvision-lm"},
+	{17, 6480, "sun-sr-http"},
+	{17, 6481, "servicetags"},
+	{17, 6482, "ldoms-mgmt"},
+	{17, 6483, "SunVTS-RMI"},
+	{17, 6484, "sun-sr-jms"},
+	{17, 6485, "sun-sr-iiop"},
+	{17, 6486, "sun-sr-iiops"},
+	{17, 6487, "sun-sr-iiop-aut"},
+	{17, 6488, "sun-sr-jmx"},
+	{17, 6489, "sun-sr-admin"},
+	{17, 6500, "boks"},
+	{17, 6501, "boks-servc"},
+	{17, 6502, "boks-servm"},
+	{17, 6503, "boks-clntd"},
+	{17, 6505, "badm-priv"},
+	{17, 6506, "badm-pub"},
+	{17, 6507, "bdir-priv"},
+	{17, 6508, "bdir-pub"},
+	{17, 6509, "mgcs-mfp-port"},
+	{17, 6510, "mcer-port"},
+	{17, 6511, "dccp-udp"},
+	{17, 6514, "syslog-tls"},
+	{17, 6515, "elipse-rec"},
+	{17, 6543, "lds-distrib"},
+	{17, 6544, "lds-dump"},
+	{17, 6547, "apc-6547"},
+	{17, 6548, "apc-6548"},
+	{17, 6549, "apc-6549"},
+	{17, 6550, "fg-sysupdate"},
+	{17, 6551, "sum"},
+	{17, 6558, "xdsxdm"},
+	{17, 6566, "sane-port"},
+	{17, 6568, "rp-reputation"},
+	{17, 6579, "affiliate"},
+	{17, 6580, "parsec-master"},
+	{17, 6581, "parsec-peer"},
+	{17, 6582, "parsec-game"},
+	{17, 6583, "joaJewelSuite"},
+	{17, 6619, "odette-ftps"},
+	{17, 6620, "kftp-data"},
+	{17, 6621, "kftp"},
+	{17, 6622, "mcftp"},
+	{17, 6623, "ktelnet"},
+	{17, 6626, "wago-service"},
+	{17, 6627, "nexgen"},
+	{17, 6628, "afesc-mc"},
+	{17, 6629, "nexgen-aux"},
+	{17, 6633, "cisco-vpath-tun"},
+	{17, 6634, "mpls-pm"},
+	{17, 6635, "mpls-udp"},
+	{17, 6636, "mpls-udp-dtls"},
+	{17, 6653, "openflow"},
+	{17, 6657, "palcom-disc"},
+	{17, 6670, "vocaltec-gold"},
+	{17, 6671, "p4p-portal"},
+	{17, 6672, "vision-server"},
+	{17, 6673, "vision-elmd"},
+	{17, 6678, "vfbp-disc"},
+	{17, 6679, "osaut"},
+	{17, 6689, "tsa"},
+	{17, 6696, "babel"},
+	{17, 6699, "babel-dtls"},
+	{17, 6701, "kti-icad-srvr"},
+	{17, 6702, "e-design-net"},
+	{17, 6703, "e-design-web"},
+	{17, 6714, "ibprotocol"},
+	{17, 6715, "fibotrader-com"},
+	{17, 6767, "bmc-perf-agent"},
+	{17, 6768, "bmc-perf-mgrd"},
+	{17, 6769, "adi-gxp-srvprt"},
+	{17, 6770, "plysrv-http"},
+	{17, 6771, "plysrv-https"},
+	{17, 6784, "bfd-lag"},
+	{17, 6785, "dgpf-exchg"},
+	{17, 6786, "smc-jmx"},
+	{17, 6787, "smc-admin"},
+	{17, 6788, "smc-http"},
+	{17, 6790, "hnmp"},
+	{17, 6791, "hnm"},
+	{17, 6801, "acnet"},
+	{17, 6831, "ambit-lm"},
+	{17, 6841, "netmo-default"},
+	{17, 6842, "netmo-http"},
+	{17, 6850, "iccrushmore"},
+	{17, 6868, "acctopus-st"},
+	{17, 6888, "muse"},
+	{17, 6924, "split-ping"},
+	{17, 6935, "ethoscan"},
+	{17, 6936, "xsmsvc"},
+	{17, 6946, "bioserver"},
+	{17, 6951, "otlp"},
+	{17, 6961, "jmact3"},
+	{17, 6962, "jmevt2"},
+	{17, 6963, "swismgr1"},
+	{17, 6964, "swismgr2"},
+	{17, 6965, "swistrap"},
+	{17, 6966, "swispol"},
+	{17, 6969, "acmsoda"},
+	{17, 6980, "qolyester"},
+	{17, 6997, "MobilitySrv"},
+	{17, 6998, "iatp-highpri"},
+	{17, 6999, "iatp-normalpri"},
+	{17, 7000, "afs3-fileserver"},
+	{17, 7001, "afs3-callback"},
+	{17, 7002, "afs3-prserver"},
+	{17, 7003, "afs3-vlserver"},
+	{17, 7004, "afs3-kaserver"},
+	{17, 7005, "afs3-volser"},
+	{17, 7006, "afs3-errors"},
+	{17, 7007, "afs3-bos"},
+	{17, 7008, "afs3-update"},
+	{17, 7009, "afs3-rmtsys"},
+	{17, 7010, "ups-onlinet"},
+	{17, 7011, "talon-disc"},
+	{17, 7012, "talon-engine"},
+	{17, 7013, "microtalon-dis"},
+	{17, 7014, "microtalon-com"},
+	{17, 7015, "talon-webserver"},
+	{17, 7016, "spg"},
+	{17, 7017, "grasp"},
+	{17, 7019, "doceri-view"},
+	{17, 7020, "dpserve"},
+	{17, 7021, "dpserveadmin"},
+	{17, 7022, "ctdp"},
+	{17, 7023, "ct2nmcs"},
+	{17, 7024, "vmsvc"},
+	{17, 7025, "vmsvc-2"},
+	{17, 7030, "op-probe"},
+	{17, 7040, "quest-disc"},
+	{17, 7070, "arcp"},
+	{17, 7071, "iwg1"},
+	{17, 7072, "iba-cfg-disc"},
+	{17, 7080, "empowerid"},
+	{17, 7088, "zixi-transport"},
+	{17, 7095, "jdp-disc"},
+	{17, 7099, "lazy-ptop"},
+	{17, 7100, "font-service"},
+	{17, 7101, "elcn"},
+	{17, 7107, "aes-x170"},
+	{17, 7121, "virprot-lm"},
+	{17, 7128, "scenidm"},
+	{17, 7129, "scenccs"},
+	{17, 7161, "cabsm-comm"},
+	{17, 7162, "caistoragemgr"},
+	{17, 7163, "cacsambroker"},
+	{17, 7164, "fsr"},
+	{17, 7165, "doc-server"},
+	{17, 7166, "aruba-server"},
+	{17, 7169, "ccag-pib"},
+	{17, 7170, "nsrp"},
+	{17, 7171, "drm-production"},
+	{17, 7174, "clutild"},
+	{17, 7181, "janus-disc"},
+	{17, 7200, "fodms"},
+	{17, 7201, "dlip"},
+	{17, 7227, "ramp"},
+	{17, 7235, "aspcoordination"},
+	{17, 7244, "frc-hicp-disc"},
+	{17, 7262, "cnap"},
+	{17, 7272, "watchme-7272"},
+	{17, 7273, "oma-rlp"},
+	{17, 7274, "oma-rlp-s"},
+	{17, 7275, "oma-ulp"},
+	{17, 7276, "oma-ilp"},
+	{17, 7277, "oma-ilp-s"},
+	{17, 7278, "oma-dcdocbs"},
+	{17, 7279, "ctxlic"},
+	{17, 7280, "itactionserver1"},
+	{17, 7281, "itactionserver2"},
+	{17, 7282, "mzca-alert"},
+	{17, 7365, "lcm-server"},
+	{17, 7391, "mindfilesys"},
+	{17, 7392, "mrssrendezvous"},
+	{17, 7393, "nfoldman"},
+	{17, 7394, "fse"},
+	{17, 7395, "winqedit"},
+	{17, 7397, "hexarc"},
+	{17, 7400, "rtps-discovery"},
+	{17, 7401, "rtps-dd-ut"},
+	{17, 7402, "rtps-dd-mt"},
+	{17, 7410, "ionixnetmon"},
+	{17, 7411, "daqstream"},
+	{17, 7420, "ipluminary"},
+	{17, 7421, "mtportmon"},
+	{17, 7426, "pmdmgr"},
+	{17, 7427, "oveadmgr"},
+	{17, 7428, "ovladmgr"},
+	{17, 7429, "opi-sock"},
+	{17, 7430, "xmpv7"},
+	{17, 7431, "pmd"},
+	{17, 7437, "faximum"},
+	{17, 7443, "oracleas-https"},
+	{17, 7473, "rise"},
+	{17, 7491, "telops-lmd"},
+	{17, 7500, "silhouette"},
+	{17, 7501, "ovbus"},
+	{17, 7510, "ovhpas"},
+	{17, 7511, "pafec-lm"},
+	{17, 7542, "saratoga"},
+	{17, 7543, "atul"},
+	{17, 7544, "nta-ds"},
+	{17, 7545, "nta-us"},
+	{17, 7546, "cfs"},
+	{17, 7547, "cwmp"},
+	{17, 7548, "tidp"},
+	{17, 7549, "nls-tl"},
+	{17, 7550, "cloudsignaling"},
+	{17, 7560, "sncp"},
+	{17, 7566, "vsi-omega"},
+	{17, 7570, "aries-kfinder"},
+	{17, 7574, "coherence-disc"},
+	{17, 7588, "sun-lm"},
+	{17, 7606, "mipi-debug"},
+	{17, 7624, "indi"},
+	{17, 7627, "soap-http"},
+	{17, 7628, "zen-pawn"},
+	{17, 7629, "xdas"},
+	{17, 7633, "pmdfmgt"},
+	{17, 7648, "cuseeme"},
+	{17, 7663, "rome"},
+	{17, 7674, "imqtunnels"},
+	{17, 7675, "imqtunnel"},
+	{17, 7676, "imqbrokerd"},
+	{17, 7677, "sun-user-https"},
+	{17, 7680, "ms-do"},
+	{17, 7689, "collaber"},
+	{17, 7697, "klio"},
+	{17, 7707, "sync-em7"},
+	{17, 7708, "scinet"},
+	{17, 7720, "medimageportal"},
+	{17, 7724, "nsdeepfreezectl"},
+	{17, 7725, "nitrogen"},
+	{17, 7726, "freezexservice"},
+	{17, 7727, "trident-data"},
+	{17, 7728, "osvr"},
+	{17, 7734, "smip"},
+	{17, 7738, "aiagent"},
+	{17, 7741, "scriptview"},
+	{17, 7743, "sstp-1"},
+	{17, 7744, "raqmon-pdu"},
+	{17, 7747, "prgp"},
+	{17, 7777, "cbt"},
+	{17, 7778, "interwise"},
+	{17, 7779, "vstat"},
+	{17, 7781, "accu-lmgr"},
+	{17, 7784, "s-bfd"},
+	{17, 7786, "minivend"},
+	{17, 7787, "popup-reminders"},
+	{17, 7789, "office-tools"},
+	{17, 7794, "q3ade"},
+	{17, 7797, "pnet-conn"},
+	{17, 7798, "pnet-enc"},
+	{17, 7799, "altbsdp"},
+	{17, 7800, "asr"},
+	{17, 7801, "ssp-client"},
+	{17, 7802, "vns-tp"},
+	{17, 7810, "rbt-wanopt"},
+	{17, 7845, "apc-7845"},
+	{17, 7846, "apc-7846"},
+	{17, 7872, "mipv6tls"},
+	{17, 7880, "pss"},
+	{17, 7887, "ubroker"},
+	{17, 7900, "mevent"},
+	{17, 7901, "tnos-sp"},
+	{17, 7902, "tnos-dp"},
+	{17, 7903, "tnos-dps"},
+	{17, 7913, "qo-secure"},
+	{17, 7932, "t2-drm"},
+	{17, 7933, "t2-brm"},
+	{17, 7962, "generalsync"},
+	{17, 7967, "supercell"},
+	{17, 7979, "micromuse-ncps"},
+	{17, 7980, "quest-vista"},
+	{17, 7982, "sossd-disc"},
+	{17, 7998, "usicontentpush"},
+	{17, 7999, "irdmi2"},
+	{17, 8000, "irdmi"},
+	{17, 8001, "vcom-tunnel"},
+	{17, 8002, "teradataordbms"},
+	{17, 8003, "mcreport"},
+	{17, 8005, "mxi"},
+	{17, 8006, "wpl-disc"},
+	{17, 8007, "warppipe"},
+	{17, 8008, "http-alt"},
+	{17, 8017, "cisco-cloudsec"},
+	{17, 8019, "qbdb"},
+	{17, 8020, "intu-ec-svcdisc"},
+	{17, 8021, "intu-ec-client"},
+	{17, 8022, "oa-system"},
+	{17, 8023, "arca-api"},
+	{17, 8025, "ca-audit-da"},
+	{17, 8026, "ca-audit-ds"},
+	{17, 8027, "papachi-p2p-srv"},
+	{17, 8032, "pro-ed"},
+	{17, 8033, "mindprint"},
+	{17, 8034, "vantronix-mgmt"},
+	{17, 8040, "ampify"},
+	{17, 8041, "enguity-xccetp"},
+	{17, 8052, "senomix01"},
+	{17, 8053, "senomix02"},
+	{17, 8054, "senomix03"},
+	{17, 8055, "senomix04"},
+	{17, 8056, "senomix05"},
+	{17, 8057, "senomix06"},
+	{17, 8058, "senomix07"},
+	{17, 8059, "senomix08"},
+	{17, 8060, "aero"},
+	{17, 8074, "gadugadu"},
+	{17, 8080, "http-alt"},
+	{17, 8081, "sunproxyadmin"},
+	{17, 8082, "us-cli"},
+	{17, 8083, "us-srv"},
+	{17, 8086, "d-s-n"},
+	{17, 8087, "simplifymedia"},
+	{17, 8088, "radan-http"},
+	{17, 8097, "sac"},
+	{17, 8100, "xprint-server"},
+	{17, 8111, "skynetflow"},
+	{17, 8115, "mtl8000-matrix"},
+	{17, 8116, "cp-cluster"},
+	{17, 8118, "privoxy"},
+	{17, 8121, "apollo-data"},
+	{17, 8122, "apollo-admin"},
+	{17, 8128, "paycash-online"},
+	{17, 8129, "paycash-wbp"},
+	{17, 8130, "indigo-vrmi"},
+	{17, 8131, "indigo-vbcp"},
+	{17, 8132, "dbabble"},
+	{17, 8148, "isdd"},
+	{17, 8149, "eor-game"},
+	{17, 8160, "patrol"},
+	{17, 8161, "patrol-snmp"},
+	{17, 8182, "vmware-fdm"},
+	{17, 8184, "itach"},
+	{17, 8192, "spytechphone"},
+	{17, 8194, "blp1"},
+	{17, 8195, "blp2"},
+	{17, 8199, "vvr-data"},
+	{17, 8200, "trivnet1"},
+	{17, 8201, "trivnet2"},
+	{17, 8202, "aesop"},
+	{17, 8204, "lm-perfworks"},
+	{17, 8205, "lm-instmgr"},
+	{17, 8206, "lm-dta"},
+	{17, 8207, "lm-sserver"},
+	{17, 8208, "lm-webwatcher"},
+	{17, 8211, "aruba-papi"},
+	{17, 8230, "rexecj"},
+	{17, 8231, "hncp-udp-port"},
+	{17, 8232, "hncp-dtls-port"},
+	{17, 8243, "synapse-nhttps"},
+	{17, 8266, "espeasy-p2p"},
+	{17, 8276, "ms-mcc"},
+	{17, 8280, "synapse-nhttp"},
+	{17, 8282, "libelle-disc"},
+	{17, 8292, "blp3"},
+	{17, 8294, "blp4"},
+	{17, 8300, "tmi"},
+	{17, 8301, "amberon"},
+	{17, 8320, "tnp-discover"},
+	{17, 8321, "tnp"},
+	{17, 8322, "garmin-marine"},
+	{17, 8351, "server-find"},
+	{17, 8376, "cruise-enum"},
+	{17, 8377, "cruise-swroute"},
+	{17, 8378, "cruise-config"},
+	{17, 8379, "cruise-diags"},
+	{17, 8380, "cruise-update"},
+	{17, 8383, "m2mservices"},
+	{17, 8384, "marathontp"},
+	{17, 8400, "cvd"},
+	{17, 8401, "sabarsd"},
+	{17, 8402, "abarsd"},
+	{17, 8403, "admind"},
+	{17, 8416, "espeech"},
+	{17, 8417, "espeech-rtp"},
+	{17, 8433, "aws-as2"},
+	{17, 8442, "cybro-a-bus"},
+	{17, 8443, "pcsync-https"},
+	{17, 8444, "pcsync-http"},
+	{17, 8445, "copy-disc"},
+	{17, 8450, "npmp"},
+	{17, 8472, "otv"},
+	{17, 8473, "vp2p"},
+	{17, 8474, "noteshare"},
+	{17, 8500, "fmtp"},
+	{17, 8501, "cmtp-av"},
+	{17, 8503, "lsp-self-ping"},
+	{17, 8554, "rtsp-alt"},
+	{17, 8555, "d-fence"},
+	{17, 8567, "dof-tunnel"},
+	{17, 8600, "asterix"},
+	{17, 8609, "canon-cpp-disc"},
+	{17, 8610, "canon-mfnp"},
+	{17, 8611, "canon-bjnp1"},
+	{17, 8612, "canon-bjnp2"},
+	{17, 8613, "canon-bjnp3"},
+	{17, 8614, "canon-bjnp4"},
+	{17, 8675, "msi-cps-rm-disc"},
+	{17, 8686, "sun-as-jmxrmi"},
+	{17, 8732, "dtp-net"},
+	{17, 8733, "ibus"},
+	{17, 8763, "mc-appserver"},
+	{17, 8764, "openqueue"},
+	{17, 8765, "ultraseek-http"},
+	{17, 8766, "amcs"},
+	{17, 8770, "dpap"},
+	{17, 8786, "msgclnt"},
+	{17, 8787, "msgsrvr"},
+	{17, 8793, "acd-pm"},
+	{17, 8800, "sunwebadmin"},
+	{17, 8804, "truecm"},
+	{17, 8805, "pfcp"},
+	{17, 8807, "hes-clip"},
+	{17, 8808, "ssports-bcast"},
+	{17, 8809, "3gpp-monp"},
+	{17, 8873, "dxspider"},
+	{17, 8880, "cddbp-alt"},
+	{17, 8883, "secure-mqtt"},
+	{17, 8888, "ddi-udp-1"},
+	{17, 8889, "ddi-udp-2"},
+	{17, 8890, "ddi-udp-3"},
+	{17, 8891, "ddi-udp-4"},
+	{17, 8892, "ddi-udp-5"},
+	{17, 8893, "ddi-udp-6"},
+	{17, 8894, "ddi-udp-7"},
+	{17, 8899, "ospf-lite"},
+	{17, 8900, "jmb-cds1"},
+	{17, 8901, "jmb-cds2"},
+	{17, 8910, "manyone-http"},
+	{17, 8911, "manyone-xml"},
+	{17, 8912, "wcbackup"},
+	{17, 8913, "dragonfly"},
+	{17, 8954, "cumulus-admin"},
+	{17, 8980, "nod-provider"},
+	{17, 8981, "nod-client"},
+	{17, 8989, "sunwebadmins"},
+	{17, 8990, "http-wmap"},
+	{17, 8991, "https-wmap"},
+	{17, 8999, "bctp"},
+	{17, 9000, "cslistener"},
+	{17, 9001, "etlservicemgr"},
+	{17, 9002, "dynamid"},
+	{17, 9007, "ogs-client"},
+	{17, 9009, "pichat"},
+	{17, 9011, "d-star"},
+	{17, 9020, "tambora"},
+	{17, 9021, "panagolin-ident"},
+	{17, 9022, "paragent"},
+	{17, 9023, "swa-1"},
+	{17, 9024, "swa-2"},
+	{17, 9025, "swa-3"},
+	{17, 9026, "swa-4"},
+	{17, 9060, "CardWeb-RT"},
+	{17, 9080, "glrpc"},
+	{17, 9081, "cisco-aqos"},
+	{17, 9084, "aurora"},
+	{17, 9085, "ibm-rsyscon"},
+	{17, 9086, "net2display"},
+	{17, 9087, "classic"},
+	{17, 9088, "sqlexec"},
+	{17, 9089, "sqlexec-ssl"},
+	{17, 9090, "websm"},
+	{17, 9091, "xmltec-xmlmail"},
+	{17, 9092, "XmlIpcRegSvc"},
+	{17, 9100, "hp-pdl-datastr"},
+	{17, 9101, "bacula-dir"},
+	{17, 9102, "bacula-fd"},
+	{17, 9103, "bacula-sd"},
+	{17, 9104, "peerwire"},
+	{17, 9105, "xadmin"},
+	{17, 9106, "astergate-disc"},
+	{17, 9111, "hexxorecore"},
+	{17, 9119, "mxit"},
+	{17, 9131, "dddp"},
+	{17, 9160, "apani1"},
+	{17, 9161, "apani2"},
+	{17, 9162, "apani3"},
+	{17, 9163, "apani4"},
+	{17, 9164, "apani5"},
+	{17, 9191, "sun-as-jpda"},
+	{17, 9200, "wap-wsp"},
+	{17, 9201, "wap-wsp-wtp"},
+	{17, 9202, "wap-wsp-s"},
+	{17, 9203, "wap-wsp-wtp-s"},
+	{17, 9204, "wap-vcard"},
+	{17, 9205, "wap-vcal"},
+	{17, 9206, "wap-vcard-s"},
+	{17, 9207, "wap-vcal-s"},
+	{17, 9208, "rjcdb-vcards"},
+	{17, 9209, "almobile-system"},
+	{17, 9210, "oma-mlp"},
+	{17, 9211, "oma-mlp-s"},
+	{17, 9212, "serverviewdbms"},
+	{17, 9213, "serverstart"},
+	{17, 9214, "ipdcesgbs"},
+	{17, 9215, "insis"},
+	{17, 9216, "acme"},
+	{17, 9217, "fsc-port"},
+	{17, 9222, "teamcoherence"},
+	{17, 9255, "mon"},
+	{17, 9277, "traingpsdata"},
+	{17, 9278, "pegasus"},
+	{17, 9279, "pegasus-ctl"},
+	{17, 9280, "pgps"},
+	{17, 9281, "swtp-port1"},
+	{17, 9282, "swtp-port2"},
+	{17, 9283, "callwaveiam"},
+	{17, 9284, "visd"},
+	{17, 9285, "n2h2server"},
+	{17, 9286, "n2receive"},
+	{17, 9287, "cumulus"},
+	{17, 9292, "armtechdaemon"},
+	{17, 9293, "storview"},
+	{17, 9294, "armcenterhttp"},
+	{17, 9295, "armcenterhttps"},
+	{17, 9300, "vrace"},
+	{17, 9318, "secure-ts"},
+	{17, 9321, "guibase"},
+	{17, 9343, "mpidcmgr"},
+	{17, 9344, "mphlpdmc"},
+	{17, 9346, "ctechlicensing"},
+	{17, 9374, "fjdmimgr"},
+	{17, 9380, "boxp"},
+	{17, 9396, "fjinvmgr"},
+	{17, 9397, "mpidcagt"},
+	{17, 9400, "sec-t4net-srv"},
+	{17, 9401, "sec-t4net-clt"},
+	{17, 9402, "sec-pc2fax-srv"},
+	{17, 9418, "git"},
+	{17, 9443, "tungsten-https"},
+	{17, 9444, "wso2esb-console"},
+	{17, 9450, "sntlkeyssrvr"},
+	{17, 9500, "ismserver"},
+	{17, 9522, "sma-spw"},
+	{17, 9535, "mngsuite"},
+	{17, 9536, "laes-bf"},
+	{17, 9555, "trispen-sra"},
+	{17, 9592, "ldgateway"},
+	{17, 9593, "cba8"},
+	{17, 9594, "msgsys"},
+	{17, 9595, "pds"},
+	{17, 9596, "mercury-disc"},
+	{17, 9597, "pd-admin"},
+	{17, 9598, "vscp"},
+	{17, 9599, "robix"},
+	{17, 9600, "micromuse-ncpw"},
+	{17, 9612, "streamcomm-ds"},
+	{17, 9618, "condor"},
+	{17, 9628, "odbcpathway"},
+	{17, 9629, "uniport"},
+	{17, 9632, "mc-comm"},
+	{17, 9667, "xmms2"},
+	{17, 9668, "tec5-sdctp"},
+	{17, 9694, "client-wakeup"},
+	{17, 9695, "ccnx"},
+	{17, 9700, "board-roar"},
+	{17, 9747, "l5nas-parchan"},
+	{17, 9750, "board-voip"},
+	{17, 9753, "rasadv"},
+	{17, 9762, "tungsten-http"},
+	{17, 9800, "davsrc"},
+	{17, 9801, "sstp-2"},
+	{17, 9802, "davsrcs"},
+	{17, 9875, "sapv1"},
+	{17, 9878, "kca-service"},
+	{17, 9888, "cyborg-systems"},
+	{17, 9889, "gt-proxy"},
+	{17, 9898, "monkeycom"},
+	{17, 9899, "sctp-tunneling"},
+	{17, 9900, "iua"},
+	{17, 9901, "enrp"},
+	{17, 9903, "multicast-ping"},
+	{17, 9909, "domaintime"},
+	{17, 9911, "sype-transport"},
+	{17, 9950, "apc-9950"},
+	{17, 9951, "apc-9951"},
+	{17, 9952, "apc-9952"},
+	{17, 9953, "acis"},
+	{17, 9955, "alljoyn-mcm"},
+	{17, 9956, "alljoyn"},
+	{17, 9966, "odnsp"},
+	{17, 9987, "dsm-scm-target"},
+	{17, 9990, "osm-appsrvr"},
+	{17, 9991, "osm-oev"},
+	{17, 9992, "palace-1"},
+	{17, 9993, "palace-2"},
+	{17, 9994, "palace-3"},
+	{17, 9995, "palace-4"},
+	{17, 9996, "palace-5"},
+	{17, 9997, "palace-6"},
+	{17, 9998, "distinct32"},
+	{17, 9999, "distinct"},
+	{17, 10000, "ndmp"},
+	{17, 10001, "scp-config"},
+	{17, 10002, "documentum"},
+	{17, 10003, "documentum-s"},
+	{17, 10007, "mvs-capacity"},
+	{17, 10008, "octopus"},
+	{17, 10009, "swdtp-sv"},
+	{17, 10023, "cefd-vmp"},
+	{17, 10050, "zabbix-agent"},
+	{17, 10051, "zabbix-trapper"},
+	{17, 10080, "amanda"},
+	{17, 10081, "famdc"},
+	{17, 10100, "itap-ddtp"},
+	{17, 10101, "ezmeeting-2"},
+	{17, 10102, "ezproxy-2"},
+	{17, 10103, "ezrelay"},
+	{17, 10104, "swdtp"},
+	{17, 10107, "bctp-server"},
+	{17, 10110, "nmea-0183"},
+	{17, 10111, "nmea-onenet"},
+	{17, 10113, "netiq-endpoint"},
+	{17, 10114, "netiq-qcheck"},
+	{17, 10115, "netiq-endpt"},
+	{17, 10116, "netiq-voipa"},
+	{17, 10117, "iqrm"},
+	{17, 10128, "bmc-perf-sd"},
+	{17, 10160, "qb-db-server"},
+	{17, 10161, "snmpdtls"},
+	{17, 10162, "snmpdtls-trap"},
+	{17, 10200, "trisoap"},
+	{17, 10201, "rscs"},
+	{17, 10252, "apollo-relay"},
+	{17, 10253, "eapol-relay"},
+	{17, 10260, "axis-wimp-port"},
+	{17, 10288, "blocks"},
+	{17, 10439, "bngsync"},
+	{17, 10500, "hip-nat-t"},
+	{17, 10540, "MOS-lower"},
+	{17, 10541, "MOS-upper"},
+	{17, 10542, "MOS-aux"},
+	{17, 10543, "MOS-soap"},
+	{17, 10544, "MOS-soap-opt"},
+	{17, 10800, "gap"},
+	{17, 10805, "lpdg"},
+	{17, 10810, "nmc-disc"},
+	{17, 10860, "helix"},
+	{17, 10880, "bveapi"},
+	{17, 10990, "rmiaux"},
+	{17, 11000, "irisa"},
+	{17, 11001, "metasys"},
+	{17, 11095, "weave"},
+	{17, 11106, "sgi-lk"},
+	{17, 11108, "myq-termlink"},
+	{17, 11111, "vce"},
+	{17, 11112, "dicom"},
+	{17, 11161, "suncacao-snmp"},
+	{17, 11162, "suncacao-jmxmp"},
+	{17, 11163, "suncacao-rmi"},
+	{17, 11164, "suncacao-csa"},
+	{17, 11165, "suncacao-websvc"},
+	{17, 11171, "snss"},
+	{17, 11201, "smsqp"},
+	{17, 11208, "wifree"},
+	{17, 11211, "memcache"},
+	{17, 11319, "imip"},
+	{17, 11320, "imip-channels"},
+	{17, 11321, "arena-server"},
+	{17, 11367, "atm-uhas"},
+	{17, 11371, "hkp"},
+	{17, 11430, "lsdp"},
+	{17, 11600, "tempest-port"},
+	{17, 11720, "h323callsigalt"},
+	{17, 11723, "emc-xsw-dcache"},
+	{17, 11751, "intrepid-ssl"},
+	{17, 11796, "lanschool-mpt"},
+	{17, 11876, "xoraya"},
+	{17, 11877, "x2e-disc"},
+	{17, 11967, "sysinfo-sp"},
+	{17, 12000, "entextxid"},
+	{17, 12001, "entextnetwk"},
+	{17, 12002, "entexthigh"},
+	{17, 12003, "entextmed"},
+	{17, 12004, "entextlow"},
+	{17, 12005, "dbisamserver1"},
+	{17, 12006, "dbisamserver2"},
+	{17, 12007, "accuracer"},
+	{17, 12008, "accuracer-dbms"},
+	{17, 12009, "ghvpn"},
+	{17, 12012, "vipera"},
+	{17, 12013, "vipera-ssl"},
+	{17, 12109, "rets-ssl"},
+	{17, 12121, "nupaper-ss"},
+	{17, 12168, "cawas"},
+	{17, 12172, "hivep"},
+	{17, 12300, "linogridengine"},
+	{17, 12321, "warehouse-sss"},
+	{17, 12322, "warehouse"},
+	{17, 12345, "italk"},
+	{17, 12753, "tsaf"},
+	{17, 13160, "i-zipqd"},
+	{17, 13216, "bcslogc"},
+	{17, 13217, "rs-pias"},
+	{17, 13218, "emc-vcas-udp"},
+	{17, 13223, "powwow-client"},
+	{17, 13224, "powwow-server"},
+	{17, 13400, "doip-disc"},
+	{17, 13720, "bprd"},
+	{17, 13721, "bpdbm"},
+	{17, 13722, "bpjava-msvc"},
+	{17, 13724, "vnetd"},
+	{17, 13782, "bpcd"},
+	{17, 13783, "vopied"},
+	{17, 13785, "nbdb"},
+	{17, 13786, "nomdb"},
+	{17, 13818, "dsmcc-config"},
+	{17, 13819, "dsmcc-session"},
+	{17, 13820, "dsmcc-passthru"},
+	{17, 13821, "dsmcc-download"},
+	{17, 13822, "dsmcc-ccp"},
+	{17, 13894, "ucontrol"},
+	{17, 13929, "dta-systems"},
+	{17, 14000, "scotty-ft"},
+	{17, 14001, "sua"},
+	{17, 14002, "scotty-disc"},
+	{17, 14033, "sage-best-com1"},
+	{17, 14034, "sage-best-com2"},
+	{17, 14141, "vcs-app"},
+	{17, 14142, "icpp"},
+	{17, 14145, "gcm-app"},
+	{17, 14149, "vrts-tdd"},
+	{17, 14154, "vad"},
+	{17, 14250, "cps"},
+	{17, 14414, "ca-web-update"},
+	{17, 14936, "hde-lcesrvr-1"},
+	{17, 14937, "hde-lcesrvr-2"},
+	{17, 15000, "hydap"},
+	{17, 15118, "v2g-secc"},
+	{17, 15345, "xpilot"},
+	{17, 15363, "3link"},
+	{17, 15555, "cisco-snat"},
+	{17, 15660, "bex-xr"},
+	{17, 15740, "ptp"},
+	{17, 15998, "2ping"},
+	{17, 16003, "alfin"},
+	{17, 16161, "sun-sea-port"},
+	{17, 16309, "etb4j"},
+	{17, 16310, "pduncs"},
+	{17, 16311, "pdefmns"},
+	{17, 16360, "netserialext1"},
+	{17, 16361, "netserialext2"},
+	{17, 16367, "netserialext3"},
+	{17, 16368, "netserialext4"},
+	{17, 16384, "connected"},
+	{17, 16666, "vtp"},
+	{17, 16900, "newbay-snc-mc"},
+	{17, 16950, "sgcip"},
+	{17, 16991, "intel-rci-mp"},
+	{17, 16992, "amt-soap-http"},
+	{17, 16993, "amt-soap-https"},
+	{17, 16994, "amt-redir-tcp"},
+	{17, 16995, "amt-redir-tls"},
+	{17, 17007, "isode-dua"},
+	{17, 17185, "soundsvirtual"},
+	{17, 17219, "chipper"},
+	{17, 17220, "avtp"},
+	{17, 17221, "avdecc"},
+	{17, 17222, "cpsp"},
+	{17, 17224, "trdp-pd"},
+	{17, 17225, "trdp-md"},
+	{17, 17234, "integrius-stp"},
+	{17, 17235, "ssh-mgmt"},
+	{17, 17500, "db-lsp-disc"},
+	{17, 17729, "ea"},
+	{17, 17754, "zep"},
+	{17, 17755, "zigbee-ip"},
+	{17, 17756, "zigbee-ips"},
+	{17, 18000, "biimenu"},
+	{17, 18181, "opsec-cvp"},
+	{17, 18182, "opsec-ufp"},
+	{17, 18183, "opsec-sam"},
+	{17, 18184, "opsec-lea"},
+	{17, 18185, "opsec-omi"},
+	{17, 18186, "ohsc"},
+	{17, 18187, "opsec-ela"},
+	{17, 18241, "checkpoint-rtm"},
+	{17, 18262, "gv-pf"},
+	{17, 18463, "ac-cluster"},
+	{17, 18516, "heythings"},
+	{17, 18634, "rds-ib"},
+	{17, 18635, "rds-ip"},
+	{17, 18668, "vdmmesh-disc"},
+	{17, 18769, "ique"},
+	{17, 18881, "infotos"},
+	{17, 18888, "apc-necmp"},
+	{17, 19000, "igrid"},
+	{17, 19007, "scintilla"},
+	{17, 19191, "opsec-uaa"},
+	{17, 19194, "ua-secureagent"},
+	{17, 19220, "cora-disc"},
+	{17, 19283, "keysrvr"},
+	{17, 19315, "keyshadow"},
+	{17, 19398, "mtrgtrans"},
+	{17, 19410, "hp-sco"},
+	{17, 19411, "hp-sca"},
+	{17, 19412, "hp-sessmon"},
+	{17, 19539, "fxuptp"},
+	{17, 19540, "sxuptp"},
+	{17, 19541, "jcp"},
+	{17, 19788, "mle"},
+	{17, 19999, "dnp-sec"},
+	{17, 20000, "dnp"},
+	{17, 20001, "microsan"},
+	{17, 20002, "commtact-http"},
+	{17, 20003, "commtact-https"},
+	{17, 20005, "openwebnet"},
+	{17, 20012, "ss-idi-disc"},
+	{17, 20014, "opendeploy"},
+	{17, 20034, "nburn-id"},
+	{17, 20046, "tmophl7mts"},
+	{17, 20048, "mountd"},
+	{17, 20049, "nfsrdma"},
+	{17, 20167, "tolfab"},
+	{17, 20202, "ipdtp-port"},
+	{17, 20222, "ipulse-ics"},
+	{17, 20480, "emwavemsg"},
+	{17, 20670, "track"},
+	{17, 20999, "athand-mmp"},
+	{17, 21000, "irtrans"},
+	{17, 21554, "dfserver"},
+	{17, 21590, "vofr-gateway"},
+	{17, 21800, "tvpm"},
+	{17, 21845, "webphone"},
+	{17, 21846, "netspeak-is"},
+	{17, 21847, "netspeak-cs"},
+	{17, 21848, "netspeak-acd"},
+	{17, 21849, "netspeak-cps"},
+	{17, 22000, "snapenetio"},
+	{17, 22001, "optocontrol"},
+	{17, 22002, "optohost002"},
+	{17, 22003, "optohost003"},
+	{17, 22004, "optohost004"},
+	{17, 22005, "optohost004"},
+	{17, 22273, "wnn6"},
+	{17, 22305, "cis"},
+	{17, 22333, "showcockpit-net"},
+	{17, 22335, "shrewd-stream"},
+	{17, 22343, "cis-secure"},
+	{17, 22347, "wibukey"},
+	{17, 22350, "codemeter"},
+	{17, 22555, "vocaltec-phone"},
+	{17, 22763, "talikaserver"},
+	{17, 22800, "aws-brf"},
+	{17, 22951, "brf-gw"},
+	{17, 23000, "inovaport1"},
+	{17, 23001, "inovaport2"},
+	{17, 23002, "inovaport3"},
+	{17, 23003, "inovaport4"},
+	{17, 23004, "inovaport5"},
+	{17, 23005, "inovaport6"},
+	{17, 23272, "s102"},
+	{17, 23294, "5afe-disc"},
+	{17, 23333, "elxmgmt"},
+	{17, 23400, "novar-dbase"},
+	{17, 23401, "novar-alarm"},
+	{17, 23402, "novar-global"},
+	{17, 24000, "med-ltp"},
+	{17, 24001, "med-fsp-rx"},
+	{17, 24002, "med-fsp-tx"},
+	{17, 24003, "med-supp"},
+	{17, 24004, "med-ovw"},
+	{17, 24005, "med-ci"},
+	{17, 24006, "med-net-svc"},
+	{17, 24242, "filesphere"},
+	{17, 24249, "vista-4gl"},
+	{17, 24321, "ild"},
+	{17, 24322, "hid"},
+	{17, 24386, "intel-rci"},
+	{17, 24465, "tonidods"},
+	{17, 24554, "binkp"},
+	{17, 24577, "bilobit-update"},
+	{17, 24676, "canditv"},
+	{17, 24677, "flashfiler"},
+	{17, 24678, "proactivate"},
+	{17, 24680, "tcc-http"},
+	{17, 24850, "assoc-disc"},
+	{17, 24922, "find"},
+	{17, 25000, "icl-twobase1"},
+	{17, 25001, "icl-twobase2"},
+	{17, 25002, "icl-twobase3"},
+	{17, 25003, "icl-twobase4"},
+	{17, 25004, "icl-twobase5"},
+	{17, 25005, "icl-twobase6"},
+	{17, 25006, "icl-twobase7"},
+	{17, 25007, "icl-twobase8"},
+	{17, 25008, "icl-twobase9"},
+	{17, 25009, "icl-twobase10"},
+	{17, 25793, "vocaltec-hos"},
+	{17, 25900, "tasp-net"},
+	{17, 25901, "niobserver"},
+	{17, 25902, "nilinkanalyst"},
+	{17, 25903, "niprobe"},
+	{17, 25954, "bf-game"},
+	{17, 25955, "bf-master"},
+	{17, 26000, "quake"},
+	{17, 26133, "scscp"},
+	{17, 26208, "wnn6-ds"},
+	{17, 26260, "ezproxy"},
+	{17, 26261, "ezmeeting"},
+	{17, 26262, "k3software-svr"},
+	{17, 26263, "k3software-cli"},
+	{17, 26486, "exoline-udp"},
+	{17, 26487, "exoconfig"},
+	{17, 26489, "exonet"},
+	{17, 27345, "imagepump"},
+	{17, 27442, "jesmsjc"},
+	{17, 27504, "kopek-httphead"},
+	{17, 27782, "ars-vista"},
+	{17, 27999, "tw-auth-key"},
+	{17, 28000, "nxlmd"},
+	{17, 28119, "a27-ran-ran"},
+	{17, 28200, "voxelstorm"},
+	{17, 28240, "siemensgsm"},
+	{17, 29167, "otmp"},
+	{17, 30001, "pago-services1"},
+	{17, 30002, "pago-services2"},
+	{17, 30003, "amicon-fpsu-ra"},
+	{17, 30004, "amicon-fpsu-s"},
+	{17, 30260, "kingdomsonline"},
+	{17, 30832, "samsung-disc"},
+	{17, 30999, "ovobs"},
+	{17, 31016, "ka-kdp"},
+	{17, 31029, "yawn"},
+	{17, 31337, "eldim"},
+	{17, 31416, "xqosd"},
+	{17, 31457, "tetrinet"},
+	{17, 31620, "lm-mon"},
+	{17, 31765, "gamesmith-port"},
+	{17, 31948, "iceedcp-tx"},
+	{17, 31949, "iceedcp-rx"},
+	{17, 32034, "iracinghelper"},
+	{17, 32249, "t1distproc60"},
+	{17, 32483, "apm-link"},
+	{17, 32635, "sec-ntb-clnt"},
+	{17, 32636, "DMExpress"},
+	{17, 32767, "filenet-powsrm"},
+	{17, 32768, "filenet-tms"},
+	{17, 32769, "filenet-rpc"},
+	{17, 32770, "filenet-nch"},
+	{17, 32771, "filenet-rmi"},
+	{17, 32772, "filenet-pa"},
+	{17, 32773, "filenet-cm"},
+	{17, 32774, "filenet-re"},
+	{17, 32775, "filenet-pch"},
+	{17, 32776, "filenet-peior"},
+	{17, 32777, "filenet-obrok"},
+	{17, 32801, "mlsn"},
+	{17, 32896, "idmgratm"},
+	{17, 33123, "aurora-balaena"},
+	{17, 33331, "diamondport"},
+	{17, 33334, "speedtrace-disc"},
+	{17, 33434, "traceroute"},
+	{17, 33435, "mtrace"},
+	{17, 33656, "snip-slave"},
+	{17, 34249, "turbonote-2"},
+	{17, 34378, "p-net-local"},
+	{17, 34379, "p-net-remote"},
+	{17, 34567, "edi_service"},
+	{17, 34962, "profinet-rt"},
+	{17, 34963, "profinet-rtm"},
+	{17, 34964, "profinet-cm"},
+	{17, 34980, "ethercat"},
+	{17, 35001, "rt-viewer"},
+	{17, 35004, "rt-classmanager"},
+	{17, 35100, "axio-disc"},
+	{17, 35355, "altova-lm-disc"},
+	{17, 36001, "allpeers"},
+	{17, 36411, "wlcp"},
+	{17, 36865, "kastenxpipe"},
+	{17, 37475, "neckar"},
+	{17, 37654, "unisys-eportal"},
+	{17, 38002, "crescoctrl-disc"},
+	{17, 38201, "galaxy7-data"},
+	{17, 38202, "fairview"},
+	{17, 38203, "agpolicy"},
+	{17, 39681, "turbonote-1"},
+	{17, 40000, "safetynetp"},
+	{17, 40023, "k-patentssensor"},
+	{17, 40841, "cscp"},
+	{17, 40842, "csccredir"},
+	{17, 40843, "csccfirewall"},
+	{17, 40853, "ortec-disc"},
+	{17, 41111, "fs-qos"},
+	{17, 41230, "z-wave-s"},
+	{17, 41794, "crestron-cip"},
+	{17, 41795, "crestron-ctp"},
+	{17, 42508, "candp"},
+	{17, 42509, "candrp"},
+	{17, 42510, "caerpc"},
+	{17, 43000, "recvr-rc-disc"},
+	{17, 43188, "reachout"},
+	{17, 43189, "ndm-agent-port"},
+	{17, 43190, "ip-provision"},
+	{17, 43210, "shaperai-disc"},
+	{17, 43438, "hmip-routing"},
+	{17, 43439, "eq3-config"},
+	{17, 43440, "ew-disc-cmd"},
+	{17, 43441, "ciscocsdb"},
+	{17, 44321, "pmcd"},
+	{17, 44322, "pmcdproxy"},
+	{17, 44544, "domiq"},
+	{17, 44553, "rbr-debug"},
+	{17, 44600, "asihpi"},
+	{17, 44818, "EtherNet-IP-2"},
+	{17, 44900, "m3da-disc"},
+	{17, 45000, "asmp-mon"},
+	{17, 45054, "invision-ag"},
+	{17, 45514, "cloudcheck-ping"},
+	{17, 45678, "eba"},
+	{17, 45825, "qdb2service"},
+	{17, 45966, "ssr-servermgr"},
+	{17, 46999, "mediabox"},
+	{17, 47000, "mbus"},
+	{17, 47100, "jvl-mactalk"},
+	{17, 47557, "dbbrowse"},
+	{17, 47624, "directplaysrvr"},
+	{17, 47806, "ap"},
+	{17, 47808, "bacnet"},
+	{17, 47809, "presonus-ucnet"},
+	{17, 48000, "nimcontroller"},
+	{17, 48001, "nimspooler"},
+	{17, 48002, "nimhub"},
+	{17, 48003, "nimgtw"},
+	{17, 48128, "isnetserv"},
+	{17, 48129, "blp5"},
+	{17, 48556, "com-bardac-dw"},
+	{17, 48619, "iqobject"},
+	{17, 48653, "robotraconteur"},
+	{17, 49001, "nusdp-disc"},
+	{132, 9, "discard"},
+	{132, 20, "ftp-data"},
+	{132, 21, "ftp"},
+	{132, 22, "ssh"},
+	{132, 80, "http"},
+	{132, 179, "bgp"},
+	{132, 443, "https"},
+	{132, 1021, "exp1"},
+	{132, 1022, "exp2"},
+	{132, 1167, "cisco-ipsla"},
+	{132, 1528, "norp"},
+	{132, 1720, "h323hostcall"},
+	{132, 2049, "nfs"},
+	{132, 2225, "rcip-itu"},
+	{132, 2904, "m2ua"},
+	{132, 2905, "m3ua"},
+	{132, 2944, "megaco-h248"},
+	{132, 2945, "h248-binary"},
+	{132, 3097, "itu-bicc-stc"},
+	{132, 3565, "m2pa"},
+	{132, 3863, "asap-sctp"},
+	{132, 3864, "asap-sctp-tls"},
+	{132, 3868, "diameter"},
+	{132, 4195, "aws-wsp"},
+	{132, 4333, "ahsp"},
+	{132, 4502, "a25-fap-fgw"},
+	{132, 4711, "trinity-dist"},
+	{132, 4739, "ipfix"},
+	{132, 4740, "ipfixs"},
+	{132, 5060, "sip"},
+	{132, 5061, "sips"},
+	{132, 5090, "car"},
+	{132, 5091, "cxtp"},
+	{132, 5215, "noteza"},
+	{132, 5445, "smbdirect"},
+	{132, 5672, "amqp"},
+	{132, 5675, "v5ua"},
+	{132, 5868, "diameters"},
+	{132, 5903, "ff-ice"},
+	{132, 5904, "ag-swim"},
+	{132, 5905, "asmgcs"},
+	{132, 5906, "rpas-c2"},
+	{132, 5907, "dsd"},
+	{132, 5908, "ipsma"},
+	{132, 5909, "agma"},
+	{132, 5910, "cm"},
+	{132, 5911, "cpdlc"},
+	{132, 5912, "fis"},
+	{132, 5913, "ads-c"},
+	{132, 6704, "frc-hp"},
+	{132, 6705, "frc-mp"},
+	{132, 6706, "frc-lp"},
+	{132, 6970, "conductor-mpx"},
+	{132, 7626, "simco"},
+	{132, 7701, "nfapi"},
+	{132, 7728, "osvr"},
+	{132, 8471, "pim-port"},
+	{132, 9082, "lcs-ap"},
+	{132, 9084, "aurora"},
+	{132, 9900, "iua"},
+	{132, 9901, "enrp-sctp"},
+	{132, 9902, "enrp-sctp-tls"},
+	{132, 11235, "xcompute"},
+	{132, 11997, "wmereceiving"},
+	{132, 11998, "wmedistribution"},
+	{132, 11999, "wmereporting"},
+	{132, 14001, "sua"},
+	{132, 19999, "dnp-sec"},
+	{132, 20000, "dnp"},
+	{132, 20049, "nfsrdma"},
+	{132, 25471, "rna"},
+	{132, 29118, "sgsap"},
+	{132, 29168, "sbcap"},
+	{132, 29169, "iuhsctpassoc"},
+	{132, 30100, "rwp"},
+	{132, 36412, "s1-control"},
+	{132, 36422, "x2-control"},
+	{132, 36423, "slmap"},
+	{132, 36424, "nq-ap"},
+	{132, 36443, "m2ap"},
+	{132, 36444, "m3ap"},
+	{132, 36462, "xw-control"},
+	{132, 37472, "3gpp-w1ap"},
+	{132, 38412, "ng-control"},
+	{132, 38422, "xn-control"},
+	{132, 38462, "e1-interface"},
+	{132, 38472, "f1-control"},
+}
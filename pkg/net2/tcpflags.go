@@ -0,0 +1,95 @@
+package net2
+
+import (
+	"strconv"
+	"strings"
+)
+
+// TCPFlags are the control bits of a TCP header, or of all the segments
+// of a flow ORed together. NS is only present in two-byte fields.
+type TCPFlags uint16
+
+const (
+	FIN TCPFlags = 1 << iota
+	SYN
+	RST
+	PSH
+	ACK
+	URG
+	ECE
+	CWR
+	NS
+)
+
+// tcpFlagNames are the names of the flags, by bit.
+var tcpFlagNames = [...]string{"FIN", "SYN", "RST", "PSH", "ACK", "URG", "ECE", "CWR", "NS"}
+
+// tcpFlagLetters are the letters of the flags as printed by nfdump, by
+// bit.
+const tcpFlagLetters = "FSRPAUECN"
+
+// String returns the names of the flags that are set joined by "|" in bit
+// order, e.g. "SYN|ACK", or "0" if none are. Bits above NS are shown as a
+// hexadecimal remainder.
+func (f TCPFlags) String() string {
+	if f == 0 {
+		return "0"
+	}
+	var b strings.Builder
+	for i, name := range tcpFlagNames {
+		if f&(1<<i) == 0 {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('|')
+		}
+		b.WriteString(name)
+	}
+	if rest := f &^ (1<<len(tcpFlagNames) - 1); rest != 0 {
+		if b.Len() > 0 {
+			b.WriteByte('|')
+		}
+		b.WriteString("0x" + strconv.FormatUint(uint64(rest), 16))
+	}
+	return b.String()
+}
+
+// ParseTCPFlags parses TCP flags as a number, as names joined by "|" or
+// ",", e.g. "SYN|ACK", or as nfdump letters, e.g. "SA". Case is ignored.
+func ParseTCPFlags(s string) (TCPFlags, bool) {
+	if n, err := strconv.ParseUint(s, 0, 16); err == nil {
+		return TCPFlags(n), true
+	}
+	s = strings.ToUpper(s)
+	if s == "" {
+		return 0, false
+	}
+	var f TCPFlags
+	if strings.ContainsAny(s, "|,") || indexOf(tcpFlagNames[:], s) >= 0 {
+		for _, name := range strings.FieldsFunc(s, func(r rune) bool { return r == '|' || r == ',' }) {
+			i := indexOf(tcpFlagNames[:], strings.TrimSpace(name))
+			if i < 0 {
+				return 0, false
+			}
+			f |= 1 << i
+		}
+		return f, true
+	}
+	for _, c := range s {
+		i := strings.IndexRune(tcpFlagLetters, c)
+		if i < 0 {
+			return 0, false
+		}
+		f |= 1 << i
+	}
+	return f, true
+}
+
+func indexOf(names []string, name string) int {
+	for i, n := range names {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,80 @@
+package net2
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DSCP returns the Differentiated Services Code Point in the upper six
+// bits of an IPv4 TOS or IPv6 Traffic Class byte.
+func DSCP(tos uint8) uint8 {
+	return tos >> 2
+}
+
+// ECN returns the Explicit Congestion Notification codepoint in the lower
+// two bits of an IPv4 TOS or IPv6 Traffic Class byte.
+func ECN(tos uint8) uint8 {
+	return tos & 3
+}
+
+// DSCPNames are the names of the standard DSCP values.
+var DSCPNames = map[uint8]string{
+	0:  "CS0",
+	1:  "LE",
+	8:  "CS1",
+	10: "AF11",
+	12: "AF12",
+	14: "AF13",
+	16: "CS2",
+	18: "AF21",
+	20: "AF22",
+	22: "AF23",
+	24: "CS3",
+	26: "AF31",
+	28: "AF32",
+	30: "AF33",
+	32: "CS4",
+	34: "AF41",
+	36: "AF42",
+	38: "AF43",
+	40: "CS5",
+	44: "VOICE-ADMIT",
+	46: "EF",
+	48: "CS6",
+	56: "CS7",
+}
+
+// ECNNames are the names of the ECN codepoints of RFC 3168.
+var ECNNames = [4]string{"Not-ECT", "ECT(1)", "ECT(0)", "CE"}
+
+// DSCPName returns the name of dscp, e.g. "EF", or its decimal value if
+// it has none.
+func DSCPName(dscp uint8) string {
+	if name, ok := DSCPNames[dscp]; ok {
+		return name
+	}
+	return strconv.Itoa(int(dscp))
+}
+
+// ParseDSCP parses a DSCP name, ignoring case, or a number below 64.
+func ParseDSCP(s string) (uint8, bool) {
+	if n, err := strconv.ParseUint(s, 0, 6); err == nil {
+		return uint8(n), true
+	}
+	for dscp, name := range DSCPNames {
+		if strings.EqualFold(name, s) {
+			return dscp, true
+		}
+	}
+	return 0, false
+}
+
+// FormatTOS returns the DSCP name of a TOS byte, followed by its ECN
+// codepoint if one is set, e.g. "AF41" or "EF CE".
+func FormatTOS(tos uint8) string {
+	s := DSCPName(DSCP(tos))
+	if ecn := ECN(tos); ecn != 0 {
+		s += " " + ECNNames[ecn]
+	}
+	return s
+}
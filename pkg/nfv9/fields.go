@@ -3,9 +3,12 @@ package nfv9
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
+	"net/netip"
 	"strconv"
+	"strings"
 
-	"github.com/ipartner/go.netflow/pkg/net2"
+	"github.com/brooksbp/go.netflow/pkg/net2"
 )
 
 type FieldTypeEntry struct {
@@ -20,8 +23,8 @@ var FieldMap = map[int]FieldTypeEntry{
 	2:   FieldTypeEntry{"IN_PKTS", -1, StringDefault, "Incoming counter with length N x 8 bits for the number of packets associated with an IP Flow"},
 	3:   FieldTypeEntry{"FLOWS", -1, StringDefault, "Number of flows that were aggregated; default for N is 4"},
 	4:   FieldTypeEntry{"PROTOCOL", 1, StringIPProtocol, "IP protocol byte"},
-	5:   FieldTypeEntry{"SRC_TOS", 1, StringDefault, "Type of Service byte setting when entering incoming interface"},
-	6:   FieldTypeEntry{"TCP_FLAGS", 1, StringDefault, "Cumulative of all the TCP flags seen for this flow"},
+	5:   FieldTypeEntry{"SRC_TOS", 1, StringTOS, "Type of Service byte setting when entering incoming interface"},
+	6:   FieldTypeEntry{"TCP_FLAGS", 1, StringTCPFlags, "Cumulative of all the TCP flags seen for this flow"},
 	7:   FieldTypeEntry{"L4_SRC_PORT", 2, StringDefault, "TCP/UDP source port number i.e.: FTP, Telnet, or equivalent"},
 	8:   FieldTypeEntry{"IPV4_SRC_ADDR", 4, StringIPv4, "IPv4 source address"},
 	9:   FieldTypeEntry{"SRC_MASK", 1, StringDefault, "The number of contiguous bits in the source address subnet mask i.e.: the submask in slash notation"},
@@ -33,7 +36,7 @@ var FieldMap = map[int]FieldTypeEntry{
 	15:  FieldTypeEntry{"IPV4_NEXT_HOP", 4, StringIPv4, "IPv4 address of next-hop router"},
 	16:  FieldTypeEntry{"SRC_AS", -1, StringDefault, "Source BGP autonomous system number where N could be 2 or 4"},
 	17:  FieldTypeEntry{"DST_AS", -1, StringDefault, "Destination BGP autonomous system number where N could be 2 or 4"},
-	18:  FieldTypeEntry{"BGP_IPV4_NEXT_HOP", 4, StringDefault, "Next-hop router's IP in the BGP domain'"},
+	18:  FieldTypeEntry{"BGP_IPV4_NEXT_HOP", 4, StringIPv4, "Next-hop router's IP in the BGP domain'"},
 	19:  FieldTypeEntry{"MUL_DST_PKTS", -1, StringDefault, ""},
 	20:  FieldTypeEntry{"MUL_DST_BYTES", -1, StringDefault, ""},
 	21:  FieldTypeEntry{"LAST_SWITCHED", -1, StringDefault, ""},
@@ -42,12 +45,12 @@ var FieldMap = map[int]FieldTypeEntry{
 	24:  FieldTypeEntry{"OUT_PKTS", -1, StringDefault, ""},
 	25:  FieldTypeEntry{"MIN_PKT_LNGTH", -1, StringDefault, ""},
 	26:  FieldTypeEntry{"MAX_PKT_LNGTH", -1, StringDefault, ""},
-	27:  FieldTypeEntry{"IPV6_SRC_ADDR", -1, StringDefault, ""},
-	28:  FieldTypeEntry{"IPV6_DST_ADDR", -1, StringDefault, ""},
-	29:  FieldTypeEntry{"IPV6_SRC_MASK", -1, StringDefault, ""},
-	30:  FieldTypeEntry{"IPV6_DST_MASK", -1, StringDefault, ""},
-	31:  FieldTypeEntry{"IPV6_FLOW_LABEL", -1, StringDefault, ""},
-	32:  FieldTypeEntry{"ICMP_TYPE", -1, StringDefault, ""},
+	27:  FieldTypeEntry{"IPV6_SRC_ADDR", 16, StringIPv6, "IPv6 source address"},
+	28:  FieldTypeEntry{"IPV6_DST_ADDR", 16, StringIPv6, "IPv6 destination address"},
+	29:  FieldTypeEntry{"IPV6_SRC_MASK", 1, StringDefault, "Length of the IPv6 source mask in contiguous bits"},
+	30:  FieldTypeEntry{"IPV6_DST_MASK", 1, StringDefault, "Length of the IPv6 destination mask in contiguous bits"},
+	31:  FieldTypeEntry{"IPV6_FLOW_LABEL", 3, StringIPv6FlowLabel, "IPv6 flow label as per RFC 2460 definition"},
+	32:  FieldTypeEntry{"ICMP_TYPE", -1, StringICMP, ""},
 	33:  FieldTypeEntry{"MUL_IGMP_TYPE", -1, StringDefault, ""},
 	34:  FieldTypeEntry{"SAMPLING_INTERVAL", -1, StringDefault, ""},
 	35:  FieldTypeEntry{"SAMPLING_ALGORITHM", -1, StringDefault, ""},
@@ -70,16 +73,16 @@ var FieldMap = map[int]FieldTypeEntry{
 	52:  FieldTypeEntry{"MIN_TTL", -1, StringDefault, ""},
 	53:  FieldTypeEntry{"MAX_TTL", -1, StringDefault, ""},
 	54:  FieldTypeEntry{"IPV4_IDENT", -1, StringDefault, ""},
-	55:  FieldTypeEntry{"DST_TOS", -1, StringDefault, ""},
+	55:  FieldTypeEntry{"DST_TOS", -1, StringTOS, ""},
 	56:  FieldTypeEntry{"IN_SRC_MAC", -1, StringMAC, ""},
 	57:  FieldTypeEntry{"OUT_DST_MAC", -1, StringMAC, ""},
 	58:  FieldTypeEntry{"SRC_VLAN", -1, StringDefault, ""},
 	59:  FieldTypeEntry{"DST_VLAN", -1, StringDefault, ""},
 	60:  FieldTypeEntry{"IP_PROTOCOL_VERSION", -1, StringDefault, ""},
 	61:  FieldTypeEntry{"DIRECTION", -1, StringDefault, ""},
-	62:  FieldTypeEntry{"IPV6_NEXT_HOP", -1, StringDefault, ""},
-	63:  FieldTypeEntry{"BGP_IPV6_NEXT_HOP", -1, StringDefault, ""},
-	64:  FieldTypeEntry{"IPV6_OPTIONS_HEADERS", -1, StringDefault, ""},
+	62:  FieldTypeEntry{"IPV6_NEXT_HOP", 16, StringIPv6, "IPv6 address of the next-hop router"},
+	63:  FieldTypeEntry{"BGP_IPV6_NEXT_HOP", 16, StringIPv6, "Next-hop router in the BGP domain"},
+	64:  FieldTypeEntry{"IPV6_OPTIONS_HEADERS", 4, StringIPv6OptionHeaders, "Bit-encoded field identifying IPv6 option headers found in the flow"},
 	65:  FieldTypeEntry{"*Vendor Proprietary*", -1, StringDefault, ""},
 	66:  FieldTypeEntry{"*Vendor Proprietary*", -1, StringDefault, ""},
 	67:  FieldTypeEntry{"*Vendor Proprietary*", -1, StringDefault, ""},
@@ -112,18 +115,36 @@ var FieldMap = map[int]FieldTypeEntry{
 	94:  FieldTypeEntry{"APPLICATION_DESCRIPTION", -1, StringDefault, ""},
 	95:  FieldTypeEntry{"APPLICATION_TAG", -1, StringDefault, ""},
 	96:  FieldTypeEntry{"APPLICATION_NAME", -1, StringDefault, ""},
-	97:  FieldTypeEntry{"postipDiffServCodePoint", -1, StringDefault, ""},
+	97:  FieldTypeEntry{"postipDiffServCodePoint", -1, StringDSCP, ""},
 	98:  FieldTypeEntry{"replication factor", -1, StringDefault, ""},
 	99:  FieldTypeEntry{"DEPRECATED", -1, StringDefault, ""},
 	100: FieldTypeEntry{"layer2packetSectionOffset", -1, StringDefault, ""},
 	101: FieldTypeEntry{"layer2packetSectionSize", -1, StringDefault, ""},
 	102: FieldTypeEntry{"layer2packetSectionData", -1, StringDefault, ""},
+	139: FieldTypeEntry{"icmpTypeCodeIPv6", 2, StringICMPv6, "Type and code of an ICMPv6 message, as type * 256 + code"},
+	176: FieldTypeEntry{"icmpTypeIPv4", 1, StringDefault, "Type of an ICMP message"},
+	177: FieldTypeEntry{"icmpCodeIPv4", 1, StringDefault, "Code of an ICMP message"},
+	178: FieldTypeEntry{"icmpTypeIPv6", 1, StringDefault, "Type of an ICMPv6 message"},
+	179: FieldTypeEntry{"icmpCodeIPv6", 1, StringDefault, "Code of an ICMPv6 message"},
+	195: FieldTypeEntry{"ipDiffServCodePoint", 1, StringDSCP, "DSCP, the upper six bits of the TOS or Traffic Class byte"},
 	298: FieldTypeEntry{"initiatorPackets", -1, StringDefault, ""},
 	299: FieldTypeEntry{"responderPackets", -1, StringDefault, ""},
 	231: FieldTypeEntry{"initiatorOctets", -1, StringDefault, ""},
 	232: FieldTypeEntry{"responderOctets", -1, StringDefault, ""},
 }
 
+// FieldType returns the type of the field named name in FieldMap. When
+// several types share a name the lowest type is returned.
+func FieldType(name string) (int, bool) {
+	ty, ok := -1, false
+	for t, entry := range FieldMap {
+		if entry.Name == name && (!ok || t < ty) {
+			ty, ok = t, true
+		}
+	}
+	return ty, ok
+}
+
 func StringDefault(b []uint8) string {
 	switch len(b) {
 	case 1:
@@ -157,7 +178,55 @@ func StringIPv4(bytes []uint8) string {
 		strconv.Itoa(int(bytes[3]))
 }
 
+// StringIPv6 formats an IPv6 address in its RFC 5952 form, e.g.
+// "2001:db8::1". Values of another length are formatted by
+// StringDefault.
+func StringIPv6(b []uint8) string {
+	if len(b) != 16 {
+		return StringDefault(b)
+	}
+	return netip.AddrFrom16([16]byte(b)).String()
+}
+
+// StringIPv6FlowLabel formats the 20-bit flow label in hexadecimal, e.g.
+// "0x2a3f1".
+func StringIPv6FlowLabel(b []uint8) string {
+	return fmt.Sprintf("0x%05x", uintValue(b)&0xfffff)
+}
+
+// ipv6OptionHeaders are the names of the bits of IPV6_OPTIONS_HEADERS,
+// from the least significant, as in the ipv6ExtensionHeaders element of
+// RFC 5102. Empty names are reserved bits.
+var ipv6OptionHeaders = [...]string{"DST", "HOP", "", "UNK", "FRA0", "RH", "FRA1"}
+
+// StringIPv6OptionHeaders formats the IPv6 extension headers seen in a
+// flow by name joined by "|", e.g. "HOP|RH", or "0" if there were none.
+// Reserved bits are shown as a hexadecimal remainder.
+func StringIPv6OptionHeaders(b []uint8) string {
+	n := uintValue(b)
+	var names []string
+	for i, name := range ipv6OptionHeaders {
+		if name != "" && n&(1<<i) != 0 {
+			names = append(names, name)
+			n &^= 1 << i
+		}
+	}
+	if n != 0 {
+		names = append(names, "0x"+strconv.FormatUint(n, 16))
+	}
+	if len(names) == 0 {
+		return "0"
+	}
+	return strings.Join(names, "|")
+}
+
+// StringMAC formats a MAC address as colon-separated hexadecimal, or ""
+// if it is all zeros, as exporters send for addresses they don't know.
+// net2.FormatMAC adds the vendor.
 func StringMAC(bytes []uint8) string {
+	if net2.MACIsZero(bytes) {
+		return ""
+	}
 	const hexDigit = "0123456789abcdef"
 	buf := make([]byte, 0, len(bytes)*3-1)
 	for i, b := range bytes {
@@ -170,6 +239,46 @@ func StringMAC(bytes []uint8) string {
 	return string(buf)
 }
 
+// uintValue returns b as a big-endian unsigned integer.
+func uintValue(b []uint8) uint64 {
+	var n uint64
+	for _, c := range b {
+		n = n<<8 | uint64(c)
+	}
+	return n
+}
+
+// StringTCPFlags formats TCP flags by name, e.g. "SYN|ACK".
+func StringTCPFlags(b []uint8) string {
+	return net2.TCPFlags(uintValue(b)).String()
+}
+
+// StringTOS formats a TOS byte as its DSCP name and ECN codepoint, e.g.
+// "AF41" or "EF CE".
+func StringTOS(b []uint8) string {
+	return net2.FormatTOS(uint8(uintValue(b)))
+}
+
+// StringDSCP formats a DSCP value by name, e.g. "EF".
+func StringDSCP(b []uint8) string {
+	return net2.DSCPName(uint8(uintValue(b)))
+}
+
+// StringICMP formats ICMP_TYPE, type * 256 + code, as an ICMP message
+// name, e.g. "echo-request". ICMPv6 flows use the same field; it is read
+// as ICMP here, as the protocol is not known.
+func StringICMP(b []uint8) string {
+	n := uintValue(b)
+	return net2.FormatICMP(1, uint8(n>>8), uint8(n))
+}
+
+// StringICMPv6 formats type * 256 + code as an ICMPv6 message name, e.g.
+// "neighbor-solicitation".
+func StringICMPv6(b []uint8) string {
+	n := uintValue(b)
+	return net2.FormatICMP(58, uint8(n>>8), uint8(n))
+}
+
 func StringIPProtocol(bytes []uint8) string {
 	if entry, ok := net2.IPProtocolMap[int(bytes[0])]; ok {
 		return entry.Keyword
@@ -3,10 +3,24 @@ package nfv9
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
 	"strconv"
 )
 
+// Version is the NetFlow version decoded by this package.
+const Version = 9
+
+// Errors returned by ReadFrame. They are wrapped with details; use
+// errors.Is to test for them.
+var (
+	ErrBadVersion      = errors.New("nfv9: bad version")
+	ErrUnknownTemplate = errors.New("nfv9: unknown template")
+	ErrTruncated       = errors.New("nfv9: truncated packet")
+	ErrBadTemplate     = errors.New("nfv9: bad template")
+)
+
 // NetFlow v9 export packet.
 type Frame struct {
 	Header   Header
@@ -62,8 +76,24 @@ type Template struct {
 	TemplateID uint16 // always 0-255
 	FieldCount uint16
 	Fields     []FieldTL
+
+	// Options is set for options templates, whose records describe the
+	// exporter rather than flows. The first ScopeFieldCount Fields are
+	// scope fields, whose types are the Scope constants rather than
+	// FieldMap types.
+	Options         bool
+	ScopeFieldCount uint16
 }
 
+// Options template scope field types.
+const (
+	ScopeSystem    = 1
+	ScopeInterface = 2
+	ScopeLineCard  = 3
+	ScopeCache     = 4
+	ScopeTemplate  = 5
+)
+
 func (p *Template) size() int {
 	size := binary.Size(p.TemplateID)
 	size += binary.Size(p.FieldCount)
@@ -95,6 +125,9 @@ func (p *Template) read(f *Framer) error {
 		}
 		p.Fields = append(p.Fields, field)
 	}
+	if p.fieldsSize() == 0 {
+		return fmt.Errorf("%w: template %d has no data", ErrBadTemplate, p.TemplateID)
+	}
 	return nil
 }
 
@@ -120,6 +153,56 @@ func (p *TemplateFlowSet) read(f *Framer, fsId uint16, length uint16) error {
 	return nil
 }
 
+type OptionsTemplateFlowSet struct {
+	FlowSetID uint16 // always 1
+	Length    uint16
+	Templates []Template
+}
+
+func (p *OptionsTemplateFlowSet) read(f *Framer, fsId uint16, length uint16) error {
+	p.FlowSetID = fsId
+	p.Length = length
+
+	bytesRemaining := int(p.Length) - binary.Size(p.FlowSetID) - binary.Size(p.Length)
+	// Each template has a 6 byte header: template ID, option scope length
+	// and option length.
+	for bytesRemaining >= 6 {
+		var header struct {
+			TemplateID   uint16
+			ScopeLength  uint16
+			OptionLength uint16
+		}
+		if err := binary.Read(f.buf, binary.BigEndian, &header); err != nil {
+			return err
+		}
+		scopes := int(header.ScopeLength) / binary.Size(FieldTL{})
+		options := int(header.OptionLength) / binary.Size(FieldTL{})
+		template := Template{
+			TemplateID:      header.TemplateID,
+			FieldCount:      uint16(scopes + options),
+			Options:         true,
+			ScopeFieldCount: uint16(scopes),
+		}
+		for i := 0; i < scopes+options; i++ {
+			field := FieldTL{}
+			if err := field.read(f); err != nil {
+				return err
+			}
+			template.Fields = append(template.Fields, field)
+		}
+		if template.fieldsSize() == 0 {
+			return fmt.Errorf("%w: options template %d has no data", ErrBadTemplate, template.TemplateID)
+		}
+		p.Templates = append(p.Templates, template)
+		bytesRemaining -= 6 + int(template.FieldCount)*binary.Size(FieldTL{})
+	}
+	// Eat padding.
+	if bytesRemaining > 0 && len(f.buf.Next(bytesRemaining)) < bytesRemaining {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 type DataRecord struct {
 	Fields []uint8
 }
@@ -141,6 +224,9 @@ func (p *DataFlowSet) read(f *Framer, fsId uint16, length uint16, template *Temp
 	bytesRemaining := int(p.Length) - binary.Size(p.FlowSetID) - binary.Size(p.Length)
 
 	recordSize := template.fieldsSize()
+	if recordSize == 0 {
+		return 0, fmt.Errorf("%w: template %d has no data", ErrBadTemplate, template.TemplateID)
+	}
 
 	count := 0
 	for bytesRemaining >= recordSize {
@@ -189,10 +275,20 @@ func (f *Framer) ReadFrame() (frame Frame, err error) {
 	err = nil
 	frame = Frame{}
 
+	defer func() {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			err = fmt.Errorf("%w: %v", ErrTruncated, err)
+		}
+	}()
+
 	// Read Header
 	if err = frame.Header.read(f); err != nil {
 		return
 	}
+	if frame.Header.Version != Version {
+		err = fmt.Errorf("%w: %d", ErrBadVersion, frame.Header.Version)
+		return
+	}
 
 	// Read FlowSets
 	count := int(frame.Header.Count)
@@ -214,21 +310,35 @@ func (f *Framer) ReadFrame() (frame Frame, err error) {
 				return
 			}
 
-			// Add new templates to the TemplateCache.
+			// Exporters resend templates, possibly redefined, so the
+			// latest definition replaces the cached one.
 			for _, template := range tfs.Templates {
 				template := template
-				if !f.template_cache.Exists(template.TemplateID) {
-					f.template_cache.Add(&template)
-				}
+				f.template_cache.Add(&template)
 			}
 
 			frame.FlowSets = append(frame.FlowSets, tfs)
 			count -= 1
 			break
+		case fsId == 1:
+			otfs := OptionsTemplateFlowSet{}
+			if err = otfs.read(f, fsId, length); err != nil {
+				return
+			}
+			// Exporters resend options templates, possibly redefined,
+			// so the latest definition replaces the cached one.
+			for _, template := range otfs.Templates {
+				template := template
+				f.template_cache.Add(&template)
+			}
+
+			frame.FlowSets = append(frame.FlowSets, otfs)
+			count -= len(otfs.Templates)
+			break
 		case fsId > 255:
 			template, ok := f.template_cache.Get(fsId)
 			if !ok {
-				err = fmt.Errorf("Cannot parse DataFlowSet: unknown TemplateID=%d", fsId)
+				err = fmt.Errorf("%w: cannot parse DataFlowSet with TemplateID=%d", ErrUnknownTemplate, fsId)
 				return
 			}
 			dfs := DataFlowSet{}
@@ -240,6 +350,15 @@ func (f *Framer) ReadFrame() (frame Frame, err error) {
 			frame.FlowSets = append(frame.FlowSets, dfs)
 			count -= cnt
 			break
+		default:
+			// FlowSet IDs 2-255 are reserved; skip their contents.
+			n := int(length) - 4
+			if n < 0 || n > f.buf.Len() {
+				err = io.ErrUnexpectedEOF
+				return
+			}
+			f.buf.Next(n)
+			count -= 1
 		}
 	}
 	return
@@ -27,3 +27,8 @@ func (tc *TemplateCache) Get(tid uint16) (template *Template, ok bool) {
 	}
 	return t, true
 }
+
+// Len returns the number of cached templates.
+func (tc *TemplateCache) Len() int {
+	return len(tc.templates)
+}
@@ -0,0 +1,231 @@
+// Package rdns resolves addresses to host names in the background, so
+// that callers never wait on DNS.
+package rdns
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Config sizes a Resolver. Zero values select the defaults.
+type Config struct {
+	// Workers is the number of concurrent lookups. Defaults to 8.
+	Workers int
+	// QueueSize is the number of lookups waiting for a worker. Lookups
+	// requested while the queue is full are dropped. Defaults to 10000.
+	QueueSize int
+	// CacheSize is the number of addresses cached, least recently used
+	// first out. Defaults to 100000.
+	CacheSize int
+	// TTL is how long names are cached. Defaults to 5 minutes.
+	TTL time.Duration
+	// NegativeTTL is how long failed lookups are cached. Defaults to 1
+	// minute.
+	NegativeTTL time.Duration
+	// Timeout limits each lookup. Defaults to 2 seconds.
+	Timeout time.Duration
+	// Server is the host:port of the DNS server to query. Defaults to the
+	// system resolver.
+	Server string
+}
+
+// Stats counts a Resolver's activity.
+type Stats struct {
+	Hits     uint64 // lookups answered from the cache
+	Misses   uint64 // lookups that were not cached or had expired
+	Dropped  uint64 // lookups dropped because the queue was full
+	Failures uint64 // DNS queries that failed or timed out
+	Cached   int    // addresses cached
+}
+
+// entry is a cached lookup result.
+type entry struct {
+	addr    netip.Addr
+	names   []string
+	expires time.Time
+	// resolving is set while a lookup for addr is queued or running.
+	resolving bool
+}
+
+// Resolver caches reverse DNS names and looks up missing ones with a
+// pool of workers. It is safe for concurrent use.
+type Resolver struct {
+	cfg      Config
+	resolver *net.Resolver
+	queue    chan netip.Addr
+	done     chan struct{}
+	wg       sync.WaitGroup
+
+	mu    sync.Mutex
+	lru   *list.List // of *entry, most recently used first
+	cache map[netip.Addr]*list.Element
+
+	hits, misses, dropped, failures atomic.Uint64
+}
+
+// New returns a Resolver for cfg and starts its workers.
+func New(cfg Config) *Resolver {
+	if cfg.Workers <= 0 {
+		cfg.Workers = 8
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 10000
+	}
+	if cfg.CacheSize <= 0 {
+		cfg.CacheSize = 100000
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = 5 * time.Minute
+	}
+	if cfg.NegativeTTL <= 0 {
+		cfg.NegativeTTL = time.Minute
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 2 * time.Second
+	}
+	r := &Resolver{
+		cfg:      cfg,
+		resolver: net.DefaultResolver,
+		queue:    make(chan netip.Addr, cfg.QueueSize),
+		done:     make(chan struct{}),
+		lru:      list.New(),
+		cache:    make(map[netip.Addr]*list.Element),
+	}
+	if cfg.Server != "" {
+		r.resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, cfg.Server)
+			},
+		}
+	}
+	for i := 0; i < cfg.Workers; i++ {
+		r.wg.Add(1)
+		go r.worker()
+	}
+	return r
+}
+
+// Lookup returns the cached names of addr without blocking. If addr is
+// not cached, or its names have expired, a lookup is started in the
+// background; expired names are still returned until it completes. ok is
+// false when there are no names to return.
+func (r *Resolver) Lookup(addr netip.Addr) (names []string, ok bool) {
+	now := time.Now()
+
+	r.mu.Lock()
+	e := r.get(addr)
+	if e != nil && now.Before(e.expires) {
+		r.mu.Unlock()
+		r.hits.Add(1)
+		return e.names, len(e.names) > 0
+	}
+	r.misses.Add(1)
+	if e == nil {
+		e = r.add(addr)
+	}
+	names = e.names
+	start := !e.resolving
+	e.resolving = true
+	r.mu.Unlock()
+
+	if start {
+		select {
+		case r.queue <- addr:
+		default:
+			r.dropped.Add(1)
+			r.mu.Lock()
+			e.resolving = false
+			r.mu.Unlock()
+		}
+	}
+	return names, len(names) > 0
+}
+
+// get returns the entry of addr, marking it recently used.
+func (r *Resolver) get(addr netip.Addr) *entry {
+	el, ok := r.cache[addr]
+	if !ok {
+		return nil
+	}
+	r.lru.MoveToFront(el)
+	return el.Value.(*entry)
+}
+
+// add caches an empty, expired entry for addr, evicting the least
+// recently used entry if the cache is full.
+func (r *Resolver) add(addr netip.Addr) *entry {
+	for r.lru.Len() >= r.cfg.CacheSize {
+		el := r.lru.Back()
+		r.lru.Remove(el)
+		delete(r.cache, el.Value.(*entry).addr)
+	}
+	e := &entry{addr: addr}
+	r.cache[addr] = r.lru.PushFront(e)
+	return e
+}
+
+func (r *Resolver) worker() {
+	defer r.wg.Done()
+	for {
+		var addr netip.Addr
+		select {
+		case <-r.done:
+			return
+		case addr = <-r.queue:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), r.cfg.Timeout)
+		names, err := r.resolver.LookupAddr(ctx, addr.String())
+		cancel()
+
+		ttl := r.cfg.TTL
+		if err != nil {
+			var dnsErr *net.DNSError
+			if !errors.As(err, &dnsErr) || !dnsErr.IsNotFound {
+				r.failures.Add(1)
+			}
+			names = nil
+			ttl = r.cfg.NegativeTTL
+		}
+
+		r.mu.Lock()
+		e := r.get(addr)
+		if e == nil {
+			// Evicted while resolving.
+			e = r.add(addr)
+		}
+		e.resolving = false
+		e.names = names
+		e.expires = time.Now().Add(ttl)
+		r.mu.Unlock()
+	}
+}
+
+// Stats returns the resolver's counters.
+func (r *Resolver) Stats() Stats {
+	r.mu.Lock()
+	cached := r.lru.Len()
+	r.mu.Unlock()
+	return Stats{
+		Hits:     r.hits.Load(),
+		Misses:   r.misses.Load(),
+		Dropped:  r.dropped.Load(),
+		Failures: r.failures.Load(),
+		Cached:   cached,
+	}
+}
+
+// Close stops the workers, abandoning queued lookups. Lookup still
+// answers from the cache after Close.
+func (r *Resolver) Close() {
+	close(r.done)
+	r.wg.Wait()
+}
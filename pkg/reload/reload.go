@@ -0,0 +1,71 @@
+// Package reload loads files again when they change, for lookup tables
+// that are swapped in while in use.
+package reload
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+// File calls a load function for a file, and again whenever the file's
+// size or modification time changes. It is safe for concurrent use.
+type File struct {
+	path string
+	load func(path string) error
+
+	mu      sync.Mutex
+	loaded  bool
+	modTime time.Time
+	size    int64
+}
+
+// NewFile returns a File that loads path with load. A File with an empty
+// path never loads.
+func NewFile(path string, load func(path string) error) *File {
+	return &File{path: path, load: load}
+}
+
+// Reload calls load if the file changed since it was last loaded, or has
+// not been loaded yet, and reports whether it did. A failed load is
+// retried by the next Reload.
+func (f *File) Reload() (bool, error) {
+	if f.path == "" {
+		return false, nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return false, err
+	}
+	if f.loaded && info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return false, nil
+	}
+	if err := f.load(f.path); err != nil {
+		return false, err
+	}
+	f.loaded = true
+	f.modTime = info.ModTime()
+	f.size = info.Size()
+	return true, nil
+}
+
+// Watch calls reload every interval until stop is closed, passing errors
+// and successful reloads to report.
+func Watch(interval time.Duration, stop <-chan struct{}, reload func() (bool, error), report func(reloaded bool, err error)) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+			reloaded, err := reload()
+			if (reloaded || err != nil) && report != nil {
+				report(reloaded, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,347 @@
+// Package rotate writes flow records to local files that are rotated by
+// time interval and size, optionally compressed, and expired by age and
+// total disk usage.
+package rotate
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+)
+
+// Encoder encodes records into a single file. If an Encoder also
+// implements io.Closer, it is closed before its file is finished.
+type Encoder interface {
+	Encode(r *flow.Record) error
+}
+
+// Config describes where and how files are written.
+type Config struct {
+	// Dir is the directory files are written to.
+	Dir string
+	// Prefix starts every file name. Defaults to "flows".
+	Prefix string
+	// Ext is the extension of the encoded data, e.g. ".json".
+	Ext string
+	// Partition is an optional time.Format layout for the directory,
+	// relative to Dir, that a file is written to, e.g.
+	// "dt=2006-01-02/hour=15". It is formatted with the file's time bucket
+	// in UTC.
+	Partition string
+	// Interval is the length of the time bucket covered by each file.
+	// Buckets are aligned to multiples of Interval. Defaults to 5 minutes.
+	Interval time.Duration
+	// MaxSize rotates a file early once this many bytes (before
+	// compression) have been written to it. Zero disables size rotation.
+	MaxSize int64
+	// Compression is "", "gzip" or "zstd".
+	Compression string
+	// MaxAge removes finished files older than this. Zero keeps files
+	// forever.
+	MaxAge time.Duration
+	// MaxTotalSize removes the oldest finished files until the total size
+	// of finished files is at most this many bytes. Zero disables the
+	// limit.
+	MaxTotalSize int64
+	// NewEncoder returns an encoder for a newly opened file.
+	NewEncoder func(w io.Writer) (Encoder, error)
+}
+
+// file is an open output file for one exporter.
+type file struct {
+	name    string // final name, the file is written to name + tmpSuffix
+	bucket  time.Time
+	seq     int
+	f       *os.File
+	z       io.WriteCloser
+	counter *countingWriter
+	enc     Encoder
+}
+
+// tmpSuffix marks files that are still being written.
+const tmpSuffix = ".tmp"
+
+// Writer writes records to rotating files, one set of files per exporter.
+// It is safe for concurrent use.
+type Writer struct {
+	cfg Config
+
+	mu    sync.Mutex
+	files map[string]*file
+}
+
+// NewWriter returns a Writer for cfg, creating cfg.Dir if needed.
+func NewWriter(cfg Config) (*Writer, error) {
+	if cfg.Prefix == "" {
+		cfg.Prefix = "flows"
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = 5 * time.Minute
+	}
+	switch cfg.Compression {
+	case "", "gzip", "zstd":
+	default:
+		return nil, fmt.Errorf("rotate: unknown compression %q", cfg.Compression)
+	}
+	if cfg.NewEncoder == nil {
+		return nil, fmt.Errorf("rotate: missing NewEncoder")
+	}
+	if err := os.MkdirAll(cfg.Dir, 0755); err != nil {
+		return nil, err
+	}
+	return &Writer{
+		cfg:   cfg,
+		files: make(map[string]*file),
+	}, nil
+}
+
+// Write appends r to the current file for its exporter, rotating first if
+// r falls in a later time bucket or the file is full. Late records, from
+// a bucket before the open file's, are written to the open file.
+func (w *Writer) Write(r *flow.Record) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	exporter := "unknown"
+	if r.Exporter.IsValid() {
+		exporter = r.Exporter.String()
+	}
+	ts := r.Received
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	bucket := ts.Truncate(w.cfg.Interval)
+
+	f := w.files[exporter]
+	if f != nil && bucket.Before(f.bucket) {
+		bucket = f.bucket
+	}
+	if f != nil {
+		full := w.cfg.MaxSize > 0 && f.counter.n >= w.cfg.MaxSize
+		if !f.bucket.Equal(bucket) || full {
+			seq := 0
+			if f.bucket.Equal(bucket) {
+				seq = f.seq + 1
+			}
+			if err := w.finish(exporter, f); err != nil {
+				return err
+			}
+			f = nil
+			if err := w.open(exporter, bucket, seq); err != nil {
+				return err
+			}
+			f = w.files[exporter]
+		}
+	}
+	if f == nil {
+		if err := w.open(exporter, bucket, 0); err != nil {
+			return err
+		}
+		f = w.files[exporter]
+	}
+	return f.enc.Encode(r)
+}
+
+// Rotate finishes every file whose time bucket ended before now. It
+// should be called periodically so that files from idle exporters are
+// finalized.
+func (w *Writer) Rotate(now time.Time) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var err error
+	for exporter, f := range w.files {
+		if now.Before(f.bucket.Add(w.cfg.Interval)) {
+			continue
+		}
+		if e := w.finish(exporter, f); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// Close finishes every open file.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var err error
+	for exporter, f := range w.files {
+		if e := w.finish(exporter, f); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// fileName returns the name of a file, e.g.
+// flows.202203111800.192.168.88.1.json.gz, with ".N" added before the
+// extension when the bucket was rotated early because of size.
+func (w *Writer) fileName(exporter string, bucket time.Time, seq int) string {
+	name := w.cfg.Prefix + "." + bucket.UTC().Format("200601021504") + "." +
+		strings.ReplaceAll(exporter, ":", "_")
+	if seq > 0 {
+		name += fmt.Sprintf(".%d", seq)
+	}
+	name += w.cfg.Ext
+	switch w.cfg.Compression {
+	case "gzip":
+		name += ".gz"
+	case "zstd":
+		name += ".zst"
+	}
+	dir := w.cfg.Dir
+	if w.cfg.Partition != "" {
+		dir = filepath.Join(dir, bucket.UTC().Format(w.cfg.Partition))
+	}
+	return filepath.Join(dir, name)
+}
+
+func (w *Writer) open(exporter string, bucket time.Time, seq int) error {
+	name := w.fileName(exporter, bucket, seq)
+	// Don't clobber a file finished by an earlier run.
+	for {
+		_, err := os.Stat(name)
+		if os.IsNotExist(err) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		seq++
+		name = w.fileName(exporter, bucket, seq)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return err
+	}
+	fd, err := os.OpenFile(name+tmpSuffix, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	f := &file{
+		name:   name,
+		bucket: bucket,
+		seq:    seq,
+		f:      fd,
+	}
+	var out io.Writer = fd
+	switch w.cfg.Compression {
+	case "gzip":
+		f.z = gzip.NewWriter(fd)
+		out = f.z
+	case "zstd":
+		z, err := zstd.NewWriter(fd)
+		if err != nil {
+			fd.Close()
+			return err
+		}
+		f.z = z
+		out = z
+	}
+	f.counter = &countingWriter{w: out}
+	enc, err := w.cfg.NewEncoder(f.counter)
+	if err != nil {
+		fd.Close()
+		return err
+	}
+	f.enc = enc
+	w.files[exporter] = f
+	return nil
+}
+
+// finish closes f, moves it to its final name and applies retention.
+func (w *Writer) finish(exporter string, f *file) error {
+	delete(w.files, exporter)
+	if c, ok := f.enc.(io.Closer); ok {
+		if err := c.Close(); err != nil {
+			f.f.Close()
+			return err
+		}
+	}
+	if f.z != nil {
+		if err := f.z.Close(); err != nil {
+			f.f.Close()
+			return err
+		}
+	}
+	if err := f.f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(f.name+tmpSuffix, f.name); err != nil {
+		return err
+	}
+	return w.expire(time.Now())
+}
+
+// expire removes finished files according to MaxAge and MaxTotalSize.
+func (w *Writer) expire(now time.Time) error {
+	if w.cfg.MaxAge <= 0 && w.cfg.MaxTotalSize <= 0 {
+		return nil
+	}
+	type finished struct {
+		path string
+		info os.FileInfo
+	}
+	var files []finished
+	var total int64
+	err := filepath.WalkDir(w.cfg.Dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, w.cfg.Prefix+".") || strings.HasSuffix(name, tmpSuffix) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil
+		}
+		if w.cfg.MaxAge > 0 && now.Sub(info.ModTime()) > w.cfg.MaxAge {
+			return os.Remove(path)
+		}
+		files = append(files, finished{path, info})
+		total += info.Size()
+		return nil
+	})
+	if err != nil || w.cfg.MaxTotalSize <= 0 {
+		return err
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].info.ModTime().Before(files[j].info.ModTime())
+	})
+	for _, f := range files {
+		if total <= w.cfg.MaxTotalSize {
+			break
+		}
+		if err := os.Remove(f.path); err != nil {
+			return err
+		}
+		total -= f.info.Size()
+	}
+	return nil
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
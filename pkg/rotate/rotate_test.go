@@ -0,0 +1,146 @@
+package rotate
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+)
+
+// lineEncoder writes the byte count of each record on a line.
+type lineEncoder struct{ w io.Writer }
+
+func (e lineEncoder) Encode(r *flow.Record) error {
+	_, err := fmt.Fprintln(e.w, r.Bytes)
+	return err
+}
+
+func newTestWriter(t *testing.T, cfg Config) *Writer {
+	t.Helper()
+	if cfg.Dir == "" {
+		cfg.Dir = t.TempDir()
+	}
+	cfg.Ext = ".txt"
+	cfg.NewEncoder = func(w io.Writer) (Encoder, error) { return lineEncoder{w}, nil }
+	w, err := NewWriter(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+// readDir returns the contents of the files in dir, by name.
+func readDir(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]string)
+	for _, e := range entries {
+		b, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[e.Name()] = string(b)
+	}
+	return files
+}
+
+func TestWriterBuckets(t *testing.T) {
+	base := time.Date(2022, 3, 11, 18, 0, 0, 0, time.UTC)
+	w := newTestWriter(t, Config{})
+	// The records at 18:06 and 18:01 arrive late, after the 18:05 bucket
+	// was opened, and go to its file rather than reopening 18:00.
+	for i, offset := range []time.Duration{0, time.Minute, 5 * time.Minute, 6 * time.Minute, time.Minute, 7 * time.Minute, 10 * time.Minute} {
+		if err := w.Write(&flow.Record{Received: base.Add(offset), Bytes: uint64(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	got := readDir(t, w.cfg.Dir)
+	want := map[string]string{
+		"flows.202203111800.unknown.txt": "0\n1\n",
+		"flows.202203111805.unknown.txt": "2\n3\n4\n5\n",
+		"flows.202203111810.unknown.txt": "6\n",
+	}
+	if len(got) != len(want) {
+		t.Errorf("got files %v, want %v", names(got), names(want))
+	}
+	for name, data := range want {
+		if got[name] != data {
+			t.Errorf("%s = %q, want %q", name, got[name], data)
+		}
+	}
+}
+
+func TestWriterSizeAndExisting(t *testing.T) {
+	dir := t.TempDir()
+	received := time.Date(2022, 3, 11, 18, 0, 0, 0, time.UTC)
+	// A file left by an earlier run is not clobbered.
+	if err := os.WriteFile(filepath.Join(dir, "flows.202203111800.unknown.txt"), []byte("old\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	w := newTestWriter(t, Config{Dir: dir, MaxSize: 4})
+	for i := 0; i < 5; i++ {
+		if err := w.Write(&flow.Record{Received: received, Bytes: uint64(10 + i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"flows.202203111800.unknown.txt":   "old\n",
+		"flows.202203111800.unknown.1.txt": "10\n11\n",
+		"flows.202203111800.unknown.2.txt": "12\n13\n",
+		"flows.202203111800.unknown.3.txt": "14\n",
+	}
+	got := readDir(t, dir)
+	if len(got) != len(want) {
+		t.Errorf("got files %v, want %v", names(got), names(want))
+	}
+	for name, data := range want {
+		if got[name] != data {
+			t.Errorf("%s = %q, want %q", name, got[name], data)
+		}
+	}
+}
+
+func TestWriterStatError(t *testing.T) {
+	dir := t.TempDir()
+	// The partition directory is a file, so checking for an existing file
+	// in it fails with ENOTDIR.
+	if err := os.WriteFile(filepath.Join(dir, "2022"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	w := newTestWriter(t, Config{Dir: dir, Partition: "2006"})
+	done := make(chan error, 1)
+	go func() {
+		done <- w.Write(&flow.Record{Received: time.Date(2022, 3, 11, 18, 0, 0, 0, time.UTC)})
+	}()
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Error("Write into a file as partition directory: got no error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Write did not return")
+	}
+}
+
+func names(files map[string]string) []string {
+	var ns []string
+	for name := range files {
+		ns = append(ns, name)
+	}
+	sort.Strings(ns)
+	return ns
+}
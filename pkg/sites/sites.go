@@ -0,0 +1,201 @@
+// Package sites labels addresses with the site, role, customer or other
+// attributes of the local networks that contain them, read from a file
+// of prefixes, and classifies flows as inbound, outbound, internal or
+// transit.
+//
+// Each line of the file is a prefix followed by labels:
+//
+//	# prefix         labels
+//	192.168.88.0/24  home role=lan
+//	10.0.0.0/8       dc1 customer=acme
+//	10.20.0.0/16     role=servers
+//	203.0.113.0/24   external customer=acme
+//
+// A label is KEY=VALUE or a bare word: "internal" and "external" set the
+// scope label, and any other word sets the site label. Listed networks
+// are internal unless labelled external. Addresses take the labels of
+// the most specific network that contains them, merged with the labels of
+// the less specific networks that contain it.
+package sites
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/net2"
+	"github.com/brooksbp/go.netflow/pkg/reload"
+)
+
+// Labels are the labels of a network, by key.
+type Labels map[string]string
+
+// Scope values.
+const (
+	Internal = "internal"
+	External = "external"
+)
+
+// Direction of a flow relative to the internal networks.
+type Direction string
+
+const (
+	// Inbound flows go from an external address to an internal one.
+	Inbound Direction = "inbound"
+	// Outbound flows go from an internal address to an external one.
+	Outbound Direction = "outbound"
+	// InternalFlow flows stay within the internal networks.
+	InternalFlow Direction = "internal"
+	// Transit flows neither start nor end in an internal network.
+	Transit Direction = "transit"
+)
+
+// Map maps prefixes to labels. It is immutable once read, and safe for
+// concurrent use.
+type Map struct {
+	table *net2.PrefixTable[Labels]
+}
+
+// Read reads a map in the format described in the package comment.
+func Read(r io.Reader) (*Map, error) {
+	table := net2.NewPrefixTable[Labels]()
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		text, _, _ := strings.Cut(s.Text(), "#")
+		words := strings.Fields(text)
+		if len(words) == 0 {
+			continue
+		}
+		p, err := netip.ParsePrefix(words[0])
+		if err != nil {
+			return nil, fmt.Errorf("sites: line %d: %w", line, err)
+		}
+		labels := Labels{"scope": Internal}
+		for _, w := range words[1:] {
+			key, value, ok := strings.Cut(w, "=")
+			switch {
+			case ok && key == "":
+				return nil, fmt.Errorf("sites: line %d: empty label key in %q", line, w)
+			case ok:
+				labels[key] = value
+			case w == Internal || w == External:
+				labels["scope"] = w
+			default:
+				labels["site"] = w
+			}
+		}
+		table.Insert(p, labels)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	// Merge the labels of each network into the networks it contains.
+	// Walk visits a network before the networks inside it, so labels it
+	// inherited are already merged in.
+	table.Walk(func(p netip.Prefix, labels Labels) bool {
+		for bits := p.Bits() - 1; bits >= 0; bits-- {
+			parent, _ := p.Addr().Prefix(bits)
+			if outer, ok := table.Get(parent); ok {
+				for k, v := range outer {
+					if _, ok := labels[k]; !ok {
+						labels[k] = v
+					}
+				}
+				break
+			}
+		}
+		return true
+	})
+	return &Map{table}, nil
+}
+
+// Load reads the map in path.
+func Load(path string) (*Map, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	m, err := Read(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return m, nil
+}
+
+// Lookup returns the most specific network containing addr and its
+// labels, which must not be changed.
+func (m *Map) Lookup(addr netip.Addr) (netip.Prefix, Labels, bool) {
+	return m.table.Lookup(addr)
+}
+
+// IsInternal reports whether addr is in an internal network.
+func (m *Map) IsInternal(addr netip.Addr) bool {
+	_, labels, ok := m.table.Lookup(addr)
+	return ok && labels["scope"] != External
+}
+
+// Direction classifies a flow from src to dst.
+func (m *Map) Direction(src, dst netip.Addr) Direction {
+	switch s, d := m.IsInternal(src), m.IsInternal(dst); {
+	case s && d:
+		return InternalFlow
+	case s:
+		return Outbound
+	case d:
+		return Inbound
+	}
+	return Transit
+}
+
+// Len returns the number of networks in the map.
+func (m *Map) Len() int {
+	return m.table.Len()
+}
+
+// File is a Map read from a file that it reloads when the file changes.
+// It is safe for concurrent use, including while it is reloaded.
+type File struct {
+	cur  atomic.Pointer[Map]
+	file *reload.File
+}
+
+// Open loads the map in path.
+func Open(path string) (*File, error) {
+	f := &File{}
+	f.file = reload.NewFile(path, func(path string) error {
+		m, err := Load(path)
+		if err == nil {
+			f.cur.Store(m)
+		}
+		return err
+	})
+	if _, err := f.Reload(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Map returns the current map.
+func (f *File) Map() *Map {
+	return f.cur.Load()
+}
+
+// Reload loads the file again if its size or modification time changed
+// since it was last loaded, and reports whether it did. The previous map
+// stays in use if the file fails to load.
+func (f *File) Reload() (bool, error) {
+	return f.file.Reload()
+}
+
+// Watch calls Reload every interval until stop is closed, passing errors
+// and successful reloads to report.
+func (f *File) Watch(interval time.Duration, stop <-chan struct{}, report func(reloaded bool, err error)) {
+	reload.Watch(interval, stop, f.Reload, report)
+}
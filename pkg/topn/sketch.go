@@ -0,0 +1,154 @@
+package topn
+
+import (
+	"container/heap"
+	"hash/maphash"
+	"sort"
+)
+
+// SpaceSaving tracks the heaviest keys of a stream in a fixed number of
+// counters. A key that is not tracked replaces the smallest counter and
+// inherits its count as error, so every count is an upper bound that
+// exceeds the true count by at most its error.
+type SpaceSaving struct {
+	capacity int
+	items    map[string]*ssItem
+	heap     ssHeap
+}
+
+type ssItem struct {
+	key   string
+	count uint64
+	err   uint64
+	index int
+}
+
+// NewSpaceSaving returns a SpaceSaving summary with capacity counters.
+func NewSpaceSaving(capacity int) *SpaceSaving {
+	return &SpaceSaving{
+		capacity: capacity,
+		items:    make(map[string]*ssItem, capacity),
+	}
+}
+
+// Add adds weight to key.
+func (s *SpaceSaving) Add(key string, weight uint64) {
+	if it, ok := s.items[key]; ok {
+		it.count += weight
+		heap.Fix(&s.heap, it.index)
+		return
+	}
+	if len(s.heap) < s.capacity {
+		it := &ssItem{key: key, count: weight}
+		s.items[key] = it
+		heap.Push(&s.heap, it)
+		return
+	}
+	it := s.heap[0]
+	delete(s.items, it.key)
+	it.key = key
+	it.err = it.count
+	it.count += weight
+	s.items[key] = it
+	heap.Fix(&s.heap, 0)
+}
+
+// Count returns the upper bound and error of key's count, and whether it
+// is tracked. The count of an untracked key is at most Min.
+func (s *SpaceSaving) Count(key string) (count, err uint64, ok bool) {
+	it, ok := s.items[key]
+	if !ok {
+		return 0, 0, false
+	}
+	return it.count, it.err, true
+}
+
+// Min returns the smallest tracked count once every counter is in use,
+// and zero before.
+func (s *SpaceSaving) Min() uint64 {
+	if len(s.heap) < s.capacity {
+		return 0
+	}
+	return s.heap[0].count
+}
+
+// Keys returns the tracked keys, largest count first.
+func (s *SpaceSaving) Keys() []string {
+	items := append([]*ssItem(nil), s.heap...)
+	sort.Slice(items, func(i, j int) bool { return items[i].count > items[j].count })
+	keys := make([]string, len(items))
+	for i, it := range items {
+		keys[i] = it.key
+	}
+	return keys
+}
+
+// ssHeap is a min-heap of counters.
+type ssHeap []*ssItem
+
+func (h ssHeap) Len() int           { return len(h) }
+func (h ssHeap) Less(i, j int) bool { return h[i].count < h[j].count }
+func (h ssHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *ssHeap) Push(x any) {
+	it := x.(*ssItem)
+	it.index = len(*h)
+	*h = append(*h, it)
+}
+
+func (h *ssHeap) Pop() any {
+	old := *h
+	it := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return it
+}
+
+// CountMin estimates the count of any key in depth rows of width
+// counters. Estimates never undercount.
+type CountMin struct {
+	width  int
+	depth  int
+	seed   maphash.Seed
+	counts []uint64
+}
+
+// NewCountMin returns a CountMin sketch of depth rows of width counters.
+func NewCountMin(width, depth int) *CountMin {
+	return &CountMin{
+		width:  width,
+		depth:  depth,
+		seed:   maphash.MakeSeed(),
+		counts: make([]uint64, width*depth),
+	}
+}
+
+// index returns the counter of key in row i, deriving the row hashes
+// from two halves of a single hash.
+func (c *CountMin) index(h uint64, i int) int {
+	h1, h2 := uint32(h), uint32(h>>32)
+	return i*c.width + int((h1+uint32(i)*h2)%uint32(c.width))
+}
+
+// Add adds weight to key.
+func (c *CountMin) Add(key string, weight uint64) {
+	h := maphash.String(c.seed, key)
+	for i := 0; i < c.depth; i++ {
+		c.counts[c.index(h, i)] += weight
+	}
+}
+
+// Estimate returns an upper bound of key's count.
+func (c *CountMin) Estimate(key string) uint64 {
+	h := maphash.String(c.seed, key)
+	var min uint64
+	for i := 0; i < c.depth; i++ {
+		if n := c.counts[c.index(h, i)]; i == 0 || n < min {
+			min = n
+		}
+	}
+	return min
+}
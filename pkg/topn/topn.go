@@ -0,0 +1,320 @@
+// Package topn answers top-N queries, such as the heaviest talkers or
+// conversations over the last few minutes, from memory-bounded sketches
+// of the flow records seen.
+package topn
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/brooksbp/go.netflow/pkg/flow"
+	"github.com/brooksbp/go.netflow/pkg/net2"
+)
+
+// Dimensions maps dimension names to the key they take from a record.
+var Dimensions = map[string]func(r *flow.Record) string{
+	"src": func(r *flow.Record) string { return addrString(r.SrcAddr) },
+	"dst": func(r *flow.Record) string { return addrString(r.DstAddr) },
+	"conversation": func(r *flow.Record) string {
+		a, b := r.SrcAddr, r.DstAddr
+		if b.Less(a) {
+			a, b = b, a
+		}
+		return addrString(a) + " <-> " + addrString(b)
+	},
+	"dst_port": func(r *flow.Record) string {
+		proto := strconv.Itoa(int(r.Protocol))
+		if entry, ok := net2.IPProtocolMap[int(r.Protocol)]; ok {
+			proto = entry.Keyword
+		}
+		return proto + "/" + strconv.Itoa(int(r.DstPort))
+	},
+	"src_as":   func(r *flow.Record) string { return "AS" + strconv.FormatUint(uint64(r.SrcAS), 10) },
+	"dst_as":   func(r *flow.Record) string { return "AS" + strconv.FormatUint(uint64(r.DstAS), 10) },
+	"exporter": func(r *flow.Record) string { return addrString(r.Exporter) },
+}
+
+// DefaultDimensions are tracked when Config.Dimensions is empty.
+var DefaultDimensions = []string{"src", "dst", "conversation", "dst_port", "src_as", "dst_as"}
+
+// Metrics maps metric names to the weight a record adds.
+var Metrics = map[string]func(r *flow.Record) uint64{
+	"bytes":   func(r *flow.Record) uint64 { return r.Bytes },
+	"packets": func(r *flow.Record) uint64 { return r.Packets },
+	"flows":   func(r *flow.Record) uint64 { return r.Flows },
+}
+
+func addrString(a netip.Addr) string {
+	if !a.IsValid() {
+		return "-"
+	}
+	return a.String()
+}
+
+// Config sizes a Tracker.
+type Config struct {
+	// Dimensions to track. Defaults to DefaultDimensions.
+	Dimensions []string
+	// Metrics to rank by. Defaults to bytes and packets.
+	Metrics []string
+	// Capacity is the number of keys each Space-Saving summary tracks.
+	// It bounds the N of queries. Defaults to 1000.
+	Capacity int
+	// Width and Depth size each Count-Min sketch. Default to 2048 and 4.
+	Width int
+	Depth int
+	// Slot is the time resolution of queries. Defaults to 1 minute.
+	Slot time.Duration
+	// History is how far back queries can reach. Defaults to 15 slots.
+	History time.Duration
+}
+
+// sketch combines a Space-Saving summary, which finds candidate keys,
+// with a Count-Min sketch, which bounds their counts more tightly.
+type sketch struct {
+	ss *SpaceSaving
+	cm *CountMin
+}
+
+type sketchKey struct {
+	dimension, metric string
+}
+
+// slot holds the sketches of one time slot.
+type slot struct {
+	start    time.Time
+	sketches map[sketchKey]*sketch
+}
+
+// Tracker keeps sketches of recent records in time slots. It is safe for
+// concurrent use.
+type Tracker struct {
+	cfg Config
+
+	mu    sync.Mutex
+	slots []*slot // oldest first
+}
+
+// NewTracker returns a Tracker for cfg.
+func NewTracker(cfg Config) (*Tracker, error) {
+	if len(cfg.Dimensions) == 0 {
+		cfg.Dimensions = DefaultDimensions
+	}
+	if len(cfg.Metrics) == 0 {
+		cfg.Metrics = []string{"bytes", "packets"}
+	}
+	for _, d := range cfg.Dimensions {
+		if _, ok := Dimensions[d]; !ok {
+			return nil, fmt.Errorf("topn: unknown dimension %q", d)
+		}
+	}
+	for _, m := range cfg.Metrics {
+		if _, ok := Metrics[m]; !ok {
+			return nil, fmt.Errorf("topn: unknown metric %q", m)
+		}
+	}
+	if cfg.Capacity <= 0 {
+		cfg.Capacity = 1000
+	}
+	if cfg.Width <= 0 {
+		cfg.Width = 2048
+	}
+	if cfg.Depth <= 0 {
+		cfg.Depth = 4
+	}
+	if cfg.Slot <= 0 {
+		cfg.Slot = time.Minute
+	}
+	if cfg.History < cfg.Slot {
+		cfg.History = 15 * cfg.Slot
+	}
+	return &Tracker{cfg: cfg}, nil
+}
+
+// slot returns the slot starting at start, creating it and expiring old
+// slots if needed. It returns nil for times older than the history.
+func (t *Tracker) slot(start time.Time) *slot {
+	for i := len(t.slots) - 1; i >= 0; i-- {
+		if t.slots[i].start.Equal(start) {
+			return t.slots[i]
+		}
+		if t.slots[i].start.Before(start) {
+			break
+		}
+	}
+	if n := len(t.slots); n > 0 && start.Before(t.slots[n-1].start) {
+		// Records for past slots are rare; don't reorder for them.
+		return nil
+	}
+	s := &slot{start: start, sketches: make(map[sketchKey]*sketch)}
+	for _, d := range t.cfg.Dimensions {
+		for _, m := range t.cfg.Metrics {
+			s.sketches[sketchKey{d, m}] = &sketch{
+				ss: NewSpaceSaving(t.cfg.Capacity),
+				cm: NewCountMin(t.cfg.Width, t.cfg.Depth),
+			}
+		}
+	}
+	t.slots = append(t.slots, s)
+	oldest := start.Add(-t.cfg.History)
+	for len(t.slots) > 0 && !t.slots[0].start.After(oldest) {
+		t.slots = t.slots[1:]
+	}
+	return s
+}
+
+// Write adds r to the sketches of the slot it was received in.
+func (t *Tracker) Write(r *flow.Record) error {
+	ts := r.Received
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s := t.slot(ts.Truncate(t.cfg.Slot))
+	if s == nil {
+		return nil
+	}
+	for k, sk := range s.sketches {
+		key := Dimensions[k.dimension](r)
+		weight := Metrics[k.metric](r)
+		if weight == 0 {
+			continue
+		}
+		sk.ss.Add(key, weight)
+		sk.cm.Add(key, weight)
+	}
+	return nil
+}
+
+// Close implements the collector's Output interface.
+func (t *Tracker) Close() error {
+	return nil
+}
+
+// Item is one entry of a top-N result.
+type Item struct {
+	Key string `json:"key"`
+	// Value is an upper bound of the key's total.
+	Value uint64 `json:"value"`
+	// Error bounds how much Value may exceed the true total.
+	Error uint64 `json:"error"`
+}
+
+// Result is the answer to a top-N query.
+type Result struct {
+	Dimension string    `json:"by"`
+	Metric    string    `json:"metric"`
+	Start     time.Time `json:"start"`
+	End       time.Time `json:"end"`
+	Items     []Item    `json:"items"`
+}
+
+// Top returns the n keys of dimension with the largest metric over the
+// slots that overlap the window ending at now.
+func (t *Tracker) Top(dimension, metric string, n int, window time.Duration, now time.Time) (*Result, error) {
+	if !contains(t.cfg.Dimensions, dimension) || !contains(t.cfg.Metrics, metric) {
+		return nil, fmt.Errorf("topn: %s by %s is not tracked", dimension, metric)
+	}
+	k := sketchKey{dimension, metric}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	start := now.Add(-window).Truncate(t.cfg.Slot)
+	res := &Result{Dimension: dimension, Metric: metric, Start: start, End: now, Items: []Item{}}
+	var sketches []*sketch
+	for _, s := range t.slots {
+		if s.start.Before(start) || s.start.After(now) {
+			continue
+		}
+		sketches = append(sketches, s.sketches[k])
+	}
+
+	candidates := make(map[string]bool)
+	for _, sk := range sketches {
+		for _, key := range sk.ss.Keys() {
+			candidates[key] = true
+		}
+	}
+	for key := range candidates {
+		var upper, lower uint64
+		for _, sk := range sketches {
+			estimate := sk.cm.Estimate(key)
+			count, err, ok := sk.ss.Count(key)
+			if !ok {
+				count = sk.ss.Min()
+			} else {
+				lower += count - err
+			}
+			upper += min(estimate, count)
+		}
+		if lower > upper {
+			lower = upper
+		}
+		res.Items = append(res.Items, Item{Key: key, Value: upper, Error: upper - lower})
+	}
+	sort.Slice(res.Items, func(i, j int) bool {
+		if res.Items[i].Value != res.Items[j].Value {
+			return res.Items[i].Value > res.Items[j].Value
+		}
+		return res.Items[i].Key < res.Items[j].Key
+	})
+	if len(res.Items) > n {
+		res.Items = res.Items[:n]
+	}
+	return res, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ServeHTTP answers top-N queries with a JSON Result. Query parameters
+// are by (a dimension, default src), metric (default bytes), n (default
+// 10) and window (a duration, default the whole history).
+func (t *Tracker) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	by := q.Get("by")
+	if by == "" {
+		by = "src"
+	}
+	metric := q.Get("metric")
+	if metric == "" {
+		metric = "bytes"
+	}
+	n := 10
+	if s := q.Get("n"); s != "" {
+		var err error
+		if n, err = strconv.Atoi(s); err != nil || n <= 0 {
+			http.Error(w, "bad n", http.StatusBadRequest)
+			return
+		}
+	}
+	window := t.cfg.History
+	if s := q.Get("window"); s != "" {
+		var err error
+		if window, err = time.ParseDuration(s); err != nil || window <= 0 {
+			http.Error(w, "bad window", http.StatusBadRequest)
+			return
+		}
+	}
+	res, err := t.Top(by, metric, n, window, time.Now())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}